@@ -1,25 +0,0 @@
-package main
-
-import "fmt"
-
-// BubbleSort function
-func bubbleSort(arr []int) {
-    n := len(arr)
-    for i := 0; i < n-1; i++ {
-        // Last i elements are already in place
-        for j := 0; j < n-i-1; j++ {
-            if arr[j] > arr[j+1] {
-                // Swap arr[j] and arr[j+1]
-                arr[j], arr[j+1] = arr[j+1], arr[j]
-            }
-        }
-    }
-}
-
-func main() {
-    arr := []int{64, 34, 25, 12, 22, 11, 90}
-    fmt.Println("Original array:", arr)
-
-    bubbleSort(arr)
-    fmt.Println("Sorted array:  ", arr)
-}
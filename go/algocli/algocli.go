@@ -0,0 +1,325 @@
+// Package algocli wraps a handful of this repository's algorithms as
+// CLI-style functions — parse flags, do the work, print a result — and
+// registers them with the registry package so any frontend (the dsa CLI,
+// dsa-server's HTTP endpoints) can list and run them without each
+// needing its own copy of the wiring.
+package algocli
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ghostmkg/dsa-code/go/graph/dijkstra"
+	"github.com/ghostmkg/dsa-code/go/misc/kadane"
+	"github.com/ghostmkg/dsa-code/go/registry"
+	"github.com/ghostmkg/dsa-code/go/searching/binarysearch"
+	"github.com/ghostmkg/dsa-code/go/searching/linearsearch"
+	"github.com/ghostmkg/dsa-code/go/sorting/bubblesort"
+	"github.com/ghostmkg/dsa-code/go/sorting/mergesort"
+	"github.com/ghostmkg/dsa-code/go/sorting/quicksort"
+	"github.com/ghostmkg/dsa-code/go/strings/kmp"
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+// init registers every algorithm this package wraps, along with its
+// category and complexity, so "dsa list"/"dsa describe" (and dsa-server's
+// /algorithms) can surface them and other tooling (benchmarks, the
+// complexity estimator) can look them up by name.
+func init() {
+	registry.Register(registry.Entry{
+		Name:            "kmp",
+		Category:        "strings",
+		TimeComplexity:  "O(n + m)",
+		SpaceComplexity: "O(m)",
+		Describe:        "KMP substring search: dsa run kmp --text ... --pattern ...",
+		Run:             runKMP,
+		SizedRun:        sizedRunKMP,
+	})
+	registry.Register(registry.Entry{
+		Name:            "binarysearch",
+		Category:        "searching",
+		TimeComplexity:  "O(log n)",
+		SpaceComplexity: "O(1)",
+		Describe:        "Binary search over a sorted int slice: dsa run binarysearch --nums 1,2,3 --target 2",
+		Run:             runBinarySearch,
+		SizedRun:        sizedRunBinarySearch,
+	})
+	registry.Register(registry.Entry{
+		Name:            "linearsearch",
+		Category:        "searching",
+		TimeComplexity:  "O(n)",
+		SpaceComplexity: "O(1)",
+		Describe:        "Linear search over an int slice: dsa run linearsearch --nums 1,2,3 --target 2",
+		Run:             runLinearSearch,
+	})
+	registry.Register(registry.Entry{
+		Name:            "bubblesort",
+		Category:        "sorting",
+		TimeComplexity:  "O(n^2)",
+		SpaceComplexity: "O(1)",
+		Describe:        "Bubble sort an int slice: dsa run bubblesort --nums 5,3,1",
+		Run:             runBubbleSort,
+		SizedRun:        sizedRunBubbleSort,
+	})
+	registry.Register(registry.Entry{
+		Name:            "quicksort",
+		Category:        "sorting",
+		TimeComplexity:  "O(n log n) avg, O(n^2) worst",
+		SpaceComplexity: "O(log n)",
+		Describe:        "Quicksort an int slice: dsa run quicksort --nums 5,3,1",
+		Run:             runQuickSort,
+		SizedRun:        sizedRunQuickSort,
+	})
+	registry.Register(registry.Entry{
+		Name:            "mergesort",
+		Category:        "sorting",
+		TimeComplexity:  "O(n log n)",
+		SpaceComplexity: "O(n)",
+		Describe:        "Merge sort an int slice: dsa run mergesort --nums 5,3,1",
+		Run:             runMergeSort,
+		SizedRun:        sizedRunMergeSort,
+	})
+	registry.Register(registry.Entry{
+		Name:            "kadane",
+		Category:        "misc",
+		TimeComplexity:  "O(n)",
+		SpaceComplexity: "O(1)",
+		Describe:        "Kadane's max subarray sum: dsa run kadane --nums 1,-2,3",
+		Run:             runKadane,
+	})
+	registry.Register(registry.Entry{
+		Name:            "dijkstra",
+		Category:        "graph",
+		TimeComplexity:  "O((V + E) log V)",
+		SpaceComplexity: "O(V)",
+		Describe:        "Dijkstra shortest paths: dsa run dijkstra --graph file.json --start 0",
+		Run:             runDijkstra,
+	})
+}
+
+// ReadNums returns the ints from raw (a comma-separated list) if non-empty,
+// otherwise reads a comma- or whitespace-separated list from stdin.
+func ReadNums(raw string) ([]int, error) {
+	if strings.TrimSpace(raw) == "" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("reading stdin: %w", err)
+		}
+		raw = string(data)
+	}
+	fields := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\t'
+	})
+	nums := make([]int, 0, len(fields))
+	for _, f := range fields {
+		n, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", f, err)
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+func runKMP(args []string) error {
+	fs := flag.NewFlagSet("kmp", flag.ExitOnError)
+	text := fs.String("text", "", "text to search within")
+	pattern := fs.String("pattern", "", "pattern to search for")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *pattern == "" {
+		return fmt.Errorf("--pattern is required")
+	}
+	for _, idx := range kmp.FindAll(*text, *pattern) {
+		fmt.Printf("Pattern found at index %d\n", idx)
+	}
+	return nil
+}
+
+func runBinarySearch(args []string) error {
+	fs := flag.NewFlagSet("binarysearch", flag.ExitOnError)
+	raw := fs.String("nums", "", "comma-separated sorted ints (reads stdin if omitted)")
+	target := fs.Int("target", 0, "value to search for")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	nums, err := ReadNums(*raw)
+	if err != nil {
+		return err
+	}
+	fmt.Println(binarysearch.BinarySearch(nums, *target))
+	return nil
+}
+
+func runLinearSearch(args []string) error {
+	fs := flag.NewFlagSet("linearsearch", flag.ExitOnError)
+	raw := fs.String("nums", "", "comma-separated ints (reads stdin if omitted)")
+	target := fs.Int("target", 0, "value to search for")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	nums, err := ReadNums(*raw)
+	if err != nil {
+		return err
+	}
+	fmt.Println(linearsearch.LinearSearch(nums, *target))
+	return nil
+}
+
+func runBubbleSort(args []string) error {
+	fs := flag.NewFlagSet("bubblesort", flag.ExitOnError)
+	raw := fs.String("nums", "", "comma-separated ints (reads stdin if omitted)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	nums, err := ReadNums(*raw)
+	if err != nil {
+		return err
+	}
+	bubblesort.BubbleSort(nums)
+	fmt.Println(joinInts(nums))
+	return nil
+}
+
+func runQuickSort(args []string) error {
+	fs := flag.NewFlagSet("quicksort", flag.ExitOnError)
+	raw := fs.String("nums", "", "comma-separated ints (reads stdin if omitted)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	nums, err := ReadNums(*raw)
+	if err != nil {
+		return err
+	}
+	if len(nums) > 0 {
+		quicksort.QuickSort(nums, 0, len(nums)-1)
+	}
+	fmt.Println(joinInts(nums))
+	return nil
+}
+
+func runMergeSort(args []string) error {
+	fs := flag.NewFlagSet("mergesort", flag.ExitOnError)
+	raw := fs.String("nums", "", "comma-separated ints (reads stdin if omitted)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	nums, err := ReadNums(*raw)
+	if err != nil {
+		return err
+	}
+	fmt.Println(joinInts(mergesort.MergeSort(nums)))
+	return nil
+}
+
+func runKadane(args []string) error {
+	fs := flag.NewFlagSet("kadane", flag.ExitOnError)
+	raw := fs.String("nums", "", "comma-separated ints (reads stdin if omitted)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	nums, err := ReadNums(*raw)
+	if err != nil {
+		return err
+	}
+	result, err := kadane.Kadane(nums)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+	return nil
+}
+
+// dijkstraGraph is the on-disk JSON shape accepted by --graph: one array
+// entry per vertex, each holding that vertex's outgoing edges.
+type dijkstraGraph [][]struct {
+	To     int `json:"to"`
+	Weight int `json:"weight"`
+}
+
+func runDijkstra(args []string) error {
+	fs := flag.NewFlagSet("dijkstra", flag.ExitOnError)
+	graphPath := fs.String("graph", "", `path to a JSON graph, e.g. [[{"to":1,"weight":4}],[]]`)
+	start := fs.Int("start", 0, "source vertex")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *graphPath == "" {
+		return fmt.Errorf("--graph is required")
+	}
+
+	data, err := os.ReadFile(*graphPath)
+	if err != nil {
+		return fmt.Errorf("reading graph file: %w", err)
+	}
+
+	var raw dijkstraGraph
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("parsing graph JSON: %w", err)
+	}
+
+	graph := make([][]dijkstra.Edge, len(raw))
+	for i, edges := range raw {
+		for _, e := range edges {
+			graph[i] = append(graph[i], dijkstra.Edge{To: e.To, Weight: e.Weight})
+		}
+	}
+
+	dist, err := dijkstra.Dijkstra(graph, *start)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	for v, d := range dist {
+		fmt.Fprintf(w, "To %d = %d\n", v, d)
+	}
+	return nil
+}
+
+// sizedRand is the single deterministic source every SizedRun below draws
+// from, so repeated runs at the same size do comparable work.
+var sizedRand = testutil.NewRand(1)
+
+// sizedRunKMP searches a repetitive alphabet so matches (and the
+// append-heavy result slice FindAll builds them into) scale with n, making
+// it a useful target for allocation profiling.
+func sizedRunKMP(n int) {
+	text := testutil.RandomString(sizedRand, n, "ab")
+	kmp.FindAll(text, "ab")
+}
+
+func sizedRunBubbleSort(n int) {
+	bubblesort.BubbleSort(testutil.RandomInts(sizedRand, n, n))
+}
+
+func sizedRunQuickSort(n int) {
+	nums := testutil.RandomInts(sizedRand, n, n)
+	if len(nums) > 0 {
+		quicksort.QuickSort(nums, 0, len(nums)-1)
+	}
+}
+
+func sizedRunMergeSort(n int) {
+	mergesort.MergeSort(testutil.RandomInts(sizedRand, n, n))
+}
+
+func sizedRunBinarySearch(n int) {
+	nums := mergesort.MergeSort(testutil.RandomInts(sizedRand, n, n))
+	binarysearch.BinarySearch(nums, nums[n/2])
+}
+
+func joinInts(nums []int) string {
+	parts := make([]string, len(nums))
+	for i, n := range nums {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,55 @@
+package algocli
+
+import (
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/registry"
+)
+
+func TestReadNums(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []int
+	}{
+		{"comma separated", "1,2,3", []int{1, 2, 3}},
+		{"with spaces", "1, 2, 3", []int{1, 2, 3}},
+		{"negatives", "-1,2,-3", []int{-1, 2, -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadNums(tt.in)
+			if err != nil {
+				t.Fatalf("ReadNums(%q) error = %v", tt.in, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ReadNums(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("ReadNums(%q) = %v, want %v", tt.in, got, tt.want)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid number", func(t *testing.T) {
+		if _, err := ReadNums("1,x,3"); err == nil {
+			t.Errorf("ReadNums(\"1,x,3\") should return an error")
+		}
+	})
+}
+
+func TestRegistryHasExpectedEntries(t *testing.T) {
+	all := registry.All()
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Name >= all[i].Name {
+			t.Errorf("registry.All() not sorted: %v", all)
+			break
+		}
+	}
+	if _, ok := registry.Lookup("kmp"); !ok {
+		t.Errorf("registry missing expected entry %q", "kmp")
+	}
+}
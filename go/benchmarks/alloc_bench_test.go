@@ -0,0 +1,79 @@
+// This file benchmarks the allocation-reduction APIs added alongside the
+// originals (FindAllAppend, BFS's pooled scratch buffers) against their
+// always-allocate counterparts. Run with -benchmem to see the allocs/op
+// difference; the *Reused variants should show zero or near-zero
+// allocations per op once the destination buffer is warmed up.
+package benchmarks
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/graph/bfs"
+	"github.com/ghostmkg/dsa-code/go/strings/kmp"
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+func BenchmarkKMPFindAllAlloc(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				kmp.FindAll(text, pattern)
+			}
+		})
+	}
+}
+
+func BenchmarkKMPFindAllAppendReused(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			var dst []int
+			for i := 0; i < b.N; i++ {
+				dst = kmp.FindAllAppend(dst[:0], text, pattern)
+			}
+		})
+	}
+}
+
+func BenchmarkBFSAlloc(b *testing.B) {
+	r := testutil.NewRand(3)
+	graph := adjacencyList(testutil.RandomConnectedGraph(r, 1000, 2000, 1))
+
+	// BFS prints every visited node; silence that for the duration of the
+	// benchmark so it measures allocation/CPU cost, not terminal I/O.
+	orig := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	os.Stdout = devNull
+	defer func() {
+		os.Stdout = orig
+		devNull.Close()
+	}()
+
+	for i := 0; i < b.N; i++ {
+		bfs.BFS(0, graph)
+	}
+}
+
+// adjacencyList converts testutil's weighted-edge list into the plain
+// [][]int adjacency form BFS expects.
+func adjacencyList(edges []testutil.WeightedEdge) [][]int {
+	n := 0
+	for _, e := range edges {
+		if e.From+1 > n {
+			n = e.From + 1
+		}
+		if e.To+1 > n {
+			n = e.To + 1
+		}
+	}
+	adj := make([][]int, n)
+	for _, e := range edges {
+		adj[e.From] = append(adj[e.From], e.To)
+	}
+	return adj
+}
@@ -0,0 +1,68 @@
+// This file benchmarks structures/bitvector.BitVector.Rank against a
+// naive popcount scan that walks every word from the start of the
+// vector on every call, to show what the two-level block index buys
+// over recomputing the count from scratch each time.
+package benchmarks
+
+import (
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/structures/bitvector"
+)
+
+var bitVectorSizes = []int{1000, 100_000, 1_000_000}
+
+func randomBits(n int) []bool {
+	r := rand.New(rand.NewSource(int64(n)))
+	data := make([]bool, n)
+	for i := range data {
+		data[i] = r.Intn(2) == 0
+	}
+	return data
+}
+
+// naivePopcountRank counts set bits in [0, i) by scanning every word
+// from the beginning, with no auxiliary index.
+func naivePopcountRank(words []uint64, i int) int {
+	count := 0
+	for w := 0; w < i/64; w++ {
+		count += bits.OnesCount64(words[w])
+	}
+	mask := uint64(1)<<uint(i%64) - 1
+	count += bits.OnesCount64(words[i/64] & mask)
+	return count
+}
+
+func toWords(data []bool) []uint64 {
+	words := make([]uint64, (len(data)+63)/64)
+	for i, b := range data {
+		if b {
+			words[i/64] |= 1 << uint(i%64)
+		}
+	}
+	return words
+}
+
+func BenchmarkBitVectorRank(b *testing.B) {
+	for _, n := range bitVectorSizes {
+		bv := bitvector.New(randomBits(n))
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bv.Rank(n - 1)
+			}
+		})
+	}
+}
+
+func BenchmarkNaivePopcountRank(b *testing.B) {
+	for _, n := range bitVectorSizes {
+		words := toWords(randomBits(n))
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naivePopcountRank(words, n-1)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package benchmarks
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/compression/arithmetic"
+	"github.com/ghostmkg/dsa-code/go/compression/huffman"
+)
+
+// skewedBytes returns a deterministic byte slice of n bytes where 'a'
+// dominates, the distribution Huffman handles worst (it's stuck paying
+// at least one bit per symbol no matter how lopsided the split is).
+func skewedBytes(n int) []byte {
+	r := rand.New(rand.NewSource(99))
+	data := make([]byte, n)
+	for i := range data {
+		if r.Float64() < 0.95 {
+			data[i] = 'a'
+		} else {
+			data[i] = byte('b' + r.Intn(4))
+		}
+	}
+	return data
+}
+
+var compressionInputSizes = []int{1000, 10000, 100000}
+
+func BenchmarkHuffmanEncode(b *testing.B) {
+	for _, n := range compressionInputSizes {
+		data := skewedBytes(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var buf bytes.Buffer
+				if err := huffman.Encode(&buf, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkArithmeticEncode(b *testing.B) {
+	for _, n := range compressionInputSizes {
+		data := skewedBytes(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var buf bytes.Buffer
+				if err := arithmetic.Encode(&buf, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// This file benchmarks fractionalcascading.FractionalCascading.SuccessorAll
+// against k independent sort.Search calls, to show the benefit of
+// cascading the search down through the levels instead of repeating a
+// full binary search in every list.
+package benchmarks
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/structures/fractionalcascading"
+)
+
+var fractionalCascadingListCounts = []int{4, 16, 64}
+
+const fractionalCascadingListSize = 1000
+
+func randomSortedLists(k, n int) [][]int {
+	r := rand.New(rand.NewSource(int64(k)*int64(n) + 7))
+	lists := make([][]int, k)
+	for i := range lists {
+		list := make([]int, n)
+		for j := range list {
+			list[j] = r.Intn(1 << 20)
+		}
+		sort.Ints(list)
+		lists[i] = list
+	}
+	return lists
+}
+
+func BenchmarkFractionalCascadingSuccessorAll(b *testing.B) {
+	for _, k := range fractionalCascadingListCounts {
+		lists := randomSortedLists(k, fractionalCascadingListSize)
+		fc := fractionalcascading.New(lists)
+		b.Run(sizeName(k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				fc.SuccessorAll(i % (1 << 20))
+			}
+		})
+	}
+}
+
+func BenchmarkIndependentBinarySearches(b *testing.B) {
+	for _, k := range fractionalCascadingListCounts {
+		lists := randomSortedLists(k, fractionalCascadingListSize)
+		b.Run(sizeName(k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x := i % (1 << 20)
+				for _, list := range lists {
+					sort.Search(len(list), func(j int) bool { return list[j] >= x })
+				}
+			}
+		})
+	}
+}
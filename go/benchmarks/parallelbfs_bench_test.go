@@ -0,0 +1,54 @@
+// This file benchmarks parallelbfs.ParallelBFS and
+// parallelbfs.ParallelConnectedComponents against large random graphs,
+// up to a million vertices and several million edges, to show how they
+// scale as edge count grows into the millions.
+package benchmarks
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/parallelbfs"
+)
+
+var parallelBFSVertexCounts = []int{10_000, 100_000, 1_000_000}
+
+const parallelBFSWorkers = 8
+
+// randomParallelBFSGraph returns a graph over n vertices with roughly
+// 4*n random edges, guaranteed connected via a random spanning structure
+// (each vertex i>0 gets an edge to some earlier vertex) plus extra random
+// edges, so a million-vertex graph carries several million edges.
+func randomParallelBFSGraph(n int) *parallelbfs.Graph {
+	r := rand.New(rand.NewSource(int64(n)))
+	g := parallelbfs.NewGraph(n)
+	for v := 1; v < n; v++ {
+		g.AddEdge(r.Intn(v), v)
+	}
+	for i := 0; i < 3*n; i++ {
+		g.AddEdge(r.Intn(n), r.Intn(n))
+	}
+	return g
+}
+
+func BenchmarkParallelBFS(b *testing.B) {
+	for _, n := range parallelBFSVertexCounts {
+		g := randomParallelBFSGraph(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				parallelbfs.ParallelBFS(g, 0, parallelBFSWorkers)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelConnectedComponents(b *testing.B) {
+	for _, n := range parallelBFSVertexCounts {
+		g := randomParallelBFSGraph(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				parallelbfs.ParallelConnectedComponents(g, parallelBFSWorkers)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// This file benchmarks parallelscan.ParallelScan against
+// parallelscan.SequentialScan, to show what splitting the scan across
+// workers buys once input length clears SequentialScanThreshold.
+package benchmarks
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/parallelscan"
+)
+
+var parallelScanInputSizes = []int{1_000, 100_000, 10_000_000}
+
+const parallelScanWorkers = 8
+
+func randomScanInput(n int) []int {
+	r := rand.New(rand.NewSource(int64(n)))
+	out := make([]int, n)
+	for i := range out {
+		out[i] = r.Intn(1000)
+	}
+	return out
+}
+
+func addInts(a, b int) int { return a + b }
+
+func BenchmarkParallelScan(b *testing.B) {
+	for _, n := range parallelScanInputSizes {
+		in := randomScanInput(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				parallelscan.ParallelScan(in, parallelScanWorkers, addInts)
+			}
+		})
+	}
+}
+
+func BenchmarkSequentialScan(b *testing.B) {
+	for _, n := range parallelScanInputSizes {
+		in := randomScanInput(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				parallelscan.SequentialScan(in, addInts)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// This file benchmarks structures/phashmap.Map's structural-sharing Set
+// against the "copy the whole built-in map, then set" approach a naive
+// immutable-map implementation would use, to show how much the sharing
+// saves as the map grows.
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/structures/phashmap"
+)
+
+var phashmapSizes = []int{100, 1000, 10000}
+
+func BenchmarkPHashMapSet(b *testing.B) {
+	for _, n := range phashmapSizes {
+		m := phashmap.New[int, int](phashmap.HashInt)
+		for i := 0; i < n; i++ {
+			m = m.Set(i, i)
+		}
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				m.Set(0, i)
+			}
+		})
+	}
+}
+
+func BenchmarkBuiltinMapCopyOnSet(b *testing.B) {
+	for _, n := range phashmapSizes {
+		base := make(map[int]int, n)
+		for i := 0; i < n; i++ {
+			base[i] = i
+		}
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copied := make(map[int]int, len(base))
+				for k, v := range base {
+					copied[k] = v
+				}
+				copied[0] = i
+			}
+		})
+	}
+}
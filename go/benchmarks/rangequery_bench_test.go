@@ -0,0 +1,54 @@
+// This file benchmarks structures/rangequery.DisjointSparseTable's query
+// time against the regular SparseTable's, to show that lifting the
+// restriction to idempotent ops doesn't cost anything at query time —
+// both are O(1) lookups once built.
+package benchmarks
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/structures/rangequery"
+)
+
+var rangeQuerySizes = []int{100, 1000, 10000}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func randomData(n int) []int {
+	r := rand.New(rand.NewSource(int64(n)))
+	data := make([]int, n)
+	for i := range data {
+		data[i] = r.Intn(1 << 20)
+	}
+	return data
+}
+
+func BenchmarkSparseTableQuery(b *testing.B) {
+	for _, n := range rangeQuerySizes {
+		data := randomData(n)
+		st := rangequery.NewSparseTable(data, minInt)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				st.Query(0, n-1)
+			}
+		})
+	}
+}
+
+func BenchmarkDisjointSparseTableQuery(b *testing.B) {
+	for _, n := range rangeQuerySizes {
+		data := randomData(n)
+		dst := rangequery.NewDisjointSparseTable(data, minInt)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dst.Query(0, n-1)
+			}
+		})
+	}
+}
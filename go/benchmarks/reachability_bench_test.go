@@ -0,0 +1,75 @@
+// This file benchmarks reachability.Index.Reachable against a plain
+// per-query BFS, to show the benefit of paying for transitive-closure
+// preprocessing once instead of re-exploring the graph on every query.
+package benchmarks
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/structures/reachability"
+)
+
+var reachabilityNodeCounts = []int{100, 500, 2000}
+
+func randomDAG(n int, edgeProb float64, seed int64) [][]int {
+	r := rand.New(rand.NewSource(seed))
+	adj := make([][]int, n)
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if r.Float64() < edgeProb {
+				adj[u] = append(adj[u], v)
+			}
+		}
+	}
+	return adj
+}
+
+func bfsReachable(n int, adj [][]int, u, v int) bool {
+	if u == v {
+		return true
+	}
+	visited := make([]bool, n)
+	queue := []int{u}
+	visited[u] = true
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, w := range adj[cur] {
+			if w == v {
+				return true
+			}
+			if !visited[w] {
+				visited[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+	return false
+}
+
+func BenchmarkReachabilityIndexQuery(b *testing.B) {
+	for _, n := range reachabilityNodeCounts {
+		adj := randomDAG(n, 0.05, int64(n))
+		idx, err := reachability.New(n, adj)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				idx.Reachable(i%n, (i*7+3)%n)
+			}
+		})
+	}
+}
+
+func BenchmarkReachabilityPerQueryBFS(b *testing.B) {
+	for _, n := range reachabilityNodeCounts {
+		adj := randomDAG(n, 0.05, int64(n))
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bfsReachable(n, adj, i%n, (i*7+3)%n)
+			}
+		})
+	}
+}
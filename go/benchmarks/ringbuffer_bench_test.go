@@ -0,0 +1,44 @@
+// This file benchmarks ringbuffer.SPSCRingBuffer's single-producer/
+// single-consumer throughput against an equivalent buffered channel, to
+// show what avoiding the channel's internal locking buys.
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/ringbuffer"
+)
+
+const ringBufferBenchCapacity = 1024
+
+func BenchmarkSPSCRingBufferThroughput(b *testing.B) {
+	r := ringbuffer.NewSPSCRingBuffer(ringBufferBenchCapacity)
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < b.N; i++ {
+			for !r.Push(i) {
+			}
+		}
+		close(done)
+	}()
+	for i := 0; i < b.N; i++ {
+		for {
+			if _, ok := r.Pop(); ok {
+				break
+			}
+		}
+	}
+	<-done
+}
+
+func BenchmarkBufferedChannelThroughput(b *testing.B) {
+	ch := make(chan int, ringBufferBenchCapacity)
+	go func() {
+		for i := 0; i < b.N; i++ {
+			ch <- i
+		}
+		close(ch)
+	}()
+	for range ch {
+	}
+}
@@ -0,0 +1,105 @@
+// Package benchmarks compares alternative implementations of the same
+// problem against standardized inputs, so `go test -bench . -benchmem` (or
+// benchstat across two runs) gives an apples-to-apples comparison instead of
+// each package benchmarking itself in isolation against its own fixture.
+package benchmarks
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/sorting/bubblesort"
+	"github.com/ghostmkg/dsa-code/go/sorting/countingsort"
+	"github.com/ghostmkg/dsa-code/go/sorting/introsort"
+	"github.com/ghostmkg/dsa-code/go/sorting/mergesort"
+	"github.com/ghostmkg/dsa-code/go/sorting/quicksort"
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+// sizeName formats an input size as a benchmark sub-name, e.g. "n=1000".
+func sizeName(n int) string {
+	return fmt.Sprintf("n=%d", n)
+}
+
+// sortInputSizes are the input sizes every sort benchmark runs at, so
+// benchstat output lines up across implementations and across runs.
+var sortInputSizes = []int{100, 1000, 10000}
+
+// randomInts returns a fresh, deterministic slice of n ints each time it's
+// called, so b.N iterations don't all sort the same (already-sorted) slice.
+func randomInts(n int) []int {
+	r := testutil.NewRand(42)
+	return testutil.RandomInts(r, n, n)
+}
+
+// randomNonNegInts is like randomInts but only ever non-negative, since
+// CountingSort indexes directly by value and panics on negatives.
+func randomNonNegInts(n int) []int {
+	in := randomInts(n)
+	for i, v := range in {
+		if v < 0 {
+			in[i] = -v
+		}
+	}
+	return in
+}
+
+func BenchmarkBubbleSort(b *testing.B) {
+	for _, n := range sortInputSizes {
+		in := randomInts(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bubblesort.BubbleSort(slices.Clone(in))
+			}
+		})
+	}
+}
+
+func BenchmarkQuickSort(b *testing.B) {
+	for _, n := range sortInputSizes {
+		in := randomInts(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				arr := slices.Clone(in)
+				quicksort.QuickSort(arr, 0, len(arr)-1)
+			}
+		})
+	}
+}
+
+func BenchmarkMergeSort(b *testing.B) {
+	for _, n := range sortInputSizes {
+		in := randomInts(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				mergesort.MergeSort(slices.Clone(in))
+			}
+		})
+	}
+}
+
+func BenchmarkIntroSort(b *testing.B) {
+	for _, n := range sortInputSizes {
+		in := randomInts(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				arr := slices.Clone(in)
+				introsort.Introsort(arr)
+			}
+		})
+	}
+}
+
+func BenchmarkCountingSort(b *testing.B) {
+	// CountingSort only supports non-negative input (it indexes directly by
+	// value), so it gets its own generator instead of the shared randomInts.
+	for _, n := range sortInputSizes {
+		in := randomNonNegInts(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				countingsort.CountingSort(slices.Clone(in))
+			}
+		})
+	}
+}
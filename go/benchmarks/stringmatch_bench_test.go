@@ -0,0 +1,101 @@
+package benchmarks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/parallelkmp"
+	"github.com/ghostmkg/dsa-code/go/strings/kmp"
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+// textSizes are the haystack sizes every string-matching benchmark runs
+// against; the needle is always a short substring pulled out of the text so
+// every implementation has exactly one match to find.
+var textSizes = []int{1000, 10000, 100000}
+
+// textAndPattern returns a deterministic text of length n together with a
+// pattern guaranteed to occur near its end, so every benchmarked matcher
+// does comparable work.
+func textAndPattern(n int) (text, pattern string) {
+	r := testutil.NewRand(7)
+	text = testutil.RandomString(r, n, "ab")
+	pattern = text[n-8:]
+	return text, pattern
+}
+
+// naiveFindAll is the textbook O(n*m) substring search: the baseline every
+// smarter algorithm here is expected to beat.
+func naiveFindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	var matches []int
+	for i := 0; i+m <= n; i++ {
+		if text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// This repo only has KMP-family implementations (strings/kmp and its
+// parallel variant in concurrency/parallelkmp), plus the naive baseline
+// above and the stdlib for reference. There's no Rabin-Karp or Boyer-Moore
+// implementation to benchmark here; add one to this file if that changes.
+
+func BenchmarkNaiveFindAll(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naiveFindAll(text, pattern)
+			}
+		})
+	}
+}
+
+func BenchmarkKMPFindAll(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				kmp.FindAll(text, pattern)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelKMPStringMatcher(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				parallelkmp.KMPStringMatcher(text, pattern)
+			}
+		})
+	}
+}
+
+func BenchmarkParallelKMPSearch(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				parallelkmp.ParallelSearch(text, pattern, 4)
+			}
+		})
+	}
+}
+
+func BenchmarkStdlibIndex(b *testing.B) {
+	for _, n := range textSizes {
+		text, pattern := textAndPattern(n)
+		b.Run(sizeName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				strings.Index(text, pattern)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/ahocorasick"
+)
+
+func main() {
+	patterns := []string{"he", "she", "his", "hers"}
+	m := ahocorasick.New(patterns)
+
+	text := "ushers"
+	for _, match := range m.Search(text) {
+		fmt.Printf("pattern %q matched at [%d,%d)\n", patterns[match.PatternID], match.Start, match.End)
+	}
+}
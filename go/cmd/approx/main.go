@@ -0,0 +1,40 @@
+// Command approx demonstrates the graph/approx package's greedy set
+// cover, matching-based vertex cover, and MST-doubling metric TSP.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/approx"
+)
+
+func main() {
+	universe := []int{1, 2, 3, 4, 5}
+	sets := [][]int{
+		{1, 2, 3},
+		{2, 4},
+		{3, 4},
+		{4, 5},
+	}
+	fmt.Println("set cover chose sets:", approx.SetCover(universe, sets))
+
+	edges := []approx.Edge{
+		{U: 0, V: 1}, {U: 1, V: 2}, {U: 2, V: 3}, {U: 3, V: 0}, {U: 0, V: 2},
+	}
+	fmt.Println("vertex cover:", approx.VertexCover(edges, 4))
+
+	points := []float64{0, 1, 4, 9, 10}
+	n := len(points)
+	dist := make([][]float64, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		for j := range dist[i] {
+			d := points[i] - points[j]
+			if d < 0 {
+				d = -d
+			}
+			dist[i][j] = d
+		}
+	}
+	fmt.Println("TSP tour:", approx.MetricTSP2Approx(dist))
+}
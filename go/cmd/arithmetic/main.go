@@ -0,0 +1,48 @@
+// Command arithmetic demonstrates compression/arithmetic by compressing
+// a skewed byte stream and comparing the result against
+// compression/huffman on the same input.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+
+	"github.com/ghostmkg/dsa-code/go/compression/arithmetic"
+	"github.com/ghostmkg/dsa-code/go/compression/huffman"
+)
+
+func main() {
+	r := rand.New(rand.NewSource(1))
+	data := make([]byte, 10000)
+	for i := range data {
+		if r.Float64() < 0.95 {
+			data[i] = 'a'
+		} else {
+			data[i] = byte('b' + r.Intn(4))
+		}
+	}
+
+	var arith bytes.Buffer
+	if err := arithmetic.Encode(&arith, data); err != nil {
+		fmt.Println("arithmetic encode error:", err)
+		return
+	}
+	arithSize := arith.Len()
+	decoded, err := arithmetic.Decode(&arith)
+	if err != nil {
+		fmt.Println("arithmetic decode error:", err)
+		return
+	}
+	fmt.Println("arithmetic round trip ok:", bytes.Equal(decoded, data))
+
+	var huff bytes.Buffer
+	if err := huffman.Encode(&huff, data); err != nil {
+		fmt.Println("huffman encode error:", err)
+		return
+	}
+
+	fmt.Printf("original: %d bytes\n", len(data))
+	fmt.Printf("arithmetic: %d bytes\n", arithSize)
+	fmt.Printf("huffman: %d bytes\n", huff.Len())
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/astar"
+)
+
+func main() {
+	grid := [][]int{
+		{0, 0, 0, 0, 0},
+		{0, 1, 1, 1, 0},
+		{0, 0, 0, 0, 0},
+		{0, 1, 0, 1, 0},
+		{0, 0, 0, 0, 0},
+	}
+
+	start := astar.Point{X: 0, Y: 0}
+	goal := astar.Point{X: 4, Y: 4}
+
+	path := astar.AStar(grid, start, goal)
+
+	if path != nil {
+		fmt.Println("Path found:")
+		for _, p := range path {
+			fmt.Printf("(%d,%d) ", p.X, p.Y)
+		}
+		fmt.Printf("\nSteps: %d\n", len(path)-1)
+	} else {
+		fmt.Println("No path found")
+	}
+
+	cost := [][]float64{
+		{1, 1, 1, 1, 1},
+		{1, 0, 0, 0, 1},
+		{1, 1, 3, 1, 1},
+		{1, 0, 1, 0, 1},
+		{1, 1, 1, 1, 1},
+	}
+	result := astar.AStarWeighted(cost, start, goal, astar.Options{Diagonal: true, Smooth: true})
+	fmt.Println("\nWeighted, diagonal, smoothed path:")
+	for _, p := range result.Path {
+		fmt.Printf("(%d,%d) ", p.X, p.Y)
+	}
+	fmt.Printf("\nWaypoints: %d, nodes explored: %d\n", len(result.Path), len(result.Explored))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/backtrack"
+)
+
+func main() {
+	fmt.Println("8-queens solution count:", backtrack.CountNQueens(8))
+
+	board := [9][9]int{
+		{5, 3, 0, 0, 7, 0, 0, 0, 0},
+		{6, 0, 0, 1, 9, 5, 0, 0, 0},
+		{0, 9, 8, 0, 0, 0, 0, 6, 0},
+		{8, 0, 0, 0, 6, 0, 0, 0, 3},
+		{4, 0, 0, 8, 0, 3, 0, 0, 1},
+		{7, 0, 0, 0, 2, 0, 0, 0, 6},
+		{0, 6, 0, 0, 0, 0, 2, 8, 0},
+		{0, 0, 0, 4, 1, 9, 0, 0, 5},
+		{0, 0, 0, 0, 8, 0, 0, 7, 9},
+	}
+	if backtrack.SolveSudoku(&board) {
+		fmt.Println("solved sudoku, first row:", board[0])
+	}
+
+	fmt.Println("permutations of [1 2 3]:", backtrack.Permutations([]int{1, 2, 3}))
+	fmt.Println("2-combinations of [1 2 3 4]:", backtrack.Combinations([]int{1, 2, 3, 4}, 2))
+}
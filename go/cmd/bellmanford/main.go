@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/bellmanford"
+)
+
+func main() {
+	V := 5 // Number of vertices
+	edges := []bellmanford.Edge{
+		{From: 0, To: 1, Weight: -1},
+		{From: 0, To: 2, Weight: 4},
+		{From: 1, To: 2, Weight: 3},
+		{From: 1, To: 3, Weight: 2},
+		{From: 1, To: 4, Weight: 2},
+		{From: 3, To: 2, Weight: 5},
+		{From: 3, To: 1, Weight: 1},
+		{From: 4, To: 3, Weight: -3},
+	}
+
+	start := 0
+	dist, hasNegativeCycle := bellmanford.BellmanFord(edges, V, start)
+
+	if hasNegativeCycle {
+		fmt.Println("Graph contains a negative weight cycle")
+	} else {
+		fmt.Println("Shortest distances from node", start, ":")
+		for i, d := range dist {
+			fmt.Printf("To %d = %d\n", i, d)
+		}
+	}
+}
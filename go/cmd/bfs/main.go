@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/bfs"
+)
+
+func main() {
+	graph := [][]int{
+		{1, 2},    // 0 → 1, 2
+		{0, 3, 4}, // 1 → 0, 3, 4
+		{0, 5},    // 2 → 0, 5
+		{1},       // 3 → 1
+		{1, 5},    // 4 → 1, 5
+		{2, 4},    // 5 → 2, 4
+	}
+
+	fmt.Print("BFS traversal: ")
+	bfs.BFS(0, graph)
+	fmt.Println()
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/binarylifting"
+)
+
+func main() {
+	domain := []int{0, 1, 2, 3, 4, 5}
+	parent := func(v int) int {
+		if v == 0 {
+			return 0
+		}
+		return v - 1
+	}
+	bl := binarylifting.New(domain, parent)
+
+	fmt.Println("5 lifted 3 steps:", bl.KthApply(5, 3))
+
+	ancestor, steps, found := bl.FindFirst(5, func(v int) bool { return v <= 2 })
+	fmt.Println("first ancestor <= 2:", ancestor, "in", steps, "steps, found:", found)
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/searching/binarysearch"
+)
+
+func main() {
+	sortedArr := []int{2, 5, 8, 12, 16, 23, 38, 56, 72, 91}
+	t1 := 23
+	t2 := 10
+
+	index1 := binarysearch.BinarySearch(sortedArr, t1)
+	index2 := binarysearch.BinarySearch(sortedArr, t2)
+
+	fmt.Printf("Searching for %d in array: %v\n", t1, sortedArr)
+	fmt.Printf("Found %d at index: %d\n", t1, index1)
+
+	fmt.Printf("Searching for %d in array: %v\n", t2, sortedArr)
+	fmt.Printf("Found %d at index: %d\n", t2, index2)
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/tree/bintreeheight"
+)
+
+func main() {
+	var root *bintreeheight.Node[int]
+	values := []int{10, 5, 20, 3, 7, 15}
+
+	for _, v := range values {
+		root = bintreeheight.Insert(root, v)
+	}
+
+	fmt.Println("Height of tree:", bintreeheight.Height(root))
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/tree/bintreelevelorder"
+)
+
+func main() {
+	var root *bintreelevelorder.Node[int]
+	values := []int{10, 5, 20, 3, 7, 15, 25}
+
+	for _, v := range values {
+		root = bintreelevelorder.Insert(root, v)
+	}
+
+	fmt.Print("Level Order Traversal: ")
+	bintreelevelorder.LevelOrder(root)
+	fmt.Println()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/tree/bintreetraversal"
+)
+
+func main() {
+	// Create a simple binary tree
+	root := &bintreetraversal.Node{Value: 1}
+	root.Left = &bintreetraversal.Node{Value: 2}
+	root.Right = &bintreetraversal.Node{Value: 3}
+	root.Left.Left = &bintreetraversal.Node{Value: 4}
+	root.Left.Right = &bintreetraversal.Node{Value: 5}
+
+	fmt.Print("Pre-order: ")
+	bintreetraversal.PreOrder(root)
+	fmt.Println()
+
+	fmt.Print("In-order: ")
+	bintreetraversal.InOrder(root)
+	fmt.Println()
+
+	fmt.Print("Post-order: ")
+	bintreetraversal.PostOrder(root)
+	fmt.Println()
+
+	fmt.Print("Level-order: ")
+	bintreetraversal.LevelOrder(root)
+	fmt.Println()
+
+	// Build a BST and demonstrate the same traversals over it
+	var bst *bintreetraversal.Node
+	values := []int{10, 5, 20, 3, 7, 15, 25}
+
+	for _, v := range values {
+		bst = bintreetraversal.Insert(bst, v)
+	}
+
+	fmt.Print("Inorder: ")
+	bintreetraversal.Inorder(bst)
+	fmt.Println()
+
+	fmt.Print("Preorder: ")
+	bintreetraversal.Preorder(bst)
+	fmt.Println()
+
+	fmt.Print("Postorder: ")
+	bintreetraversal.Postorder(bst)
+	fmt.Println()
+}
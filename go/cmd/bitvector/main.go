@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/bitvector"
+)
+
+func main() {
+	bv := bitvector.New([]bool{true, false, true, true, false, true})
+
+	fmt.Println("Rank(4) =", bv.Rank(4))
+	for k := 0; k < 4; k++ {
+		pos, ok := bv.Select(k)
+		fmt.Printf("Select(%d) = %d, %v\n", k, pos, ok)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/blockingqueue"
+)
+
+func main() {
+	q := blockingqueue.NewBoundedBlockingQueue[int](2)
+	q.Put(1)
+	q.Put(2)
+
+	if err := q.PutTimeout(3, 50*time.Millisecond); err != nil {
+		fmt.Println("put timed out as expected:", err)
+	}
+
+	v, _ := q.Get()
+	fmt.Println("get:", v)
+	v, _ = q.Get()
+	fmt.Println("get:", v)
+
+	if _, err := q.GetTimeout(50 * time.Millisecond); err != nil {
+		fmt.Println("get timed out as expected:", err)
+	}
+}
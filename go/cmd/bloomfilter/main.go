@@ -0,0 +1,20 @@
+// Command bloomfilter demonstrates the structures/bloomfilter package.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/bloomfilter"
+)
+
+func main() {
+	f := bloomfilter.New(1000, 0.01)
+	for _, w := range []string{"apple", "banana", "cherry"} {
+		f.Add(w)
+	}
+
+	for _, w := range []string{"apple", "grape"} {
+		fmt.Printf("Test(%q) = %v\n", w, f.Test(w))
+	}
+	fmt.Println("estimated false-positive rate:", f.EstimatedFalsePositiveRate())
+}
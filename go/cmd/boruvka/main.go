@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/boruvka"
+)
+
+func main() {
+	edges := []boruvka.BoruvkaEdge{
+		{U: 0, V: 1, Weight: 4}, {U: 0, V: 2, Weight: 4}, {U: 1, V: 2, Weight: 2}, {U: 1, V: 0, Weight: 4},
+		{U: 2, V: 3, Weight: 3}, {U: 2, V: 5, Weight: 2}, {U: 2, V: 4, Weight: 4}, {U: 3, V: 4, Weight: 3},
+		{U: 5, V: 4, Weight: 3}, {U: 5, V: 6, Weight: 6}, {U: 4, V: 6, Weight: 7},
+	}
+	mst, weight := boruvka.ParallelBoruvkaMST(7, edges, 4)
+	fmt.Println("MST edges:", mst)
+	fmt.Println("total weight:", weight)
+}
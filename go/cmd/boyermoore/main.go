@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ghostmkg/dsa-code/go/strings/boyermoore"
+	"github.com/ghostmkg/dsa-code/go/strings/kmp"
+)
+
+// Boyer-Moore's bad-character rule can skip large stretches of text
+// when the alphabet is skewed (a few characters dominate, so a
+// mismatch is very informative), while KMP always advances the text
+// pointer by checking every byte at least once. This demo searches a
+// pattern against a long, heavily repetitive text to show the gap.
+func main() {
+	text := strings.Repeat("a", 500_000) + "b"
+	pattern := "aaaaaaaaaaaaaaaaaaaab"
+
+	start := time.Now()
+	bmMatches := boyermoore.FindAll(text, pattern)
+	bmElapsed := time.Since(start)
+
+	start = time.Now()
+	kmpMatches := kmp.FindAll(text, pattern)
+	kmpElapsed := time.Since(start)
+
+	fmt.Printf("Boyer-Moore: %d match(es) in %s\n", len(bmMatches), bmElapsed)
+	fmt.Printf("KMP:         %d match(es) in %s\n", len(kmpMatches), kmpElapsed)
+}
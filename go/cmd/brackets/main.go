@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/brackets"
+)
+
+func main() {
+	expr := "(a + [b * c])"
+	fmt.Printf("IsBalanced(%q) = %v\n", expr, brackets.IsBalanced(expr))
+
+	unbalanced := "()))"
+	fmt.Printf("MinInsertions(%q) = %d\n", unbalanced, brackets.MinInsertions(unbalanced))
+
+	withJunk := "lee(t(c)o)de)"
+	removed, cleaned := brackets.MinRemovals(withJunk)
+	fmt.Printf("MinRemovals(%q) = %d removal(s), result %q\n", withJunk, removed, cleaned)
+
+	longest := ")()())"
+	fmt.Printf("LongestValidParentheses(%q) = %d\n", longest, brackets.LongestValidParentheses(longest))
+
+	scored := "(()(()))"
+	fmt.Printf("ScoreOfParentheses(%q) = %d\n", scored, brackets.ScoreOfParentheses(scored))
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/sorting/bubblesort"
+)
+
+func main() {
+	arr := []int{64, 34, 25, 12, 22, 11, 90}
+	fmt.Println("Original array:", arr)
+
+	bubblesort.BubbleSort(arr)
+	fmt.Println("Sorted array:  ", arr)
+}
@@ -0,0 +1,27 @@
+// Command cache demonstrates the structures/cache package by replaying
+// an access trace against several eviction policies and printing each
+// one's hit rate.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/cache"
+)
+
+func main() {
+	trace := []int{1, 2, 3, 1, 4, 5, 1, 2, 3, 4, 5, 1, 1, 2}
+
+	results := cache.Simulate(trace, map[string]cache.Cache[int, int]{
+		"LRU":   cache.NewLRU[int, int](3),
+		"LFU":   cache.NewLFU[int, int](3),
+		"LRU-K": cache.NewLRUK[int, int](3, 2),
+		"CLOCK": cache.NewClock[int, int](3),
+		"ARC":   cache.NewARC[int, int](3),
+	})
+
+	for _, name := range []string{"LRU", "LFU", "LRU-K", "CLOCK", "ARC"} {
+		r := results[name]
+		fmt.Printf("%-5s hits=%d misses=%d hitRate=%.2f\n", name, r.Hits, r.Misses, r.HitRate())
+	}
+}
@@ -0,0 +1,25 @@
+// Command clustering demonstrates K-means and DBSCAN from the
+// structures/clustering package on a small set of 2D points.
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/ghostmkg/dsa-code/go/structures/clustering"
+)
+
+func main() {
+	points := []clustering.Point{
+		{0, 0}, {0.5, 0.5}, {1, 0},
+		{10, 10}, {10.5, 10.5}, {11, 10},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	assignments, centroids := clustering.KMeans(points, 2, rng, 50)
+	fmt.Println("k-means assignments:", assignments)
+	fmt.Println("k-means centroids:", centroids)
+
+	labels := clustering.DBSCAN(points, 1.0, 2)
+	fmt.Println("dbscan labels:", labels)
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/dawg"
+)
+
+func main() {
+	words := []string{"car", "card", "care", "cared", "cars", "cart"}
+	d, err := dawg.Build(words)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	fmt.Println("Contains(card):", d.Contains("card"))
+	fmt.Println("WithPrefix(car):", d.WithPrefix("car"))
+	fmt.Printf("trie nodes: %d, dawg nodes: %d\n", d.TrieNodeCount(), d.NodeCount())
+}
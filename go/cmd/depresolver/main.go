@@ -0,0 +1,23 @@
+// Command depresolver demonstrates resolving a package dependency graph
+// into an install order with the structures/depresolver package.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/depresolver"
+)
+
+func main() {
+	r := depresolver.New()
+	r.AddDependency("app", "lib")
+	r.AddDependency("app", "config")
+	r.AddDependency("lib", "base")
+
+	order, err := r.Resolve()
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("install order:", order)
+}
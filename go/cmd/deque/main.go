@@ -0,0 +1,25 @@
+// Command deque demonstrates structures/deque, a growable ring-buffer
+// double-ended queue.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/deque"
+)
+
+func main() {
+	d := deque.New[int]()
+	d.PushBack(1)
+	d.PushBack(2)
+	d.PushFront(0)
+
+	fmt.Println("deque front to back:")
+	for i := 0; i < d.Len(); i++ {
+		fmt.Println(d.At(i))
+	}
+
+	front, _ := d.PopFront()
+	back, _ := d.PopBack()
+	fmt.Printf("PopFront() = %d, PopBack() = %d, remaining length = %d\n", front, back, d.Len())
+}
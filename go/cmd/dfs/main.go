@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/dfs"
+)
+
+func main() {
+	graph := [][]int{
+		{1, 2},    // 0 → 1, 2
+		{0, 3, 4}, // 1 → 0, 3, 4
+		{0, 5},    // 2 → 0, 5
+		{1},       // 3 → 1
+		{1, 5},    // 4 → 1, 5
+		{2, 4},    // 5 → 2, 4
+	}
+
+	n := len(graph)
+	visited := make([]bool, n)
+
+	fmt.Print("DFS traversal: ")
+	dfs.DFS(0, visited, graph)
+	fmt.Println()
+}
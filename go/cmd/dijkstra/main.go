@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ghostmkg/dsa-code/go/graph/dijkstra"
+)
+
+func main() {
+	// Example graph (undirected):
+	// 0 --4--> 1
+	// 0 --1--> 2
+	// 2 --2--> 1
+	// 1 --1--> 3
+	// 2 --5--> 3
+	graph := [][]dijkstra.Edge{
+		{{To: 1, Weight: 4}, {To: 2, Weight: 1}},                     // edges from 0
+		{{To: 0, Weight: 4}, {To: 2, Weight: 2}, {To: 3, Weight: 1}}, // edges from 1
+		{{To: 0, Weight: 1}, {To: 1, Weight: 2}, {To: 3, Weight: 5}}, // edges from 2
+		{{To: 1, Weight: 1}, {To: 2, Weight: 5}},                     // edges from 3
+	}
+
+	start := 0
+	dist, err := dijkstra.Dijkstra(graph, start)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Shortest distances from node %d:\n", start)
+	for i, d := range dist {
+		fmt.Printf("To %d = %d\n", i, d)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ghostmkg/dsa-code/go/misc/distributedsim"
+)
+
+func main() {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	rateLimiter := distributedsim.NewRateLimiter(5, time.Second) // 5 requests/sec
+	coordinator := distributedsim.NewCoordinator()
+	crawler := distributedsim.NewCrawler(rateLimiter, coordinator)
+
+	urls := []string{
+		"https://golang.org",
+		"https://github.com",
+		"https://example.com",
+		"https://google.com",
+		"https://stackoverflow.com",
+	}
+
+	results := crawler.Crawl(ctx, urls)
+
+	fmt.Println("Crawl results:")
+	for res := range results {
+		fmt.Println(res)
+	}
+}
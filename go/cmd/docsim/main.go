@@ -0,0 +1,23 @@
+// Command docsim demonstrates strings/docsim by reporting the common
+// passages between two short documents, as a plagiarism checker might.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/docsim"
+)
+
+func main() {
+	a := "the quick brown fox jumps over the lazy dog"
+	b := "a quick brown fox leaps over a sleepy dog"
+
+	matches, err := docsim.Compare(a, b, 4)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	for _, m := range matches {
+		fmt.Printf("shared %q (len %d) at a[%d], b[%d]\n", m.Text, m.Len, m.APos, m.BPos)
+	}
+}
@@ -0,0 +1,50 @@
+// Command dsa-server exposes the algorithms registered with the registry
+// package as JSON-over-HTTP endpoints, so the collection can back a web
+// demo/playground instead of only being runnable from the dsa CLI.
+//
+// POST /run/<algorithm> with a JSON body mapping the algorithm's CLI flag
+// names to string values (e.g. {"nums": "5,3,1"} for bubblesort, which
+// normally takes --nums) runs that algorithm and returns its captured
+// stdout as {"output": "..."}, or {"error": "..."} on failure.
+//
+// dijkstra is a special case: its CLI flag --graph takes a file path, so
+// the HTTP body instead takes the graph directly as {"graph": [...],
+// "start": "0"} and the server writes it to a temp file itself.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	_ "github.com/ghostmkg/dsa-code/go/algocli"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/run/", runHandler)
+	mux.HandleFunc("/algorithms", algorithmsHandler)
+
+	log.Printf("dsa-server listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// execTimeout bounds how long a single /run request may take, so a
+// pathological input (or an infinite loop in a future algorithm) can't
+// hang the server indefinitely.
+const execTimeout = 5 * time.Second
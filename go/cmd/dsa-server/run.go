@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghostmkg/dsa-code/go/registry"
+)
+
+// stdoutMu serializes access to the process-wide os.Stdout swap in
+// runWithTimeout, since concurrent requests would otherwise race on it.
+var stdoutMu sync.Mutex
+
+// algorithmsHandler lists every runnable algorithm, so a UI can discover
+// what /run/<name> endpoints exist without hard-coding them.
+func algorithmsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "GET only")
+		return
+	}
+	var names []string
+	for _, e := range registry.All() {
+		if e.Run != nil {
+			names = append(names, e.Name)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"algorithms": names})
+}
+
+// runHandler executes the algorithm named by the URL path (/run/<name>)
+// against a JSON body of flag-name -> value strings, and returns its
+// captured stdout.
+func runHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "POST only")
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/run/")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "missing algorithm name")
+		return
+	}
+
+	e, ok := registry.Lookup(name)
+	if !ok || e.Run == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown algorithm %q", name))
+		return
+	}
+
+	var body map[string]string
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid JSON body: %v", err))
+			return
+		}
+	}
+
+	args, cleanup, err := buildArgs(name, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if cleanup != nil {
+		defer cleanup()
+	}
+
+	output, err := runWithTimeout(e, args, execTimeout)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"output": output})
+}
+
+// buildArgs converts a JSON body of flag-name -> value into the CLI-style
+// []string args registry.Entry.Run expects. dijkstra is special-cased
+// since its --graph flag takes a file path rather than inline JSON.
+func buildArgs(name string, body map[string]string) (args []string, cleanup func(), err error) {
+	if name == "dijkstra" {
+		graphJSON, ok := body["graph"]
+		if !ok {
+			return nil, nil, fmt.Errorf(`dijkstra requires a "graph" field`)
+		}
+		f, err := os.CreateTemp("", "dsa-server-graph-*.json")
+		if err != nil {
+			return nil, nil, fmt.Errorf("writing graph: %w", err)
+		}
+		if _, err := f.WriteString(graphJSON); err != nil {
+			f.Close()
+			os.Remove(f.Name())
+			return nil, nil, fmt.Errorf("writing graph: %w", err)
+		}
+		f.Close()
+
+		args = []string{"--graph", f.Name()}
+		if start, ok := body["start"]; ok {
+			args = append(args, "--start", start)
+		}
+		return args, func() { os.Remove(f.Name()) }, nil
+	}
+
+	for flagName, value := range body {
+		args = append(args, "--"+flagName, value)
+	}
+	return args, nil, nil
+}
+
+// runWithTimeout runs e.Run(args), capturing whatever it prints to
+// stdout, and fails it if it doesn't return within timeLimit.
+func runWithTimeout(e registry.Entry, args []string, timeLimit time.Duration) (string, error) {
+	type result struct {
+		output string
+		err    error
+	}
+	done := make(chan result, 1)
+
+	go func() {
+		stdoutMu.Lock()
+		defer stdoutMu.Unlock()
+
+		orig := os.Stdout
+		r, w, pipeErr := os.Pipe()
+		if pipeErr != nil {
+			done <- result{"", pipeErr}
+			return
+		}
+		os.Stdout = w
+
+		runErr := e.Run(args)
+
+		w.Close()
+		os.Stdout = orig
+
+		var buf bytes.Buffer
+		io.Copy(&buf, r)
+		done <- result{buf.String(), runErr}
+	}()
+
+	select {
+	case res := <-done:
+		return res.output, res.err
+	case <-time.After(timeLimit):
+		return "", fmt.Errorf("execution exceeded time limit of %s", timeLimit)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func doRequest(t *testing.T, method, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var r *http.Request
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("json.Marshal: %v", err)
+		}
+		r = httptest.NewRequest(method, path, bytes.NewReader(b))
+	} else {
+		r = httptest.NewRequest(method, path, nil)
+	}
+	w := httptest.NewRecorder()
+
+	switch {
+	case strings.HasPrefix(path, "/run/"):
+		runHandler(w, r)
+	case path == "/algorithms":
+		algorithmsHandler(w, r)
+	default:
+		t.Fatalf("doRequest: unhandled path %q", path)
+	}
+	return w
+}
+
+func TestRunBubbleSort(t *testing.T) {
+	w := doRequest(t, http.MethodPost, "/run/bubblesort", map[string]string{"nums": "5,3,1"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	var resp map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if want := "1 3 5\n"; resp["output"] != want {
+		t.Errorf("output = %q, want %q", resp["output"], want)
+	}
+}
+
+func TestRunUnknownAlgorithm(t *testing.T) {
+	w := doRequest(t, http.MethodPost, "/run/not-a-real-algorithm", map[string]string{})
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestRunMethodNotAllowed(t *testing.T) {
+	w := doRequest(t, http.MethodGet, "/run/bubblesort", nil)
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestRunDijkstra(t *testing.T) {
+	w := doRequest(t, http.MethodPost, "/run/dijkstra", map[string]string{
+		"graph": `[[{"to":1,"weight":4}],[]]`,
+		"start": "0",
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	var resp map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if want := "To 0 = 0\nTo 1 = 4\n"; resp["output"] != want {
+		t.Errorf("output = %q, want %q", resp["output"], want)
+	}
+}
+
+func TestRunDijkstraMissingGraph(t *testing.T) {
+	w := doRequest(t, http.MethodPost, "/run/dijkstra", map[string]string{})
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestAlgorithmsHandler(t *testing.T) {
+	w := doRequest(t, http.MethodGet, "/algorithms", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	var resp map[string][]string
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	found := false
+	for _, name := range resp["algorithms"] {
+		if name == "bubblesort" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("algorithms = %v, want it to include %q", resp["algorithms"], "bubblesort")
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ghostmkg/dsa-code/go/complexity"
+	"github.com/ghostmkg/dsa-code/go/registry"
+)
+
+// estimateSizes are the geometrically increasing input sizes every
+// estimate run measures at.
+var estimateSizes = []int{500, 1000, 2000, 4000, 8000}
+
+func estimateAlgorithm(name string) {
+	e, ok := registry.Lookup(name)
+	if !ok || e.SizedRun == nil {
+		fmt.Fprintf(os.Stderr, "algorithm %q has no empirical estimate support; run \"dsa list\" to see available algorithms\n", name)
+		os.Exit(2)
+	}
+
+	samples := complexity.Measure(e.SizedRun, estimateSizes, 3)
+	exponent, _ := complexity.FitPowerLaw(samples)
+
+	fmt.Printf("%-10s %s\n", "n", "time")
+	for _, s := range samples {
+		fmt.Printf("%-10d %s\n", s.N, s.Duration)
+	}
+	fmt.Printf("\nfitted time ~ n^%.2f (declared complexity: %s)\n", exponent, e.TimeComplexity)
+}
@@ -0,0 +1,94 @@
+// Command dsa is a single entry point for running the algorithm demos that
+// otherwise live as one-off main() functions under go/cmd/<name>. Usage:
+//
+//	dsa list
+//	dsa describe <algorithm>
+//	dsa run <algorithm> [flags]
+//
+// Run "dsa run <algorithm> -h" to see that algorithm's flags.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	_ "github.com/ghostmkg/dsa-code/go/algocli"
+	"github.com/ghostmkg/dsa-code/go/registry"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "list":
+		listAlgorithms()
+	case "describe":
+		if len(os.Args) < 3 {
+			fmt.Fprintln(os.Stderr, "usage: dsa describe <algorithm>")
+			os.Exit(2)
+		}
+		describeAlgorithm(os.Args[2])
+	case "run":
+		if len(os.Args) < 3 {
+			fmt.Fprintln(os.Stderr, "usage: dsa run <algorithm> [flags]")
+			os.Exit(2)
+		}
+		runAlgorithm(os.Args[2], os.Args[3:])
+	case "estimate":
+		if len(os.Args) < 3 {
+			fmt.Fprintln(os.Stderr, "usage: dsa estimate <algorithm>")
+			os.Exit(2)
+		}
+		estimateAlgorithm(os.Args[2])
+	case "-h", "--help", "help":
+		usage()
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `dsa - run this repository's algorithm demos from one CLI
+
+Usage:
+  dsa list                    list available algorithms
+  dsa describe <algorithm>    show an algorithm's category and complexity
+  dsa run <algorithm> ...     run one algorithm; pass -h for its flags
+  dsa estimate <algorithm>    empirically estimate its time complexity`)
+}
+
+func listAlgorithms() {
+	for _, e := range registry.All() {
+		fmt.Printf("%-16s %-10s %s\n", e.Name, e.Category, e.Describe)
+	}
+}
+
+func describeAlgorithm(name string) {
+	e, ok := registry.Lookup(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown algorithm %q; run \"dsa list\" to see available algorithms\n", name)
+		os.Exit(2)
+	}
+	fmt.Printf("name:  %s\n", e.Name)
+	fmt.Printf("category: %s\n", e.Category)
+	fmt.Printf("time:  %s\n", e.TimeComplexity)
+	fmt.Printf("space: %s\n", e.SpaceComplexity)
+	fmt.Printf("about: %s\n", e.Describe)
+}
+
+func runAlgorithm(name string, args []string) {
+	e, ok := registry.Lookup(name)
+	if !ok || e.Run == nil {
+		fmt.Fprintf(os.Stderr, "unknown algorithm %q; run \"dsa list\" to see available algorithms\n", name)
+		os.Exit(2)
+	}
+	if err := e.Run(args); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
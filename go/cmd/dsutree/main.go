@@ -0,0 +1,39 @@
+// Command dsutree demonstrates structures/dsutree's small-to-large
+// sweep by counting distinct colors in every subtree of a sample tree.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/dsutree"
+)
+
+func main() {
+	adj := make([][]int, 8)
+	edges := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {3, 6}, {4, 7}}
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	colors := []string{"red", "blue", "red", "blue", "red", "blue", "red", "blue"}
+
+	counts := make(map[string]int)
+	distinct := 0
+	dsutree.Run(8, 0, adj, dsutree.Callbacks{
+		Add: func(v int) {
+			if counts[colors[v]] == 0 {
+				distinct++
+			}
+			counts[colors[v]]++
+		},
+		Remove: func(v int) {
+			counts[colors[v]]--
+			if counts[colors[v]] == 0 {
+				distinct--
+			}
+		},
+		Answer: func(root int) {
+			fmt.Printf("distinct colors in subtree(%d) = %d\n", root, distinct)
+		},
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/exprparser"
+)
+
+func main() {
+	expr := "(3 + 4) * 2 - sqrt(9)"
+
+	postfix, err := exprparser.ToPostfix(expr)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("infix:  ", expr)
+	fmt.Println("postfix:", postfix)
+
+	prefix, err := exprparser.PostfixToPrefix(postfix)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("prefix: ", prefix)
+
+	ast, err := exprparser.ParseAST(expr)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	result, err := ast.Eval()
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("result: ", result)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/misc/floydscycle"
+)
+
+func main() {
+	// Creating a cycle for testing
+	n1 := &floydscycle.ListNode{Val: 1}
+	n2 := &floydscycle.ListNode{Val: 2}
+	n3 := &floydscycle.ListNode{Val: 3}
+	n4 := &floydscycle.ListNode{Val: 4}
+	n1.Next = n2
+	n2.Next = n3
+	n3.Next = n4
+	n4.Next = n2 // creates a cycle
+
+	fmt.Println("Has Cycle?", floydscycle.HasCycle(n1))
+}
@@ -3,32 +3,9 @@ package main
 import (
 	"fmt"
 	"math"
-)
-
-func FloydWarshall(graph [][]int, vertices int) [][]int {
-	// Create a copy of the graph to store shortest distances
-	dist := make([][]int, vertices)
-	for i := range graph {
-		dist[i] = make([]int, vertices)
-		for j := range graph[i] {
-			dist[i][j] = graph[i][j]
-		}
-	}
-
-	// Main Floyd–Warshall algorithm
-	for k := 0; k < vertices; k++ {
-		for i := 0; i < vertices; i++ {
-			for j := 0; j < vertices; j++ {
-				if dist[i][k] != math.MaxInt32 && dist[k][j] != math.MaxInt32 &&
-					dist[i][k]+dist[k][j] < dist[i][j] {
-					dist[i][j] = dist[i][k] + dist[k][j]
-				}
-			}
-		}
-	}
 
-	return dist
-}
+	"github.com/ghostmkg/dsa-code/go/graph/floydwarshall"
+)
 
 func main() {
 	var vertices int
@@ -48,7 +25,7 @@ func main() {
 		}
 	}
 
-	dist := FloydWarshall(graph, vertices)
+	dist := floydwarshall.FloydWarshall(graph, vertices)
 
 	fmt.Println("\nShortest distances between every pair of vertices:")
 	for i := 0; i < vertices; i++ {
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/fractionalcascading"
+)
+
+func main() {
+	lists := [][]int{
+		{1, 5, 9, 20},
+		{2, 3, 4, 100},
+		{50, 60, 70},
+	}
+	fc := fractionalcascading.New(lists)
+
+	for _, x := range []int{0, 6, 21, 1000} {
+		fmt.Printf("Successor of %d in every list: %v\n", x, fc.SuccessorAll(x))
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/gametheory"
+)
+
+func main() {
+	piles := []int{3, 4, 5}
+	fmt.Printf("Nim piles %v: Nim-sum %d, first player wins: %v\n",
+		piles, gametheory.NimSum(piles), gametheory.FirstPlayerWins(piles))
+
+	fmt.Println("Subtraction game {1,2,3} Grundy numbers 0..12:", gametheory.SubtractionGameGrundy(12, []int{1, 2, 3}))
+
+	game := gametheory.NewTicTacToeGame()
+	var empty gametheory.TicTacToeBoard
+	fmt.Println("Optimal value of tic-tac-toe from an empty board:", game.Minimax(empty, 9))
+
+	best, value := game.BestMove(empty, 9)
+	fmt.Printf("X's best opening move: %v (value %d)\n", best, value)
+}
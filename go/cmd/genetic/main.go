@@ -0,0 +1,60 @@
+// Command genetic demonstrates the structures/genetic engine evolving a
+// random string toward a target string.
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/ghostmkg/dsa-code/go/structures/genetic"
+)
+
+const alphabet = "abcdefghijklmnopqrstuvwxyz "
+
+func main() {
+	target := "the quick fox"
+
+	problem := genetic.Problem[[]byte]{
+		Fitness: func(s []byte) float64 {
+			matches := 0
+			for i := range s {
+				if s[i] == target[i] {
+					matches++
+				}
+			}
+			return float64(matches)
+		},
+		RandomIndividual: func(rng *rand.Rand) []byte {
+			s := make([]byte, len(target))
+			for i := range s {
+				s[i] = alphabet[rng.Intn(len(alphabet))]
+			}
+			return s
+		},
+		Crossover: func(a, b []byte, rng *rand.Rand) []byte {
+			child := make([]byte, len(a))
+			point := rng.Intn(len(a) + 1)
+			for i := range child {
+				if i < point {
+					child[i] = a[i]
+				} else {
+					child[i] = b[i]
+				}
+			}
+			return child
+		},
+		Mutate: func(s []byte, rng *rand.Rand) []byte {
+			next := append([]byte{}, s...)
+			i := rng.Intn(len(next))
+			next[i] = alphabet[rng.Intn(len(alphabet))]
+			return next
+		},
+	}
+
+	rng := rand.New(rand.NewSource(42))
+	config := genetic.Config{PopulationSize: 200, Generations: 300, MutationRate: 0.2, Elitism: 10}
+	best, bestFitness := genetic.Run(problem, genetic.TournamentSelector[[]byte](5), config, rng)
+
+	fmt.Printf("best: %q\n", string(best))
+	fmt.Println("fitness:", bestFitness)
+}
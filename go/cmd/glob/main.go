@@ -0,0 +1,21 @@
+// Command glob demonstrates the strings/glob wildcard matcher.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/glob"
+)
+
+func main() {
+	fmt.Println(glob.Match("*.go", "main.go"))
+	fmt.Println(glob.Match("data_[0-9]*.csv", "data_2024_report.csv"))
+
+	m, err := glob.Compile("[a-z]*?")
+	if err != nil {
+		fmt.Println("compile error:", err)
+		return
+	}
+	fmt.Println(m.Match("hello"))
+	fmt.Println(m.Match("HELLO"))
+}
@@ -0,0 +1,19 @@
+// Command hashing demonstrates the strings/hashing double-hash Table:
+// O(1) substring hashing, substring comparison, and period-finding.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/hashing"
+)
+
+func main() {
+	s := "abracadabra"
+	cfg := hashing.DefaultConfig()
+	table := hashing.NewTable(s, cfg)
+
+	fmt.Printf("Hash(%q) = %+v\n", s[0:4], table.Hash(0, 4))
+	fmt.Println("s[0:4] == s[7:11]:", table.Equal(0, 4, 7, 11)) // "abra" == "abra"
+	fmt.Println("Period(\"abcabcabc\"):", hashing.Period("abcabcabc", cfg))
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/misc/hello"
+)
+
+func main() {
+	fmt.Println(hello.Hello())
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/compression/huffman"
+)
+
+func main() {
+	data := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog, "), 20)
+
+	var buf bytes.Buffer
+	if err := huffman.Encode(&buf, data); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Printf("original: %d bytes, encoded: %d bytes\n", len(data), buf.Len())
+
+	decoded, err := huffman.Decode(&buf)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("round trip matches:", bytes.Equal(decoded, data))
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/interval"
+)
+
+func main() {
+	intervals := []interval.Interval{
+		{Start: 1, End: 3}, {Start: 2, End: 6}, {Start: 8, End: 10}, {Start: 15, End: 18},
+	}
+	fmt.Println("merged:", interval.Merge(intervals))
+	fmt.Println("max non-overlapping:", interval.MaxNonOverlapping(intervals))
+	fmt.Println("coverage:", interval.Coverage(intervals))
+
+	meetings := []interval.Interval{
+		{Start: 0, End: 30}, {Start: 5, End: 10}, {Start: 15, End: 20},
+	}
+	fmt.Println("min meeting rooms:", interval.MinMeetingRooms(meetings))
+}
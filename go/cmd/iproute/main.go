@@ -0,0 +1,37 @@
+// Command iproute demonstrates longest-prefix-match routing lookups
+// from the structures/iproute package.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/iproute"
+)
+
+func main() {
+	tbl := iproute.New[string]()
+
+	routes := map[string]string{
+		"0.0.0.0/0":   "default",
+		"10.0.0.0/8":  "ten-net",
+		"10.1.2.0/24": "ten-one-two-net",
+	}
+	for cidr, name := range routes {
+		addr, prefixLen, err := iproute.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		if err := tbl.Insert(addr, prefixLen, name); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, ip := range []string{"10.1.2.5", "10.1.3.5", "8.8.8.8"} {
+		addr, err := iproute.ParseIP(ip)
+		if err != nil {
+			panic(err)
+		}
+		route, ok := tbl.Lookup(addr)
+		fmt.Printf("%s -> %s (%v)\n", ip, route, ok)
+	}
+}
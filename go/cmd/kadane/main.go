@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ghostmkg/dsa-code/go/misc/kadane"
+)
+
+func main() {
+	arr := []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}
+	result, err := kadane.Kadane(arr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	fmt.Println("Maximum Subarray Sum:", result)
+}
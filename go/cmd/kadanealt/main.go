@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/misc/kadanealt"
+)
+
+func main() {
+	arr := []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}
+	fmt.Println("Array:", arr)
+
+	result := kadanealt.Kadane(arr)
+	fmt.Println("Maximum Subarray Sum:", result)
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/misc/kfrequent"
+)
+
+func main() {
+	nums := []int{1, 1, 1, 2, 2, 3}
+	fmt.Println("Top 2 frequent elements:", kfrequent.TopKFrequent(nums, 2))
+}
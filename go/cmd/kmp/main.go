@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/ghostmkg/dsa-code/go/strings/kmp"
+)
+
+func main() {
+	text := "ABABDABACDABABCABAB"
+	pattern := "ABABCABAB"
+
+	kmp.KMPSearch(text, pattern)
+}
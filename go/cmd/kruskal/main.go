@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/kruskal"
+)
+
+func main() {
+	var vertices, edgesCount int
+	fmt.Print("Enter number of vertices: ")
+	fmt.Scan(&vertices)
+
+	fmt.Print("Enter number of edges: ")
+	fmt.Scan(&edgesCount)
+
+	edges := make([]kruskal.Edge, edgesCount)
+
+	fmt.Println("Enter edges in the format: src dest weight")
+	for i := 0; i < edgesCount; i++ {
+		fmt.Scan(&edges[i].Src, &edges[i].Dest, &edges[i].Weight)
+	}
+
+	mst := kruskal.KruskalMST(edges, vertices)
+
+	fmt.Println("\nEdges in the Minimum Spanning Tree:")
+	totalWeight := 0
+	for _, e := range mst {
+		fmt.Printf("%d -- %d  == %d\n", e.Src, e.Dest, e.Weight)
+		totalWeight += e.Weight
+	}
+
+	fmt.Printf("Total weight of MST: %d\n", totalWeight)
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/misc/largestaltitude"
+)
+
+func main() {
+	gain := []int{-5, 1, 5, 0, -7}
+	ans := largestaltitude.LargestAltitude(gain)
+	fmt.Println("largest altitude is ", ans)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/lca"
+)
+
+func main() {
+	// Tree: 0 is root, children 1,2,3; 1 has children 4,5; 3 has child 6.
+	edges := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {3, 6}}
+	adj := make([][]int, 7)
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+
+	queries := []lca.Query{{U: 4, V: 5}, {U: 4, V: 6}, {U: 2, V: 3}}
+	results := lca.OfflineLCA(7, 0, adj, queries)
+	for i, q := range queries {
+		fmt.Printf("LCA(%d, %d) = %d\n", q.U, q.V, results[i])
+	}
+}
@@ -0,0 +1,22 @@
+// Command lichao demonstrates structures/lichao: a Li Chao tree that
+// answers the best value among a dynamic set of lines and line segments
+// at any query point.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/lichao"
+)
+
+func main() {
+	tree := lichao.New(0, 1000, true)
+	tree.InsertLine(lichao.Line{M: 1, B: 0})
+	tree.InsertLine(lichao.Line{M: -1, B: 800})
+	tree.InsertSegment(lichao.Line{M: 0, B: 900}, 400, 600)
+
+	for _, x := range []int64{0, 300, 500, 700, 1000} {
+		v, ok := tree.Query(x)
+		fmt.Printf("Query(%d) = %d, ok = %v\n", x, v, ok)
+	}
+}
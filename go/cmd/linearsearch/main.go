@@ -6,17 +6,9 @@ import (
 	"os"
 	"strconv"
 	"strings"
-)
 
-// Linear search function
-func linearSearch(arr []int, target int) int {
-	for i, value := range arr {
-		if value == target {
-			return i
-		}
-	}
-	return -1
-}
+	"github.com/ghostmkg/dsa-code/go/searching/linearsearch"
+)
 
 func main() {
 	reader := bufio.NewReader(os.Stdin)
@@ -48,7 +40,7 @@ func main() {
 	}
 
 	// Perform search
-	index := linearSearch(arr, target)
+	index := linearsearch.LinearSearch(arr, target)
 
 	fmt.Printf("\nArray: %v\n", arr)
 	if index != -1 {
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/linkedlist"
+)
+
+func main() {
+	l := linkedlist.New(1, 2, 3, 4, 5)
+	fmt.Println("original:", l.ToSlice())
+
+	l.ReverseKGroup(2)
+	fmt.Println("reversed in groups of 2:", l.ToSlice())
+
+	l2 := linkedlist.New(1, 2, 3, 4, 5)
+	l2.Reorder()
+	fmt.Println("reordered:", l2.ToSlice())
+
+	a := linkedlist.New(1, 3, 5)
+	b := linkedlist.New(2, 4, 6)
+	merged := linkedlist.Merge(a, b, func(x, y int) bool { return x < y })
+	fmt.Println("merged:", merged.ToSlice())
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/longestsubstring"
+)
+
+func main() {
+	s := "abcabcbb"
+	fmt.Println("Longest substring length:", longestsubstring.LengthOfLongestSubstring(s))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/compression"
+	"github.com/ghostmkg/dsa-code/go/compression/lzw"
+)
+
+func main() {
+	data := bytes.Repeat([]byte("TOBEORNOTTOBEOR"), 50)
+
+	codec := lzw.Codec{MaxDictSize: 4096}
+	compressed, err := codec.Compress(data)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Printf("original: %d bytes, compressed: %d bytes, ratio: %.3f\n",
+		len(data), len(compressed), compression.Ratio(len(data), len(compressed)))
+
+	decoded, err := codec.Decompress(compressed)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("round trip matches:", bytes.Equal(decoded, data))
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/manacher"
+)
+
+func main() {
+	s := "forgeeksskeegfor"
+	fmt.Println("Text:", s)
+	fmt.Println("LongestPalindrome:", manacher.LongestPalindrome(s))
+	fmt.Println("CountPalindromicSubstrings:", manacher.CountPalindromicSubstrings(s))
+
+	idx := manacher.NewPalindromeIndex(s)
+	fmt.Println("IsPalindromeRange(5, 12):", idx.IsPalindromeRange(5, 12))
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/matrix"
+)
+
+func main() {
+	m, err := matrix.FromRows([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	})
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	fmt.Println("Spiral order:", m.SpiralOrder())
+
+	m.RotateClockwise()
+	for r := 0; r < m.Rows(); r++ {
+		fmt.Println(m.RowSlice(r))
+	}
+}
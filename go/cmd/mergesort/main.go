@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/sorting/mergesort"
+)
+
+func main() {
+	var n int
+	fmt.Print("Enter number of elements: ")
+	fmt.Scan(&n)
+
+	arr := make([]int, n)
+	fmt.Println("Enter elements:")
+	for i := 0; i < n; i++ {
+		fmt.Scan(&arr[i])
+	}
+
+	sorted := mergesort.MergeSort(arr)
+
+	fmt.Println("Sorted array:")
+	fmt.Println(sorted)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/merkletree"
+)
+
+func main() {
+	leaves := [][]byte{[]byte("alice"), []byte("bob"), []byte("carol"), []byte("dave")}
+	tree := merkletree.New(leaves, nil)
+	fmt.Println("Root:", hex.EncodeToString(tree.Root()))
+
+	proof, err := tree.Prove(2)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("Verify(carol):", merkletree.Verify(nil, tree.Root(), leaves[2], proof))
+}
@@ -0,0 +1,51 @@
+// Command metaheuristics demonstrates simulated annealing solving
+// N-Queens from the structures/metaheuristics package.
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/ghostmkg/dsa-code/go/structures/metaheuristics"
+)
+
+func queensConflicts(board []int) float64 {
+	conflicts := 0
+	for i := range board {
+		for j := i + 1; j < len(board); j++ {
+			diff := board[i] - board[j]
+			if diff < 0 {
+				diff = -diff
+			}
+			if board[i] == board[j] || diff == j-i {
+				conflicts++
+			}
+		}
+	}
+	return float64(conflicts)
+}
+
+func main() {
+	const n = 8
+	problem := metaheuristics.Problem[[]int]{
+		Energy: queensConflicts,
+		Neighbor: func(board []int, rng *rand.Rand) []int {
+			next := append([]int{}, board...)
+			col := rng.Intn(len(next))
+			next[col] = rng.Intn(len(next))
+			return next
+		},
+	}
+
+	initial := make([]int, n)
+	for i := range initial {
+		initial[i] = i
+	}
+
+	rng := rand.New(rand.NewSource(42))
+	schedule := metaheuristics.ExponentialSchedule(5, 0.99)
+	board, conflicts := metaheuristics.SimulatedAnnealing(problem, initial, schedule, 5000, rng)
+
+	fmt.Println("board:", board)
+	fmt.Println("conflicts:", conflicts)
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/minhashdedup"
+)
+
+func main() {
+	docs := []string{
+		"the quick brown fox jumps over the lazy dog",
+		"the quick brown fox leaps over the lazy dog",
+		"completely unrelated text about something else entirely",
+	}
+
+	for _, pair := range minhashdedup.Dedup(docs, minhashdedup.DefaultConfig()) {
+		fmt.Printf("near-duplicate: doc %d ~ doc %d (jaccard=%.2f)\n", pair.A, pair.B, pair.Jaccard)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/streaming/misragries"
+)
+
+func main() {
+	stream := []string{"a", "b", "a", "c", "a", "b", "a", "d", "a", "e", "a", "b"}
+
+	c := misragries.NewCounter(4)
+	for _, item := range stream {
+		c.Update(item)
+	}
+
+	for _, e := range c.TopK() {
+		fmt.Printf("%s: %d\n", e.Item, e.Count)
+	}
+	fmt.Println("error bound:", c.ErrorBound())
+}
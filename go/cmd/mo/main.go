@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/mo"
+)
+
+func main() {
+	arr := []int{1, 2, 1, 3, 2, 4, 1, 5, 5, 3}
+	queries := []mo.Query{
+		{L: 0, R: 10, Index: 0},
+		{L: 2, R: 5, Index: 1},
+		{L: 5, R: 9, Index: 2},
+	}
+
+	freq := map[int]int{}
+	distinct := 0
+	d := &mo.Driver{
+		Add: func(i int) {
+			if freq[arr[i]] == 0 {
+				distinct++
+			}
+			freq[arr[i]]++
+		},
+		Remove: func(i int) {
+			freq[arr[i]]--
+			if freq[arr[i]] == 0 {
+				distinct--
+			}
+		},
+		Answer: func(q mo.Query) any { return distinct },
+	}
+
+	for i, ans := range d.Run(len(arr), queries, 0) {
+		fmt.Printf("distinct in [%d,%d) = %v\n", queries[i].L, queries[i].R, ans)
+	}
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/ordermaintenance"
+)
+
+func main() {
+	l := ordermaintenance.New[string]()
+	a := l.Insert("a")
+	c := l.InsertAfter(a, "c")
+	l.InsertAfter(a, "b")
+
+	fmt.Println("list order:", l)
+	fmt.Println("Order(a, c) =", ordermaintenance.Order(a, c))
+}
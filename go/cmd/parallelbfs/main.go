@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/parallelbfs"
+)
+
+func main() {
+	g := parallelbfs.NewGraph(8)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 7)
+
+	dist := parallelbfs.ParallelBFS(g, 0, 4)
+	fmt.Println("distances from vertex 0:", dist)
+
+	labels := parallelbfs.ParallelConnectedComponents(g, 4)
+	fmt.Println("component labels:", labels)
+}
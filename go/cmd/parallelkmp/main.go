@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/parallelkmp"
+)
+
+func main() {
+	text := "ABABDABACDABABCABABABABCABAB"
+	pattern := "ABAB"
+
+	fmt.Println("sequential:", parallelkmp.KMPStringMatcher(text, pattern))
+	fmt.Println("parallel:  ", parallelkmp.ParallelSearch(text, pattern, 4))
+}
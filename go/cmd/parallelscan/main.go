@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/parallelscan"
+)
+
+func main() {
+	data := make([]int, 20)
+	for i := range data {
+		data[i] = i + 1
+	}
+
+	sum := func(a, b int) int { return a + b }
+	fmt.Println("sequential:", parallelscan.SequentialScan(data, sum))
+	fmt.Println("parallel:  ", parallelscan.ParallelScan(data, 4, sum))
+}
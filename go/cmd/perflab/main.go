@@ -0,0 +1,78 @@
+// Command perflab runs a registered algorithm's SizedRun against a large
+// generated input under CPU and heap profiling, so allocation and CPU
+// hotspots (e.g. the append-heavy result slice in kmp.FindAll) can be
+// found with "go tool pprof" instead of guessed at.
+//
+// Usage:
+//
+//	perflab -algo kmp -n 2000000 -cpuprofile cpu.pprof -memprofile mem.pprof
+//
+// Run "go tool pprof cpu.pprof" (or mem.pprof) afterwards to explore the
+// profile.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+
+	_ "github.com/ghostmkg/dsa-code/go/algocli"
+	"github.com/ghostmkg/dsa-code/go/registry"
+)
+
+func main() {
+	algo := flag.String("algo", "", "registered algorithm name to profile (must have a SizedRun)")
+	n := flag.Int("n", 1_000_000, "input size to generate")
+	iterations := flag.Int("iterations", 10, "times to run the algorithm, to accumulate enough CPU samples")
+	cpuprofile := flag.String("cpuprofile", "", "write a CPU profile to this file")
+	memprofile := flag.String("memprofile", "", "write a heap profile to this file")
+	flag.Parse()
+
+	if *algo == "" {
+		fmt.Fprintln(os.Stderr, "usage: perflab -algo <name> [-n size] [-cpuprofile file] [-memprofile file]")
+		os.Exit(2)
+	}
+
+	e, ok := registry.Lookup(*algo)
+	if !ok || e.SizedRun == nil {
+		fmt.Fprintf(os.Stderr, "algorithm %q has no SizedRun to profile; run \"dsa list\" to see available algorithms\n", *algo)
+		os.Exit(2)
+	}
+
+	if *cpuprofile != "" {
+		f, err := os.Create(*cpuprofile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		if err := pprof.StartCPUProfile(f); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	fmt.Printf("running %s at n=%d for %d iterations...\n", *algo, *n, *iterations)
+	for i := 0; i < *iterations; i++ {
+		e.SizedRun(*n)
+	}
+
+	if *memprofile != "" {
+		f, err := os.Create(*memprofile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		runtime.GC() // get up-to-date statistics before writing the heap profile
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Println("done")
+}
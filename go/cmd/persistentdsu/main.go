@@ -0,0 +1,21 @@
+// Command persistentdsu demonstrates structures/persistentdsu: a
+// disjoint-set union whose past versions stay queryable after later
+// unions.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/persistentdsu"
+)
+
+func main() {
+	d := persistentdsu.New(4)
+	d.Union(0, 1)
+	d.Union(2, 3)
+	d.Union(1, 2)
+
+	for v := 0; v <= d.Version(); v++ {
+		fmt.Printf("version %d: Connected(0, 3) = %v\n", v, d.Connected(0, 3, v))
+	}
+}
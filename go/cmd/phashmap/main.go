@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/phashmap"
+)
+
+func main() {
+	m := phashmap.New[string, int](phashmap.HashString)
+	m = m.Set("a", 1)
+	m = m.Set("b", 2)
+
+	m2 := m.Set("a", 100)
+
+	got, _ := m.Get("a")
+	got2, _ := m2.Get("a")
+	fmt.Println("m[a]:", got)
+	fmt.Println("m2[a]:", got2)
+	fmt.Println("m.Len():", m.Len(), "m2.Len():", m2.Len())
+}
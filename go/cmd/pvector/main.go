@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/pvector"
+)
+
+func main() {
+	v := &pvector.Vector[string]{}
+	v = v.Push("a")
+	v = v.Push("b")
+	v = v.Push("c")
+
+	v2, _ := v.Set(1, "B")
+
+	first, _ := v.Get(1)
+	second, _ := v2.Get(1)
+	fmt.Println("v[1]:", first)
+	fmt.Println("v2[1]:", second)
+	fmt.Println("v.Len():", v.Len(), "v2.Len():", v2.Len())
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/queue"
+)
+
+func main() {
+	q := queue.NewQueue[int]()
+	q.Enqueue(10)
+	q.Enqueue(20)
+	q.Enqueue(30)
+	fmt.Print("Queue: ")
+	q.Display()
+	dequeued, _ := q.Dequeue()
+	fmt.Println("Dequeued:", dequeued)
+	fmt.Print("Queue after dequeue: ")
+	q.Display()
+}
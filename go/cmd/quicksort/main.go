@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/sorting/quicksort"
+)
+
+func main() {
+	data := []int{10, 7, 8, 9, 1, 5}
+
+	fmt.Println("Original:", data)
+	quicksort.QuickSort(data, 0, len(data)-1)
+	fmt.Println("Sorted:", data)
+}
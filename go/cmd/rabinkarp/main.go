@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/rabinkarp"
+)
+
+func main() {
+	text := "she sells seashells by the seashore"
+	fmt.Println("FindAll(\"sea\"):", rabinkarp.FindAll(text, "sea"))
+
+	cfg := rabinkarp.RandomConfig(42)
+	fmt.Println("FindAllConfig(\"sea\") with randomized base/modulus:", rabinkarp.FindAllConfig(text, "sea", cfg))
+
+	patterns := []string{"sea", "shell", "sells", "xyz"}
+	for i, positions := range rabinkarp.FindAllPatterns(text, patterns) {
+		fmt.Printf("FindAllPatterns: %q -> %v\n", patterns[i], positions)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/rangequery"
+)
+
+func main() {
+	data := []int{5, 2, 4, 7, 6, 3, 1, 8, 9}
+
+	min := func(a, b int) int {
+		if a < b {
+			return a
+		}
+		return b
+	}
+	st := rangequery.NewSparseTable(data, min)
+	fmt.Println("range min [2,6]:", st.Query(2, 6))
+
+	dst := rangequery.NewDisjointSparseTable(data, func(a, b int) int { return a + b })
+	fmt.Println("range sum [2,6]:", dst.Query(2, 6))
+
+	fmt.Println("sliding window min (k=3):", rangequery.SlidingWindowMin(data, 3))
+	fmt.Println("sliding window max (k=3):", rangequery.SlidingWindowMax(data, 3))
+}
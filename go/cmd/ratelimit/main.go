@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/ratelimit"
+)
+
+func main() {
+	clock := ratelimit.SystemClock{}
+
+	tb := ratelimit.NewTokenBucketLimiter(5, 1, clock)
+	fmt.Println("token bucket allow 3:", tb.Allow(3))
+	fmt.Println("token bucket allow 3 again:", tb.Allow(3))
+
+	lb := ratelimit.NewLeakyBucketLimiter(5, 1, clock)
+	fmt.Println("leaky bucket allow 3:", lb.Allow(3))
+	fmt.Println("leaky bucket allow 3 again:", lb.Allow(3))
+
+	sw := ratelimit.NewSlidingWindowLimiter(3, time.Second, clock)
+	fmt.Println("sliding window allow 1x3:", sw.Allow(1), sw.Allow(1), sw.Allow(1), sw.Allow(1))
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/reachability"
+)
+
+func main() {
+	// 0 -> 1, 0 -> 2, 1 -> 3, 2 -> 3, 3 -> 4
+	adj := [][]int{{1, 2}, {3}, {3}, {4}, {}}
+	idx, err := reachability.New(5, adj)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	fmt.Println("Reachable(0, 4):", idx.Reachable(0, 4))
+	fmt.Println("Reachable(4, 0):", idx.Reachable(4, 0))
+	fmt.Println("ReachableFrom(0):", idx.ReachableFrom(0))
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/rectanglearea"
+)
+
+func main() {
+	rects := []rectanglearea.Rectangle{
+		{X1: 0, Y1: 0, X2: 4, Y2: 4},
+		{X1: 2, Y1: 2, X2: 6, Y2: 6},
+		{X1: 5, Y1: 0, X2: 7, Y2: 2},
+	}
+
+	fmt.Println("Rectangles:", rects)
+	fmt.Println("Union area:", rectanglearea.Area(rects))
+	fmt.Println("Union perimeter:", rectanglearea.Perimeter(rects))
+}
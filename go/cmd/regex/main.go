@@ -0,0 +1,21 @@
+// Command regex demonstrates the strings/regex Thompson-NFA engine.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/regex"
+)
+
+func main() {
+	re, err := regex.Compile("a(b|c)*d")
+	if err != nil {
+		fmt.Println("compile error:", err)
+		return
+	}
+	fmt.Println(re.MatchString("abccbd"))
+	fmt.Println(re.MatchString("aed"))
+
+	spans := re.FindAllStringIndex("xxabdyyacdzzabcbd")
+	fmt.Println("matches at:", spans)
+}
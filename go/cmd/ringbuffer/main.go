@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/ringbuffer"
+)
+
+func main() {
+	rb := ringbuffer.NewSPSCRingBuffer(4)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			for !rb.Push(i) {
+			}
+		}
+		close(done)
+	}()
+
+	received := make([]int, 0, 10)
+	for len(received) < 10 {
+		if v, ok := rb.Pop(); ok {
+			received = append(received, v)
+		}
+	}
+	<-done
+	fmt.Println("received:", received)
+}
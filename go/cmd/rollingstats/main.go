@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/rollingstats"
+)
+
+func main() {
+	rs := rollingstats.New(3)
+	for _, x := range []float64{4, 8, 15, 16, 23, 42} {
+		rs.Push(x)
+		min, _ := rs.Min()
+		max, _ := rs.Max()
+		mean, _ := rs.Mean()
+		variance, _ := rs.Variance()
+		fmt.Printf("pushed %v: min=%v max=%v mean=%.2f variance=%.2f\n", x, min, max, mean, variance)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/satsolver"
+)
+
+func main() {
+	f := &satsolver.Formula{
+		NumVars: 3,
+		Clauses: [][]int{
+			{1, 2},
+			{-1, 2},
+			{-2, 3},
+		},
+	}
+
+	model, ok := satsolver.Solve(f)
+	if !ok {
+		fmt.Println("UNSAT")
+		return
+	}
+	fmt.Println("SAT:", model)
+	fmt.Println("verified:", satsolver.Verify(f, model))
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/scheduling"
+)
+
+func main() {
+	jobs := []scheduling.Job{
+		{ID: 1, Deadline: 4, Profit: 20},
+		{ID: 2, Deadline: 1, Profit: 10},
+		{ID: 3, Deadline: 1, Profit: 40},
+		{ID: 4, Deadline: 1, Profit: 30},
+	}
+	selected, profit := scheduling.JobSequencing(jobs)
+	fmt.Println("job sequencing:", selected, "profit:", profit)
+
+	processes := []scheduling.Process{
+		{ID: 1, Arrival: 0, Burst: 5},
+		{ID: 2, Arrival: 1, Burst: 3},
+		{ID: 3, Arrival: 2, Burst: 8},
+	}
+	for _, m := range scheduling.FCFS(processes) {
+		fmt.Printf("FCFS: process %d waiting=%d turnaround=%d\n", m.ID, m.Waiting, m.Turnaround)
+	}
+}
@@ -0,0 +1,23 @@
+// Command segtree demonstrates structures/segtree with a range-add,
+// range-sum tree and a range-assign, range-min tree over the same data.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/segtree"
+)
+
+func main() {
+	values := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	sums := segtree.New(values, segtree.RangeAddRangeSum())
+	fmt.Println("sum[0:9) =", sums.Query(0, 9))
+	sums.Update(2, 5, 10)
+	fmt.Println("sum[2:5) after adding 10 =", sums.Query(2, 5))
+
+	mins := segtree.New(values, segtree.RangeAssignRangeMin())
+	fmt.Println("min[0:9) =", mins.Query(0, 9))
+	mins.Update(0, 3, segtree.Assign[int64]{Set: true, Value: 100})
+	fmt.Println("min[0:9) after assigning [0:3) to 100 =", mins.Query(0, 9))
+}
@@ -0,0 +1,25 @@
+// Command similarity demonstrates the strings/similarity fuzzy-matching
+// metrics: Jaro, Jaro-Winkler, n-gram cosine similarity, and the
+// Sørensen–Dice coefficient.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/similarity"
+)
+
+func main() {
+	a, b := "MARTHA", "MARHTA"
+	fmt.Printf("Jaro(%q, %q) = %.4f\n", a, b, similarity.JaroSimilarity(a, b))
+	fmt.Printf("JaroWinkler(%q, %q) = %.4f\n", a, b, similarity.JaroWinklerSimilarity(a, b))
+
+	c, d := "night", "nacht"
+	fmt.Printf("Cosine(%q, %q) = %.4f\n", c, d, similarity.CosineSimilarity(c, d))
+	fmt.Printf("Dice(%q, %q) = %.4f\n", c, d, similarity.DiceCoefficient(c, d))
+
+	var metrics = []similarity.Metric{similarity.Jaro{}, similarity.JaroWinkler{}, similarity.NGramCosine{N: 3}, similarity.Dice{N: 3}}
+	for _, m := range metrics {
+		fmt.Printf("%T.Similarity(%q, %q) = %.4f\n", m, a, b, m.Similarity(a, b))
+	}
+}
@@ -0,0 +1,20 @@
+// Command simulation demonstrates the structures/simulation
+// discrete-event engine with an M/M/1 queue.
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/ghostmkg/dsa-code/go/structures/simulation"
+)
+
+func main() {
+	rng := rand.New(rand.NewSource(42))
+	result := simulation.SimulateMM1(1.0, 1.5, 10000, rng)
+
+	fmt.Printf("customers served: %d\n", result.CustomersServed)
+	fmt.Printf("average wait time: %.4f\n", result.AverageWaitTime)
+	fmt.Printf("average time in system: %.4f\n", result.AverageSystemTime)
+	fmt.Printf("server utilization: %.4f\n", result.ServerUtilization)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/skiplist"
+)
+
+func main() {
+	sl := skiplist.NewConcurrentSkipList()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sl.Put(i, i*i)
+		}(i)
+	}
+	wg.Wait()
+
+	v, ok := sl.Get(42)
+	fmt.Println("get(42):", v, ok)
+
+	sl.Delete(42)
+	_, ok = sl.Get(42)
+	fmt.Println("get(42) after delete:", ok)
+
+	fmt.Println("size:", len(sl.Range()))
+}
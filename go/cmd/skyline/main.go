@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/skyline"
+)
+
+func main() {
+	buildings := []skyline.Building{
+		{Left: 2, Right: 9, Height: 10},
+		{Left: 3, Right: 7, Height: 15},
+		{Left: 5, Right: 12, Height: 12},
+		{Left: 15, Right: 20, Height: 10},
+		{Left: 19, Right: 24, Height: 8},
+	}
+	for _, p := range skyline.Skyline(buildings) {
+		fmt.Printf("(%d, %d)\n", p.X, p.Height)
+	}
+}
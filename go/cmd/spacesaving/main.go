@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/streaming/spacesaving"
+)
+
+func main() {
+	stream := []string{"a", "b", "a", "c", "a", "b", "a", "d", "a", "e", "a", "b"}
+
+	c := spacesaving.NewCounter(3)
+	for _, item := range stream {
+		c.Update(item)
+	}
+
+	for _, e := range c.TopK() {
+		fmt.Printf("%s: count=%d error=%d\n", e.Item, e.Count, e.Error)
+	}
+}
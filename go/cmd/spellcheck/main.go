@@ -0,0 +1,23 @@
+// Command spellcheck demonstrates the structures/spellcheck package: a
+// Bloom filter fronting an exact dictionary for fast rejection, with
+// edit-distance suggestions for misspelled words.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/spellcheck"
+)
+
+func main() {
+	dictionary := []string{"apple", "banana", "cherry", "grape", "orange", "peach", "plum"}
+	checker := spellcheck.New(dictionary)
+
+	for _, word := range []string{"apple", "aple", "zzzzz"} {
+		if checker.IsCorrect(word) {
+			fmt.Printf("%q: correct\n", word)
+			continue
+		}
+		fmt.Printf("%q: misspelled, suggestions: %v\n", word, checker.Suggest(word, 2))
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/splaysequence"
+)
+
+func main() {
+	s := splaysequence.New([]int{1, 2, 3, 4, 5}, func(a, b int) int { return a + b }, 0)
+
+	fmt.Println("sequence:", s.ToSlice())
+
+	if err := s.Insert(2, 99); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("after Insert(2, 99):", s.ToSlice())
+
+	if err := s.RangeReverse(1, 4); err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("after RangeReverse(1, 4):", s.ToSlice())
+
+	sum, err := s.RangeSum(0, s.Len())
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("sum of everything:", sum)
+}
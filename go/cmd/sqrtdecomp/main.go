@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/sqrtdecomp"
+)
+
+func main() {
+	arr := []int{5, 2, 8, 1, 9, 3, 7, 4, 6, 0}
+	d := sqrtdecomp.New(arr, func(a, b int) int { return a + b }, 0)
+	fmt.Println("sum[2:7):", d.Query(2, 7))
+
+	d.Update(3, 100)
+	fmt.Println("sum[2:7) after Update(3, 100):", d.Query(2, 7))
+
+	batched := sqrtdecomp.NewBatchedSum(arr, 100)
+	batched.Update(0, 5)
+	batched.Update(9, -1)
+	fmt.Println("batched sum[0:10):", batched.Query(0, 10))
+}
@@ -0,0 +1,24 @@
+// Command stack demonstrates structures/stack's two Stack backings
+// behind the same interface.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/stack"
+)
+
+func run(name string, s stack.Stack[int]) {
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	fmt.Printf("%s: ToSlice() = %v\n", name, s.ToSlice())
+
+	top, _ := s.Pop()
+	fmt.Printf("%s: Pop() = %d, remaining = %v\n", name, top, s.ToSlice())
+}
+
+func main() {
+	run("SliceStack", stack.NewSliceStack[int]())
+	run("ListStack", stack.NewListStack[int]())
+}
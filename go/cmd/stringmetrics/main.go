@@ -0,0 +1,25 @@
+// Command stringmetrics demonstrates the strings/stringmetrics edit
+// distance toolkit.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/stringmetrics"
+)
+
+func main() {
+	a, b := "kitten", "sitting"
+	fmt.Println("Levenshtein:", stringmetrics.Levenshtein(a, b, stringmetrics.DefaultCosts))
+	fmt.Println("Damerau-Levenshtein:", stringmetrics.DamerauLevenshtein(a, b, stringmetrics.DefaultCosts))
+
+	ops, cost := stringmetrics.Align(a, b, stringmetrics.DefaultCosts)
+	fmt.Println("alignment cost:", cost)
+	for _, op := range ops {
+		fmt.Printf("  %s %c -> %c\n", op.Kind, op.A, op.B)
+	}
+
+	if d, err := stringmetrics.Hamming("karolin", "kathrin", stringmetrics.DefaultCosts); err == nil {
+		fmt.Println("Hamming:", d)
+	}
+}
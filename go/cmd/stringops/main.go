@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/stringops"
+)
+
+func main() {
+	fmt.Println(stringops.Multiply("2", "3"))
+}
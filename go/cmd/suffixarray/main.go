@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/suffixarray"
+)
+
+func main() {
+	text := "banana"
+	suffixArr := suffixarray.BuildSuffixArray(text)
+
+	fmt.Println("Text:", text)
+	fmt.Println("Suffix Array:", suffixArr)
+
+	sa := suffixarray.New(text)
+	fmt.Println("LCP array:", sa.LCP())
+	fmt.Println("Contains(\"nana\"):", sa.Contains("nana"))
+	fmt.Println("CountDistinctSubstrings:", sa.CountDistinctSubstrings())
+	fmt.Println("LongestRepeatedSubstring:", sa.LongestRepeatedSubstring())
+}
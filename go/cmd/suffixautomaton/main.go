@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/suffixautomaton"
+)
+
+func main() {
+	text := "banana"
+	sa := suffixautomaton.New(text)
+
+	fmt.Println("Text:", text)
+	fmt.Println("Contains(\"nana\"):", sa.Contains("nana"))
+	fmt.Println("CountOccurrences(\"ana\"):", sa.CountOccurrences("ana"))
+	fmt.Println("CountDistinctSubstrings:", sa.CountDistinctSubstrings())
+	fmt.Println("LongestCommonSubstring with \"ananas\":", sa.LongestCommonSubstring("ananas"))
+
+	lcs, positions := suffixautomaton.LongestCommonSubstrings([]string{"banana", "ananas", "cabana"})
+	fmt.Println("LongestCommonSubstrings of banana/ananas/cabana:", lcs, positions)
+}
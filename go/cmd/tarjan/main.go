@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/tarjan"
+)
+
+func main() {
+	// Example graph
+	// 0 → 1, 1 → 2, 2 → 0, 1 → 3, 3 → 4
+	graph := [][]int{
+		{1},
+		{2, 3},
+		{0},
+		{4},
+		{},
+	}
+
+	n := len(graph)
+	sccs := tarjan.Tarjan(graph, n)
+
+	fmt.Println("Strongly Connected Components (SCCs):")
+	for _, scc := range sccs {
+		fmt.Println(scc)
+	}
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/timemap"
+)
+
+func main() {
+	m := timemap.New[string]()
+	m.Set("foo", "bar", 1)
+	m.Set("foo", "bar2", 4)
+
+	for _, ts := range []int{0, 1, 3, 4, 100} {
+		v, ok := m.Get("foo", ts)
+		fmt.Printf("Get(foo, %d) = %q, ok=%v\n", ts, v, ok)
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/trie"
+)
+
+func main() {
+	words := []string{"apple", "app", "application", "apply", "banana"}
+
+	tr := trie.NewTrie[int]()
+	for i, w := range words {
+		tr.Insert(w, i)
+	}
+	fmt.Println("Trie autocomplete for \"app\":", tr.AutocompleteTopK("app", 3))
+
+	rt := trie.NewRadixTree[int]()
+	for i, w := range words {
+		rt.Insert(w, i)
+	}
+	fmt.Println("RadixTree autocomplete for \"app\":", rt.AutocompleteTopK("app", 3))
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ghostmkg/dsa-code/go/concurrency/unionfind"
+)
+
+func main() {
+	uf := unionfind.NewConcurrentUnionFind(1000)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 999; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			uf.Union(i, i+1)
+		}(i)
+	}
+	wg.Wait()
+
+	fmt.Println("0 and 999 connected:", uf.Connected(0, 999))
+}
@@ -0,0 +1,26 @@
+// Command virtualtree demonstrates structures/virtualtree: building the
+// compressed auxiliary tree over a handful of query vertices from a
+// much larger tree.
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/virtualtree"
+)
+
+func main() {
+	// A small tree rooted at 0: 0->{1,2,3}, 1->{4,5}, 3->{6}, 4->{7}.
+	adj := make([][]int, 8)
+	edges := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {3, 6}, {4, 7}}
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+
+	vt := virtualtree.BuildVirtualTree(8, 0, adj, []int{7, 5, 6})
+	fmt.Println("virtual tree root:", vt.Root)
+	for parent, children := range vt.Children {
+		fmt.Printf("%d -> %v\n", parent, children)
+	}
+}
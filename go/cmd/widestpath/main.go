@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/graph/widestpath"
+)
+
+func main() {
+	graph := [][]widestpath.Edge{
+		{{To: 1, Weight: 4}, {To: 2, Weight: 1}},
+		{{To: 3, Weight: 1}},
+		{{To: 1, Weight: 2}, {To: 3, Weight: 5}},
+		{},
+	}
+	bottleneck, err := widestpath.WidestPath(graph, 0)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("widest-path bottleneck capacities from node 0:", bottleneck)
+
+	edges := []widestpath.UndirectedEdge{
+		{U: 0, V: 1, Weight: 4},
+		{U: 0, V: 2, Weight: 1},
+		{U: 2, V: 1, Weight: 2},
+		{U: 1, V: 3, Weight: 1},
+		{U: 2, V: 3, Weight: 5},
+	}
+	capacity, ok, err := widestpath.TwoNodeWidestPath(edges, 4, 0, 3)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("widest path 0->3 via maximum spanning tree:", capacity, "reachable:", ok)
+}
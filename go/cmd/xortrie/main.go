@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/structures/xortrie"
+)
+
+func main() {
+	nums := []int{3, 10, 5, 25, 2, 8}
+
+	best, _ := xortrie.MaxXorPair(nums)
+	fmt.Println("max XOR pair:", best)
+
+	fmt.Println("max XOR subarray:", xortrie.MaxXorSubarray(nums))
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/strings/zalgorithm"
+)
+
+func main() {
+	s := "aabxaabxcaabxaabxay"
+	fmt.Println("ZArray:", zalgorithm.ZArray(s))
+
+	text, pattern := "abxabcabcaby", "abcaby"
+	fmt.Printf("FindAll(%q, %q) = %v\n", text, pattern, zalgorithm.FindAll(text, pattern))
+
+	periodic := "abcabcabc"
+	fmt.Printf("SmallestPeriod(%q) = %d\n", periodic, zalgorithm.SmallestPeriod(periodic))
+
+	a, b := "hello wor", "world"
+	fmt.Printf("LongestSuffixPrefixOverlap(%q, %q) = %d\n", a, b, zalgorithm.LongestSuffixPrefixOverlap(a, b))
+}
@@ -0,0 +1,78 @@
+// Package complexity empirically estimates an algorithm's time
+// complexity: run it at geometrically increasing input sizes, time each
+// run, and fit a power law (time ~ c * n^exponent) to the results via a
+// least-squares regression on the log-log data. The fitted exponent is a
+// rough, noisy estimate of the algorithm's Big-O exponent (2 for O(n^2),
+// ~1 for O(n log n) over a narrow enough size range, etc.) — useful for
+// sanity-checking an implementation or for teaching, not a proof.
+package complexity
+
+import (
+	"math"
+	"time"
+)
+
+// Sample is one measured (input size, elapsed time) pair.
+type Sample struct {
+	N        int
+	Duration time.Duration
+}
+
+// Measure runs f once per size in sizes (averaged over repeats runs) and
+// returns the resulting samples, in the order sizes was given. Sizes
+// should be skipped if non-positive; f is expected to do work
+// proportional to n and otherwise ignore its return value.
+func Measure(f func(n int), sizes []int, repeats int) []Sample {
+	if repeats < 1 {
+		repeats = 1
+	}
+
+	samples := make([]Sample, 0, len(sizes))
+	for _, n := range sizes {
+		if n <= 0 {
+			continue
+		}
+		var total time.Duration
+		for r := 0; r < repeats; r++ {
+			start := time.Now()
+			f(n)
+			total += time.Since(start)
+		}
+		samples = append(samples, Sample{N: n, Duration: total / time.Duration(repeats)})
+	}
+	return samples
+}
+
+// FitPowerLaw fits time ~ c * n^exponent to samples by linear least
+// squares on (log n, log time), returning the fitted exponent and
+// constant c. Samples with n <= 0 or a zero duration are ignored, since
+// their logarithm is undefined; FitPowerLaw returns (0, 0) if fewer than
+// two usable samples remain.
+func FitPowerLaw(samples []Sample) (exponent, constant float64) {
+	var xs, ys []float64
+	for _, s := range samples {
+		if s.N <= 0 || s.Duration <= 0 {
+			continue
+		}
+		xs = append(xs, math.Log(float64(s.N)))
+		ys = append(ys, math.Log(float64(s.Duration)))
+	}
+	if len(xs) < 2 {
+		return 0, 0
+	}
+
+	n := float64(len(xs))
+	var sumX, sumY, sumXY, sumXX float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXY += xs[i] * ys[i]
+		sumXX += xs[i] * xs[i]
+	}
+
+	// Standard least-squares slope/intercept for y = slope*x + intercept.
+	slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+	intercept := (sumY - slope*sumX) / n
+
+	return slope, math.Exp(intercept)
+}
@@ -0,0 +1,58 @@
+package complexity
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFitPowerLawExact(t *testing.T) {
+	// Construct samples that exactly follow time = 3 * n^2 (in nanoseconds)
+	// so the fit should recover exponent=2, constant=3 almost exactly.
+	var samples []Sample
+	for _, n := range []int{10, 20, 40, 80, 160} {
+		samples = append(samples, Sample{N: n, Duration: time.Duration(3 * n * n)})
+	}
+
+	exponent, constant := FitPowerLaw(samples)
+	if math.Abs(exponent-2) > 1e-9 {
+		t.Errorf("FitPowerLaw() exponent = %v, want ~2", exponent)
+	}
+	if math.Abs(constant-3) > 1e-6 {
+		t.Errorf("FitPowerLaw() constant = %v, want ~3", constant)
+	}
+}
+
+func TestFitPowerLawTooFewSamples(t *testing.T) {
+	if exponent, constant := FitPowerLaw([]Sample{{N: 10, Duration: time.Second}}); exponent != 0 || constant != 0 {
+		t.Errorf("FitPowerLaw(1 sample) = (%v, %v), want (0, 0)", exponent, constant)
+	}
+	if exponent, constant := FitPowerLaw(nil); exponent != 0 || constant != 0 {
+		t.Errorf("FitPowerLaw(nil) = (%v, %v), want (0, 0)", exponent, constant)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	var sizesSeen []int
+	samples := Measure(func(n int) {
+		sizesSeen = append(sizesSeen, n)
+		// Busy-wait an amount proportional to n so Duration is nonzero
+		// and ordered, without depending on a real sleep/scheduler.
+		sum := 0
+		for i := 0; i < n*1000; i++ {
+			sum += i
+		}
+		_ = sum
+	}, []int{0, -5, 100, 200}, 2)
+
+	if want := []int{100, 100, 200, 200}; len(sizesSeen) != len(want) {
+		t.Fatalf("Measure() called f for sizes %v, want calls for %v (non-positive sizes skipped)", sizesSeen, want)
+	}
+
+	if len(samples) != 2 {
+		t.Fatalf("Measure() = %v, want 2 samples (non-positive sizes skipped)", samples)
+	}
+	if samples[0].N != 100 || samples[1].N != 200 {
+		t.Errorf("Measure() sizes = [%d, %d], want [100, 200]", samples[0].N, samples[1].N)
+	}
+}
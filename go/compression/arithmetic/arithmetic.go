@@ -0,0 +1,246 @@
+// Package arithmetic implements adaptive arithmetic coding over byte
+// streams: a more advanced entropy coder than compression/huffman that
+// doesn't round each symbol's code to a whole number of bits, so it can
+// get closer to the Shannon entropy bound on skewed distributions where
+// Huffman is stuck paying at least one bit per symbol.
+//
+// The implementation follows the classic Witten-Neal-Cleary integer
+// arithmetic coding algorithm with 16-bit registers: low and high are
+// narrowed to the interval of the symbol being coded, then renormalized
+// (shifting out agreed-upon leading bits, and tracking "pending" bits
+// through the E3 underflow case) so the registers never need more than
+// 16 bits of precision. The frequency model is adaptive order-0 over
+// byte values — every symbol starts with count 1 (so nothing is ever
+// unencodable) and its count increments after each occurrence — so
+// unlike Huffman there is no separate header of code lengths to
+// transmit; the encoder and decoder rebuild the same model in lockstep.
+package arithmetic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ghostmkg/dsa-code/go/compression/bitio"
+)
+
+const (
+	codeBits     = 16
+	full         = uint32(1)<<codeBits - 1 // 0xFFFF
+	half         = uint32(1) << (codeBits - 1)
+	firstQuarter = half / 2
+	thirdQuarter = half + firstQuarter
+	maxTotal     = firstQuarter - 1 // keeps every sub-interval non-empty
+)
+
+// model is an adaptive order-0 frequency model over byte values.
+type model struct {
+	freq  [256]uint32
+	total uint32
+}
+
+func newModel() *model {
+	m := &model{total: 256}
+	for i := range m.freq {
+		m.freq[i] = 1
+	}
+	return m
+}
+
+// bounds returns the cumulative frequency range of s: [low, high), plus
+// the model's current total.
+func (m *model) bounds(s byte) (low, high, total uint32) {
+	for i := 0; i < int(s); i++ {
+		low += m.freq[i]
+	}
+	return low, low + m.freq[s], m.total
+}
+
+// find returns the symbol whose cumulative range contains target, along
+// with that range's [low, high) bounds.
+func (m *model) find(target uint32) (s byte, low, high uint32) {
+	var cum uint32
+	for i := 0; i < 256; i++ {
+		next := cum + m.freq[i]
+		if target < next {
+			return byte(i), cum, next
+		}
+		cum = next
+	}
+	panic("arithmetic: cumulative frequency target out of range")
+}
+
+// update increments s's count, rescaling every symbol's count (halving,
+// floor 1) if the total would otherwise grow past what 16-bit registers
+// can divide by safely.
+func (m *model) update(s byte) {
+	m.freq[s]++
+	m.total++
+	if m.total > maxTotal {
+		m.total = 0
+		for i := range m.freq {
+			m.freq[i] = m.freq[i]/2 + 1
+			m.total += m.freq[i]
+		}
+	}
+}
+
+// Encode arithmetic-encodes data to w: a 4-byte big-endian length header
+// followed by the coded bitstream.
+func Encode(w io.Writer, data []byte) error {
+	var lenBuf [4]byte
+	binary.BigEndian.PutUint32(lenBuf[:], uint32(len(data)))
+	if _, err := w.Write(lenBuf[:]); err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	bw := bitio.NewWriter(w)
+	e := &encoderState{bw: bw, high: full}
+	m := newModel()
+	for _, b := range data {
+		low, high, total := m.bounds(b)
+		if err := e.encode(low, high, total); err != nil {
+			return err
+		}
+		m.update(b)
+	}
+	if err := e.finish(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// encoderState tracks the narrowing [low, high] interval and the count
+// of pending opposite-bit outputs accumulated across E3 underflow steps.
+type encoderState struct {
+	bw      *bitio.Writer
+	low     uint32
+	high    uint32
+	pending int
+	err     error
+}
+
+func (e *encoderState) emit(bit uint32) {
+	if e.err != nil {
+		return
+	}
+	e.err = e.bw.WriteBits(bit, 1)
+	for ; e.pending > 0 && e.err == nil; e.pending-- {
+		e.err = e.bw.WriteBits(1-bit, 1)
+	}
+}
+
+func (e *encoderState) encode(low, high, total uint32) error {
+	span := e.high - e.low + 1
+	e.high = e.low + span*high/total - 1
+	e.low = e.low + span*low/total
+
+	for {
+		switch {
+		case e.high < half:
+			e.emit(0)
+		case e.low >= half:
+			e.emit(1)
+			e.low -= half
+			e.high -= half
+		case e.low >= firstQuarter && e.high < thirdQuarter:
+			e.pending++
+			e.low -= firstQuarter
+			e.high -= firstQuarter
+		default:
+			return e.err
+		}
+		e.low <<= 1
+		e.high = e.high<<1 | 1
+	}
+}
+
+func (e *encoderState) finish() error {
+	e.pending++
+	if e.low < firstQuarter {
+		e.emit(0)
+	} else {
+		e.emit(1)
+	}
+	return e.err
+}
+
+// Decode reverses Encode, reading exactly the bytes Encode wrote for the
+// original data.
+func Decode(r io.Reader) ([]byte, error) {
+	var lenBuf [4]byte
+	if _, err := io.ReadFull(r, lenBuf[:]); err != nil {
+		return nil, fmt.Errorf("arithmetic: reading length header: %w", err)
+	}
+	origLen := binary.BigEndian.Uint32(lenBuf[:])
+	if origLen == 0 {
+		return []byte{}, nil
+	}
+
+	br := bitio.NewReader(r)
+	d := &decoderState{br: br, high: full}
+	for i := 0; i < codeBits; i++ {
+		d.value = d.value<<1 | uint32(d.nextBit())
+	}
+
+	m := newModel()
+	out := make([]byte, 0, origLen)
+	for uint32(len(out)) < origLen {
+		span := d.high - d.low + 1
+		total := m.total
+		target := ((d.value-d.low+1)*total - 1) / span
+
+		s, low, high := m.find(target)
+		out = append(out, s)
+
+		d.high = d.low + span*high/total - 1
+		d.low = d.low + span*low/total
+		m.update(s)
+
+		for {
+			switch {
+			case d.high < half:
+			case d.low >= half:
+				d.low -= half
+				d.high -= half
+				d.value -= half
+			case d.low >= firstQuarter && d.high < thirdQuarter:
+				d.low -= firstQuarter
+				d.high -= firstQuarter
+				d.value -= firstQuarter
+			default:
+				goto renormalized
+			}
+			d.low <<= 1
+			d.high = d.high<<1 | 1
+			d.value = d.value<<1 | uint32(d.nextBit())
+		}
+	renormalized:
+	}
+	return out, nil
+}
+
+// decoderState mirrors encoderState: low/high track the same narrowing
+// interval, and value is the window of coded bits seen so far.
+type decoderState struct {
+	br    *bitio.Reader
+	low   uint32
+	high  uint32
+	value uint32
+}
+
+// nextBit reads one more coded bit, or returns 0 once the stream is
+// exhausted — Encode's finish already guarantees enough bits were
+// written to resolve every symbol, so any bits "read" past the end of
+// the real stream only ever pad out of the registers' own arithmetic,
+// never decode into wrong symbols.
+func (d *decoderState) nextBit() byte {
+	bit, err := d.br.ReadBit()
+	if err != nil {
+		return 0
+	}
+	return bit
+}
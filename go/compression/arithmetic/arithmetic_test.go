@@ -0,0 +1,113 @@
+package arithmetic
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/compression/huffman"
+)
+
+func roundTrip(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Encode(&buf, data); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	out, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	return out
+}
+
+func TestRoundTripEmpty(t *testing.T) {
+	if out := roundTrip(t, []byte{}); len(out) != 0 {
+		t.Errorf("round trip of empty input = %v, want empty", out)
+	}
+}
+
+func TestRoundTripSingleByte(t *testing.T) {
+	out := roundTrip(t, []byte{42})
+	if !bytes.Equal(out, []byte{42}) {
+		t.Errorf("round trip = %v, want [42]", out)
+	}
+}
+
+func TestRoundTripRepeatedByte(t *testing.T) {
+	data := bytes.Repeat([]byte{'x'}, 500)
+	out := roundTrip(t, data)
+	if !bytes.Equal(out, data) {
+		t.Error("round trip of a single repeated byte did not match")
+	}
+}
+
+func TestRoundTripSkewedDistribution(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]byte, 4000)
+	for i := range data {
+		switch {
+		case r.Float64() < 0.9:
+			data[i] = 'a'
+		case r.Float64() < 0.5:
+			data[i] = 'b'
+		default:
+			data[i] = 'c'
+		}
+	}
+	out := roundTrip(t, data)
+	if !bytes.Equal(out, data) {
+		t.Error("round trip of skewed distribution did not match")
+	}
+}
+
+func TestRoundTripAllByteValues(t *testing.T) {
+	data := make([]byte, 256)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	out := roundTrip(t, data)
+	if !bytes.Equal(out, data) {
+		t.Error("round trip over every byte value did not match")
+	}
+}
+
+func TestRoundTripRandomBytes(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	data := make([]byte, 2000)
+	r.Read(data)
+	out := roundTrip(t, data)
+	if !bytes.Equal(out, data) {
+		t.Error("round trip of random bytes did not match")
+	}
+}
+
+// TestBeatsHuffmanOnSkewedInput checks arithmetic coding's headline
+// advantage over Huffman: on a distribution far from a power-of-two
+// split, Huffman is stuck paying at least one bit per symbol for the
+// dominant byte, while arithmetic coding can spend a fraction of a bit.
+func TestBeatsHuffmanOnSkewedInput(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	data := make([]byte, 10000)
+	for i := range data {
+		if r.Float64() < 0.95 {
+			data[i] = 'a'
+		} else {
+			data[i] = byte('b' + r.Intn(4))
+		}
+	}
+
+	var arith bytes.Buffer
+	if err := Encode(&arith, data); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	var huff bytes.Buffer
+	if err := huffman.Encode(&huff, data); err != nil {
+		t.Fatalf("huffman.Encode: %v", err)
+	}
+
+	if arith.Len() >= huff.Len() {
+		t.Errorf("arithmetic size %d not smaller than huffman size %d on skewed input", arith.Len(), huff.Len())
+	}
+}
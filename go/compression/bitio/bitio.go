@@ -0,0 +1,82 @@
+// Package bitio provides minimal MSB-first bit-level reading and writing
+// over the standard io.Reader/io.Writer interfaces, the building block
+// compression/huffman (and any future bit-packed format in this module)
+// streams its codes through.
+package bitio
+
+import (
+	"bufio"
+	"io"
+)
+
+// Writer buffers bits written with WriteBits and flushes whole bytes to an
+// underlying io.Writer, most-significant-bit first.
+type Writer struct {
+	w    *bufio.Writer
+	cur  byte
+	nbit uint8 // number of bits already packed into cur
+}
+
+// NewWriter returns a Writer that flushes completed bytes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// WriteBits writes the low n bits of bits (n <= 32), most-significant bit
+// first.
+func (bw *Writer) WriteBits(value uint32, n uint8) error {
+	for i := int(n) - 1; i >= 0; i-- {
+		bit := byte((value >> uint(i)) & 1)
+		bw.cur = bw.cur<<1 | bit
+		bw.nbit++
+		if bw.nbit == 8 {
+			if err := bw.w.WriteByte(bw.cur); err != nil {
+				return err
+			}
+			bw.cur, bw.nbit = 0, 0
+		}
+	}
+	return nil
+}
+
+// Flush pads any partial final byte with zero bits and writes it, then
+// flushes the underlying buffered writer.
+func (bw *Writer) Flush() error {
+	if bw.nbit > 0 {
+		bw.cur <<= 8 - bw.nbit
+		if err := bw.w.WriteByte(bw.cur); err != nil {
+			return err
+		}
+		bw.cur, bw.nbit = 0, 0
+	}
+	return bw.w.Flush()
+}
+
+// Reader reads bits, most-significant bit first, out of an underlying
+// io.Reader one buffered byte at a time.
+type Reader struct {
+	r    *bufio.Reader
+	cur  byte
+	nbit uint8 // number of unread bits remaining in cur
+}
+
+// NewReader returns a Reader pulling bytes from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// ReadBit reads a single bit.
+func (br *Reader) ReadBit() (byte, error) {
+	if br.nbit == 0 {
+		b, err := br.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		br.cur = b
+		br.nbit = 8
+	}
+	bit := (br.cur >> 7) & 1
+	br.cur <<= 1
+	br.nbit--
+	return bit, nil
+}
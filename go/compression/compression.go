@@ -0,0 +1,27 @@
+// Package compression declares the interfaces that this module's
+// dictionary-based compressors (lz77, lzw) are each adapted to, plus a
+// small Ratio helper shared by their demos for reporting how much an
+// implementation actually shrank its input.
+package compression
+
+// Compressor compresses a byte slice into a self-contained compressed
+// form that a matching Decompressor can reverse.
+type Compressor interface {
+	Compress(data []byte) ([]byte, error)
+}
+
+// Decompressor reverses a Compressor's output.
+type Decompressor interface {
+	Decompress(data []byte) ([]byte, error)
+}
+
+// Ratio returns compressedSize as a fraction of originalSize, the usual
+// compression-ratio figure: a ratio of 0.5 means the compressed form is
+// half the size of the original, and smaller is better. It returns 0 for
+// an empty original rather than dividing by zero.
+func Ratio(originalSize, compressedSize int) float64 {
+	if originalSize == 0 {
+		return 0
+	}
+	return float64(compressedSize) / float64(originalSize)
+}
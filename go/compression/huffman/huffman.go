@@ -0,0 +1,270 @@
+// Package huffman implements Huffman coding over byte streams: a tree is
+// built from symbol frequencies, code lengths are read off that tree, and
+// the actual codes assigned are canonical — ordered by (length, symbol)
+// with no gaps — so Decode only needs each symbol's code length, not the
+// bit patterns themselves, to reconstruct the same codes.
+package huffman
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/compression/bitio"
+)
+
+type treeNode struct {
+	freq        int
+	symbol      byte
+	isLeaf      bool
+	left, right *treeNode
+}
+
+// nodeHeap is a min-heap of *treeNode by frequency, used to repeatedly
+// merge the two least-frequent nodes when building the Huffman tree.
+type nodeHeap []*treeNode
+
+func (h nodeHeap) Len() int            { return len(h) }
+func (h nodeHeap) Less(i, j int) bool  { return h[i].freq < h[j].freq }
+func (h nodeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *nodeHeap) Push(x interface{}) { *h = append(*h, x.(*treeNode)) }
+func (h *nodeHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	last := old[n-1]
+	*h = old[:n-1]
+	return last
+}
+
+// buildTree constructs a Huffman tree from symbol frequencies. It panics
+// if freqs is empty, since there is no tree (and no valid encoding) for
+// zero symbols.
+func buildTree(freqs map[byte]int) *treeNode {
+	if len(freqs) == 0 {
+		panic("huffman: buildTree requires at least one symbol")
+	}
+
+	h := make(nodeHeap, 0, len(freqs))
+	// Iterate symbols in sorted order so two runs over the same
+	// frequencies always build byte-identical trees, even though Go map
+	// iteration order is randomized.
+	symbols := make([]byte, 0, len(freqs))
+	for s := range freqs {
+		symbols = append(symbols, s)
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i] < symbols[j] })
+	for _, s := range symbols {
+		h = append(h, &treeNode{freq: freqs[s], symbol: s, isLeaf: true})
+	}
+	heap.Init(&h)
+
+	for h.Len() > 1 {
+		a := heap.Pop(&h).(*treeNode)
+		b := heap.Pop(&h).(*treeNode)
+		heap.Push(&h, &treeNode{freq: a.freq + b.freq, left: a, right: b})
+	}
+	return h[0]
+}
+
+// codeLengths returns each leaf symbol's depth in the tree. A single-leaf
+// tree (one distinct symbol) is given length 1, since a real bitstream
+// still needs to write something per occurrence.
+func codeLengths(root *treeNode) map[byte]uint8 {
+	lengths := map[byte]uint8{}
+	if root.isLeaf {
+		lengths[root.symbol] = 1
+		return lengths
+	}
+	var walk func(n *treeNode, depth uint8)
+	walk = func(n *treeNode, depth uint8) {
+		if n.isLeaf {
+			lengths[n.symbol] = depth
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk(root, 0)
+	return lengths
+}
+
+type code struct {
+	bits   uint32
+	length uint8
+}
+
+// canonicalCodes assigns canonical Huffman codes from code lengths alone:
+// symbols are ordered by (length, symbol value), and codes increase by one
+// within a length and shift left by one bit whenever the length grows.
+func canonicalCodes(lengths map[byte]uint8) map[byte]code {
+	symbols := make([]byte, 0, len(lengths))
+	for s := range lengths {
+		symbols = append(symbols, s)
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		if lengths[symbols[i]] != lengths[symbols[j]] {
+			return lengths[symbols[i]] < lengths[symbols[j]]
+		}
+		return symbols[i] < symbols[j]
+	})
+
+	codes := make(map[byte]code, len(symbols))
+	var curCode uint32
+	var curLen uint8
+	for _, s := range symbols {
+		length := lengths[s]
+		curCode <<= length - curLen
+		curLen = length
+		codes[s] = code{bits: curCode, length: length}
+		curCode++
+	}
+	return codes
+}
+
+// Encode Huffman-encodes data to w: a small header (the original byte
+// count and each present symbol's canonical code length) followed by the
+// bitstream of codes.
+func Encode(w io.Writer, data []byte) error {
+	var lenBuf [4]byte
+	binary.BigEndian.PutUint32(lenBuf[:], uint32(len(data)))
+	if _, err := w.Write(lenBuf[:]); err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	freqs := map[byte]int{}
+	for _, b := range data {
+		freqs[b]++
+	}
+	lengths := codeLengths(buildTree(freqs))
+	if err := writeLengthTable(w, lengths); err != nil {
+		return err
+	}
+
+	codes := canonicalCodes(lengths)
+	bw := bitio.NewWriter(w)
+	for _, b := range data {
+		c := codes[b]
+		if err := bw.WriteBits(c.bits, c.length); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// writeLengthTable writes the number of distinct symbols followed by each
+// symbol's byte and canonical code length, sorted by symbol value for a
+// deterministic encoding.
+func writeLengthTable(w io.Writer, lengths map[byte]uint8) error {
+	symbols := make([]byte, 0, len(lengths))
+	for s := range lengths {
+		symbols = append(symbols, s)
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i] < symbols[j] })
+
+	if _, err := w.Write([]byte{byte(len(symbols) - 1)}); err != nil { // 1..256 symbols fit in a byte this way
+		return err
+	}
+	for _, s := range symbols {
+		if _, err := w.Write([]byte{s, lengths[s]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readLengthTable(r io.Reader) (map[byte]uint8, error) {
+	var countBuf [1]byte
+	if _, err := io.ReadFull(r, countBuf[:]); err != nil {
+		return nil, err
+	}
+	count := int(countBuf[0]) + 1
+
+	lengths := make(map[byte]uint8, count)
+	pair := make([]byte, 2)
+	for i := 0; i < count; i++ {
+		if _, err := io.ReadFull(r, pair); err != nil {
+			return nil, err
+		}
+		lengths[pair[0]] = pair[1]
+	}
+	return lengths, nil
+}
+
+// decodeNode is a node of the small trie Decode rebuilds from canonical
+// code lengths to walk bit-by-bit from the bitstream back to symbols.
+type decodeNode struct {
+	symbol      byte
+	isLeaf      bool
+	left, right *decodeNode
+}
+
+func buildDecodeTrie(codes map[byte]code) *decodeNode {
+	root := &decodeNode{}
+	for symbol, c := range codes {
+		n := root
+		for i := int(c.length) - 1; i >= 0; i-- {
+			bit := (c.bits >> uint(i)) & 1
+			if bit == 0 {
+				if n.left == nil {
+					n.left = &decodeNode{}
+				}
+				n = n.left
+			} else {
+				if n.right == nil {
+					n.right = &decodeNode{}
+				}
+				n = n.right
+			}
+		}
+		n.isLeaf = true
+		n.symbol = symbol
+	}
+	return root
+}
+
+// Decode reverses Encode, reading exactly the bytes Encode wrote for the
+// original data.
+func Decode(r io.Reader) ([]byte, error) {
+	var lenBuf [4]byte
+	if _, err := io.ReadFull(r, lenBuf[:]); err != nil {
+		return nil, fmt.Errorf("huffman: reading length header: %w", err)
+	}
+	origLen := binary.BigEndian.Uint32(lenBuf[:])
+	if origLen == 0 {
+		return []byte{}, nil
+	}
+
+	lengths, err := readLengthTable(r)
+	if err != nil {
+		return nil, fmt.Errorf("huffman: reading code length table: %w", err)
+	}
+	codes := canonicalCodes(lengths)
+	root := buildDecodeTrie(codes)
+
+	out := make([]byte, 0, origLen)
+	br := bitio.NewReader(r)
+	for uint32(len(out)) < origLen {
+		n := root
+		for !n.isLeaf {
+			bit, err := br.ReadBit()
+			if err != nil {
+				return nil, fmt.Errorf("huffman: reading bitstream: %w", err)
+			}
+			if bit == 0 {
+				n = n.left
+			} else {
+				n = n.right
+			}
+			if n == nil {
+				return nil, fmt.Errorf("huffman: corrupt bitstream: no matching code")
+			}
+		}
+		out = append(out, n.symbol)
+	}
+	return out, nil
+}
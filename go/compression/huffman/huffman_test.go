@@ -0,0 +1,70 @@
+package huffman
+
+import (
+	"bytes"
+	"testing"
+)
+
+func roundTrip(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	if err := Encode(&buf, data); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	got, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	return got
+}
+
+func TestRoundTrip(t *testing.T) {
+	cases := [][]byte{
+		[]byte(""),
+		[]byte("a"),
+		[]byte("aaaaaaaaaa"),
+		[]byte("the quick brown fox jumps over the lazy dog"),
+		[]byte("abracadabra abracadabra abracadabra"),
+		bytes.Repeat([]byte{0, 1, 2, 255}, 100),
+	}
+	for _, data := range cases {
+		got := roundTrip(t, data)
+		if !bytes.Equal(got, data) {
+			t.Errorf("round trip of %q = %q, want original back", data, got)
+		}
+	}
+}
+
+func TestCompressionRatioOnSkewedText(t *testing.T) {
+	// Highly repetitive text should compress well below its raw size.
+	data := bytes.Repeat([]byte("abababababababab"), 200)
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, data); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if buf.Len() >= len(data) {
+		t.Errorf("encoded size %d should be smaller than input size %d for skewed input", buf.Len(), len(data))
+	}
+
+	got, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("decoded data does not match original after compression")
+	}
+}
+
+func TestCanonicalCodesAreDeterministic(t *testing.T) {
+	freqs := map[byte]int{'a': 5, 'b': 3, 'c': 1, 'd': 1}
+	l1 := codeLengths(buildTree(freqs))
+	l2 := codeLengths(buildTree(freqs))
+
+	c1 := canonicalCodes(l1)
+	c2 := canonicalCodes(l2)
+	for s, c := range c1 {
+		if c2[s] != c {
+			t.Errorf("canonicalCodes not deterministic across runs for symbol %q: %v vs %v", s, c, c2[s])
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package lz77
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Codec adapts lz77 to the compression.Compressor/Decompressor
+// interfaces by serializing Encode's tokens to a flat byte encoding, so
+// a round trip becomes a plain []byte -> []byte operation. The wire
+// format favors simplicity over compactness (each token is a fixed 5 or
+// 6 bytes, not bit-packed), so Codec's output is larger than the token
+// count alone would suggest.
+type Codec struct {
+	// WindowSize bounds how far back Compress looks for a match. It must
+	// fit in a uint16 (at most 65535), since that's the width each
+	// token's offset and length are serialized with.
+	WindowSize int
+}
+
+// Compress LZ77-encodes data and serializes the resulting tokens.
+func (c Codec) Compress(data []byte) ([]byte, error) {
+	tokens := Encode(data, c.WindowSize)
+	return marshalTokens(tokens), nil
+}
+
+// Decompress reverses Compress.
+func (c Codec) Decompress(data []byte) ([]byte, error) {
+	tokens, err := unmarshalTokens(data)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(tokens), nil
+}
+
+// marshalTokens packs each token as offset (uint16), length (uint16), a
+// flag byte (1 if a literal follows), and the literal byte itself when
+// the flag is set.
+func marshalTokens(tokens []Token) []byte {
+	out := make([]byte, 0, len(tokens)*5)
+	var buf [4]byte
+	for _, t := range tokens {
+		binary.BigEndian.PutUint16(buf[0:2], uint16(t.Offset))
+		binary.BigEndian.PutUint16(buf[2:4], uint16(t.Length))
+		out = append(out, buf[:]...)
+		if t.HasNext {
+			out = append(out, 1, t.Next)
+		} else {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+func unmarshalTokens(data []byte) ([]Token, error) {
+	var tokens []Token
+	for len(data) > 0 {
+		if len(data) < 5 {
+			return nil, fmt.Errorf("lz77: truncated token stream")
+		}
+		t := Token{
+			Offset: int(binary.BigEndian.Uint16(data[0:2])),
+			Length: int(binary.BigEndian.Uint16(data[2:4])),
+		}
+		data = data[4:]
+		hasNext := data[0]
+		data = data[1:]
+		switch hasNext {
+		case 0:
+			// no literal
+		case 1:
+			if len(data) < 1 {
+				return nil, fmt.Errorf("lz77: truncated token stream")
+			}
+			t.Next, t.HasNext = data[0], true
+			data = data[1:]
+		default:
+			return nil, fmt.Errorf("lz77: corrupt token stream: bad flag byte %d", hasNext)
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens, nil
+}
@@ -0,0 +1,48 @@
+package lz77
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	cases := [][]byte{
+		[]byte(""),
+		[]byte("a"),
+		[]byte("abcabcabcabcabcabc"),
+		bytes.Repeat([]byte("abcdefgh"), 50),
+	}
+	codec := Codec{WindowSize: 64}
+	for _, data := range cases {
+		compressed, err := codec.Compress(data)
+		if err != nil {
+			t.Fatalf("Compress(%q): %v", data, err)
+		}
+		got, err := codec.Decompress(compressed)
+		if err != nil {
+			t.Fatalf("Decompress: %v", err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("round trip = %q, want %q", got, data)
+		}
+	}
+}
+
+func TestCodecShrinksRepetitiveInput(t *testing.T) {
+	data := bytes.Repeat([]byte("abcdefgh"), 200)
+	codec := Codec{WindowSize: 64}
+
+	compressed, err := codec.Compress(data)
+	if err != nil {
+		t.Fatalf("Compress: %v", err)
+	}
+	if len(compressed) >= len(data) {
+		t.Errorf("Compress produced %d bytes, want fewer than the original %d for highly repetitive input", len(compressed), len(data))
+	}
+}
+
+func TestUnmarshalTokensRejectsTruncatedStream(t *testing.T) {
+	if _, err := unmarshalTokens([]byte{0, 1, 2}); err == nil {
+		t.Error("unmarshalTokens on a truncated stream succeeded, want error")
+	}
+}
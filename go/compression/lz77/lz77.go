@@ -0,0 +1,88 @@
+// Package lz77 implements a sliding-window LZ77 compressor: each step
+// either emits a literal byte, or a (offset, length) back-reference to the
+// longest matching run found within the last windowSize bytes, followed
+// by the literal byte that broke the match. Unlike lzw's growing
+// dictionary, LZ77 only ever looks backward through a fixed-size window,
+// which is what makes the window size a tunable space/ratio knob.
+package lz77
+
+// Token is one step of an LZ77-encoded stream. A match (Length > 0) is a
+// back-reference Offset bytes behind the current output position,
+// Length bytes long; HasNext/Next carry the literal byte immediately
+// following the match, except at the very end of the input where a match
+// can run out without a trailing literal.
+type Token struct {
+	Offset  int
+	Length  int
+	Next    byte
+	HasNext bool
+}
+
+// minMatchLength is the shortest match worth encoding as a back-reference;
+// shorter matches cost more to encode (offset + length) than they save.
+const minMatchLength = 3
+
+// Encode compresses data using back-references no further than windowSize
+// bytes behind the current position.
+func Encode(data []byte, windowSize int) []Token {
+	var tokens []Token
+	pos := 0
+	for pos < len(data) {
+		length, offset := longestMatch(data, pos, windowSize)
+		if length >= minMatchLength {
+			next := pos + length
+			if next < len(data) {
+				tokens = append(tokens, Token{Offset: offset, Length: length, Next: data[next], HasNext: true})
+				pos = next + 1
+			} else {
+				tokens = append(tokens, Token{Offset: offset, Length: length})
+				pos = next
+			}
+		} else {
+			tokens = append(tokens, Token{Next: data[pos], HasNext: true})
+			pos++
+		}
+	}
+	return tokens
+}
+
+// longestMatch brute-force searches data[max(0,pos-windowSize):pos] for
+// the longest run that also matches data starting at pos, returning its
+// length and its distance behind pos. Matches are allowed to extend past
+// pos (length > offset), which lets a single token encode a run of
+// repeats, since Decode rebuilds output sequentially and can read bytes
+// it only just wrote.
+func longestMatch(data []byte, pos, windowSize int) (length, offset int) {
+	start := pos - windowSize
+	if start < 0 {
+		start = 0
+	}
+	for candidate := start; candidate < pos; candidate++ {
+		l := 0
+		for pos+l < len(data) && data[candidate+l] == data[pos+l] {
+			l++
+		}
+		if l > length {
+			length = l
+			offset = pos - candidate
+		}
+	}
+	return length, offset
+}
+
+// Decode reverses Encode.
+func Decode(tokens []Token) []byte {
+	var out []byte
+	for _, t := range tokens {
+		if t.Length > 0 {
+			start := len(out) - t.Offset
+			for i := 0; i < t.Length; i++ {
+				out = append(out, out[start+i])
+			}
+		}
+		if t.HasNext {
+			out = append(out, t.Next)
+		}
+	}
+	return out
+}
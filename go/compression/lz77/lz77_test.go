@@ -0,0 +1,45 @@
+package lz77
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	cases := [][]byte{
+		[]byte(""),
+		[]byte("a"),
+		[]byte("aaaaaaaaaaaaaaaaaaaa"),
+		[]byte("abcabcabcabcabcabc"),
+		[]byte("the quick brown fox jumps over the lazy dog"),
+		bytes.Repeat([]byte("ab"), 1000), // exercises matches longer than their own offset
+	}
+	for _, data := range cases {
+		tokens := Encode(data, 64)
+		got := Decode(tokens)
+		if !bytes.Equal(got, data) {
+			t.Errorf("round trip of %q = %q, want original back", data, got)
+		}
+	}
+}
+
+func TestWindowSizeLimitsOffset(t *testing.T) {
+	data := append(bytes.Repeat([]byte{'x'}, 20), []byte("abcabc")...)
+	tokens := Encode(data, 5)
+	for _, tok := range tokens {
+		if tok.Offset > 5 {
+			t.Errorf("token offset %d exceeds window size 5", tok.Offset)
+		}
+	}
+	if got := Decode(tokens); !bytes.Equal(got, data) {
+		t.Errorf("round trip with small window = %q, want %q", got, data)
+	}
+}
+
+func TestTokenCountShrinksOnRepetitiveInput(t *testing.T) {
+	data := bytes.Repeat([]byte("abcdefgh"), 200)
+	tokens := Encode(data, 64)
+	if len(tokens) >= len(data) {
+		t.Errorf("token count %d should be far fewer than byte count %d for repetitive input", len(tokens), len(data))
+	}
+}
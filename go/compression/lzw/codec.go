@@ -0,0 +1,27 @@
+package lzw
+
+import "bytes"
+
+// Codec adapts lzw to the compression.Compressor/Decompressor
+// interfaces, buffering EncodeWithDictSize/DecodeWithDictSize's
+// io.Writer/io.Reader streams into plain byte slices.
+type Codec struct {
+	// MaxDictSize caps the dictionary, as in EncodeWithDictSize. Zero
+	// (or any value outside (256, maxDictSize]) falls back to
+	// maxDictSize.
+	MaxDictSize int
+}
+
+// Compress LZW-encodes data.
+func (c Codec) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := EncodeWithDictSize(&buf, data, c.MaxDictSize); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress reverses Compress.
+func (c Codec) Decompress(data []byte) ([]byte, error) {
+	return DecodeWithDictSize(bytes.NewReader(data), c.MaxDictSize)
+}
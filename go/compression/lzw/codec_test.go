@@ -0,0 +1,63 @@
+package lzw
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("TOBEORNOTTOBEOR"), 50)
+	codec := Codec{MaxDictSize: 512}
+
+	compressed, err := codec.Compress(data)
+	if err != nil {
+		t.Fatalf("Compress: %v", err)
+	}
+	got, err := codec.Decompress(compressed)
+	if err != nil {
+		t.Fatalf("Decompress: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("round trip = %q, want %q", got, data)
+	}
+}
+
+func TestCodecDefaultDictSize(t *testing.T) {
+	data := []byte("hello world")
+	codec := Codec{}
+
+	compressed, err := codec.Compress(data)
+	if err != nil {
+		t.Fatalf("Compress: %v", err)
+	}
+	got, err := codec.Decompress(compressed)
+	if err != nil {
+		t.Fatalf("Decompress: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("round trip = %q, want %q", got, data)
+	}
+}
+
+func TestEncodeWithDictSizeResetsMoreOftenWhenSmall(t *testing.T) {
+	data := bytes.Repeat([]byte("abcdefghij"), 200)
+
+	var small, large bytes.Buffer
+	if err := EncodeWithDictSize(&small, data, 300); err != nil {
+		t.Fatalf("EncodeWithDictSize(small): %v", err)
+	}
+	if err := EncodeWithDictSize(&large, data, 1<<16); err != nil {
+		t.Fatalf("EncodeWithDictSize(large): %v", err)
+	}
+	if small.Len() <= large.Len() {
+		t.Errorf("small-dictionary encoding = %d bytes, want more than the large-dictionary encoding's %d (more frequent resets should compress worse)", small.Len(), large.Len())
+	}
+
+	got, err := DecodeWithDictSize(&small, 300)
+	if err != nil {
+		t.Fatalf("DecodeWithDictSize(small): %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("small-dictionary round trip did not reproduce the original data")
+	}
+}
@@ -0,0 +1,144 @@
+// Package lzw implements dictionary-based LZW compression, streaming
+// fixed-width 16-bit codes over io.Reader/io.Writer: unlike Huffman's
+// entropy coding (shorter codes for more frequent symbols), LZW builds a
+// dictionary of previously seen substrings on the fly and replaces repeats
+// of them with a single code, so it does well on data with repeated runs
+// regardless of byte-frequency skew.
+package lzw
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// maxDictSize bounds the dictionary to what a uint16 code can address;
+// once it fills, both sides reset back to the initial single-byte entries.
+const maxDictSize = 1 << 16
+
+// initialDict returns the starting string->code dictionary: every single
+// byte value maps to its own value as a code, so any input can always be
+// encoded even before the dictionary has learned any repeats.
+func initialDict() map[string]uint16 {
+	dict := make(map[string]uint16, 256)
+	for b := 0; b < 256; b++ {
+		dict[string([]byte{byte(b)})] = uint16(b)
+	}
+	return dict
+}
+
+// Encode LZW-compresses data to w as a stream of big-endian uint16
+// codes, resetting the dictionary back to its initial single-byte
+// entries once it grows to maxDictSize entries.
+func Encode(w io.Writer, data []byte) error {
+	return EncodeWithDictSize(w, data, maxDictSize)
+}
+
+// EncodeWithDictSize is Encode with a configurable dictionary size
+// (capped at maxDictSize, the largest a uint16 code can address); a
+// smaller dictSize resets and re-learns repeats more often, trading
+// compression ratio on long inputs for a dictionary that fits in less
+// memory.
+func EncodeWithDictSize(w io.Writer, data []byte, dictSize int) error {
+	if dictSize <= 256 || dictSize > maxDictSize {
+		dictSize = maxDictSize
+	}
+
+	dict := initialDict()
+	nextCode := uint16(256)
+
+	var current []byte
+	for _, b := range data {
+		candidate := append(append([]byte{}, current...), b)
+		if _, ok := dict[string(candidate)]; ok {
+			current = candidate
+			continue
+		}
+
+		if err := writeCode(w, dict[string(current)]); err != nil {
+			return err
+		}
+		if int(nextCode) < dictSize {
+			dict[string(candidate)] = nextCode
+			nextCode++
+		} else {
+			dict = initialDict()
+			nextCode = 256
+		}
+		current = []byte{b}
+	}
+	if len(current) > 0 {
+		if err := writeCode(w, dict[string(current)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCode(w io.Writer, code uint16) error {
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], code)
+	_, err := w.Write(buf[:])
+	return err
+}
+
+// Decode reverses Encode, reading codes from r until EOF.
+func Decode(r io.Reader) ([]byte, error) {
+	return DecodeWithDictSize(r, maxDictSize)
+}
+
+// DecodeWithDictSize reverses EncodeWithDictSize; dictSize must match
+// the value Encode/EncodeWithDictSize was called with.
+func DecodeWithDictSize(r io.Reader, dictSize int) ([]byte, error) {
+	if dictSize <= 256 || dictSize > maxDictSize {
+		dictSize = maxDictSize
+	}
+
+	dict := make(map[uint16][]byte, 256)
+	for b := 0; b < 256; b++ {
+		dict[uint16(b)] = []byte{byte(b)}
+	}
+	nextCode := uint16(256)
+
+	var out []byte
+	var prev []byte
+	var buf [2]byte
+	for {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("lzw: reading code: %w", err)
+		}
+		code := binary.BigEndian.Uint16(buf[:])
+
+		var entry []byte
+		if e, ok := dict[code]; ok {
+			entry = e
+		} else if code == nextCode && prev != nil {
+			// The classic LZW "not yet in the table" case: the encoder
+			// just added this exact code (prev + prev's first byte) to
+			// its dictionary and immediately used it.
+			entry = append(append([]byte{}, prev...), prev[0])
+		} else {
+			return nil, fmt.Errorf("lzw: corrupt stream: code %d not in dictionary", code)
+		}
+
+		out = append(out, entry...)
+
+		if prev != nil {
+			if int(nextCode) < dictSize {
+				dict[nextCode] = append(append([]byte{}, prev...), entry[0])
+				nextCode++
+			} else {
+				dict = make(map[uint16][]byte, 256)
+				for b := 0; b < 256; b++ {
+					dict[uint16(b)] = []byte{byte(b)}
+				}
+				nextCode = 256
+			}
+		}
+		prev = entry
+	}
+	return out, nil
+}
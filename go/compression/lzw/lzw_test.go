@@ -0,0 +1,53 @@
+package lzw
+
+import (
+	"bytes"
+	"testing"
+)
+
+func roundTrip(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	if err := Encode(&buf, data); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	got, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	return got
+}
+
+func TestRoundTrip(t *testing.T) {
+	cases := [][]byte{
+		[]byte(""),
+		[]byte("a"),
+		[]byte("aaaaaaaaaaaaaaaaaaaa"),
+		[]byte("TOBEORNOTTOBEORTOBEORNOT"),
+		[]byte("the quick brown fox jumps over the lazy dog"),
+		bytes.Repeat([]byte("abcabcabc"), 500),
+	}
+	for _, data := range cases {
+		got := roundTrip(t, data)
+		if !bytes.Equal(got, data) {
+			t.Errorf("round trip of %q (len %d) = %q, want original back", truncate(data), len(data), truncate(got))
+		}
+	}
+}
+
+func TestCompressesRepeatedInput(t *testing.T) {
+	data := bytes.Repeat([]byte("abcabcabc"), 500)
+	var buf bytes.Buffer
+	if err := Encode(&buf, data); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	if buf.Len() >= len(data) {
+		t.Errorf("encoded size %d should be smaller than input size %d for highly repetitive input", buf.Len(), len(data))
+	}
+}
+
+func truncate(b []byte) []byte {
+	if len(b) > 40 {
+		return b[:40]
+	}
+	return b
+}
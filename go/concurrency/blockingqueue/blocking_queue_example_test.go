@@ -0,0 +1,30 @@
+package blockingqueue
+
+import (
+	"fmt"
+	"time"
+)
+
+func ExampleBoundedBlockingQueue() {
+	q := NewBoundedBlockingQueue[int](2)
+	q.Put(1)
+	q.Put(2)
+
+	if err := q.PutTimeout(3, 50*time.Millisecond); err != nil {
+		fmt.Println("put timed out as expected:", err)
+	}
+
+	v, _ := q.Get()
+	fmt.Println("get:", v)
+	v, _ = q.Get()
+	fmt.Println("get:", v)
+
+	if _, err := q.GetTimeout(50 * time.Millisecond); err != nil {
+		fmt.Println("get timed out as expected:", err)
+	}
+	// Output:
+	// put timed out as expected: bounded queue: timed out
+	// get: 1
+	// get: 2
+	// get timed out as expected: bounded queue: timed out
+}
@@ -0,0 +1,131 @@
+package blockingqueue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPutGet(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](2)
+	if err := q.Put(1); err != nil {
+		t.Fatalf("Put(1): %v", err)
+	}
+	if err := q.Put(2); err != nil {
+		t.Fatalf("Put(2): %v", err)
+	}
+
+	if got, err := q.Get(); err != nil || got != 1 {
+		t.Errorf("Get() = (%d, %v), want (1, nil)", got, err)
+	}
+	if got, err := q.Get(); err != nil || got != 2 {
+		t.Errorf("Get() = (%d, %v), want (2, nil)", got, err)
+	}
+}
+
+func TestGetTimeout(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](1)
+
+	if _, err := q.GetTimeout(10 * time.Millisecond); !errors.Is(err, ErrQueueTimeout) {
+		t.Errorf("GetTimeout() on an empty queue = %v, want ErrQueueTimeout", err)
+	}
+
+	if err := q.Put(5); err != nil {
+		t.Fatalf("Put(5): %v", err)
+	}
+	got, err := q.GetTimeout(10 * time.Millisecond)
+	if err != nil || got != 5 {
+		t.Errorf("GetTimeout() = (%d, %v), want (5, nil)", got, err)
+	}
+}
+
+func TestPutTimeout(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](1)
+	if err := q.Put(1); err != nil {
+		t.Fatalf("Put(1): %v", err)
+	}
+
+	if err := q.PutTimeout(2, 10*time.Millisecond); !errors.Is(err, ErrQueueTimeout) {
+		t.Errorf("PutTimeout() on a full queue = %v, want ErrQueueTimeout", err)
+	}
+}
+
+func TestPutGetContext(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := q.GetContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetContext() on a cancelled context = %v, want context.Canceled", err)
+	}
+
+	if err := q.PutContext(context.Background(), 7); err != nil {
+		t.Fatalf("PutContext() = %v, want nil", err)
+	}
+	got, err := q.GetContext(context.Background())
+	if err != nil || got != 7 {
+		t.Errorf("GetContext() = (%d, %v), want (7, nil)", got, err)
+	}
+}
+
+func TestClose(t *testing.T) {
+	t.Run("unblocks pending Get once drained", func(t *testing.T) {
+		q := NewBoundedBlockingQueue[int](1)
+		if err := q.Put(9); err != nil {
+			t.Fatalf("Put(9): %v", err)
+		}
+		q.Close()
+
+		got, err := q.Get()
+		if err != nil || got != 9 {
+			t.Fatalf("Get() after Close() = (%d, %v), want (9, nil)", got, err)
+		}
+
+		if _, err := q.Get(); !errors.Is(err, ErrQueueClosed) {
+			t.Errorf("Get() on a drained, closed queue = %v, want ErrQueueClosed", err)
+		}
+	})
+
+	t.Run("future Put fails", func(t *testing.T) {
+		q := NewBoundedBlockingQueue[int](1)
+		q.Close()
+
+		if err := q.Put(1); !errors.Is(err, ErrQueueClosed) {
+			t.Errorf("Put() on a closed queue = %v, want ErrQueueClosed", err)
+		}
+		if err := q.PutTimeout(1, 10*time.Millisecond); !errors.Is(err, ErrQueueClosed) {
+			t.Errorf("PutTimeout() on a closed queue = %v, want ErrQueueClosed", err)
+		}
+		if err := q.PutContext(context.Background(), 1); !errors.Is(err, ErrQueueClosed) {
+			t.Errorf("PutContext() on a closed queue = %v, want ErrQueueClosed", err)
+		}
+	})
+
+	t.Run("blocked Get is released by a later Close", func(t *testing.T) {
+		q := NewBoundedBlockingQueue[int](1)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			if _, err := q.Get(); !errors.Is(err, ErrQueueClosed) {
+				t.Errorf("Get() unblocked by Close() = %v, want ErrQueueClosed", err)
+			}
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		q.Close()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Get() did not unblock after Close()")
+		}
+	})
+
+	t.Run("idempotent", func(t *testing.T) {
+		q := NewBoundedBlockingQueue[int](1)
+		q.Close()
+		q.Close()
+	})
+}
@@ -0,0 +1,159 @@
+package blockingqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrQueueTimeout is returned by PutTimeout/GetTimeout when the deadline
+// elapses before the operation can complete.
+var ErrQueueTimeout = errors.New("bounded queue: timed out")
+
+// ErrQueueClosed is returned by Put (and its Timeout/Context variants)
+// once the queue has been Closed, and by Get (and its variants) once the
+// queue has been Closed and drained of whatever was buffered at the time.
+var ErrQueueClosed = errors.New("bounded queue: closed")
+
+// BoundedBlockingQueue is a fixed-capacity FIFO queue safe for multiple
+// concurrent producers and consumers, supporting both blocking and
+// timeout/context-bound Put/Get. Close unblocks every pending and future
+// Get once the queue is drained, and makes every future Put fail.
+type BoundedBlockingQueue[T any] struct {
+	items  chan T
+	closed chan struct{}
+
+	mu       sync.Mutex
+	isClosed bool
+}
+
+// NewBoundedBlockingQueue creates a queue that holds at most capacity items.
+func NewBoundedBlockingQueue[T any](capacity int) *BoundedBlockingQueue[T] {
+	return &BoundedBlockingQueue[T]{
+		items:  make(chan T, capacity),
+		closed: make(chan struct{}),
+	}
+}
+
+// Close marks the queue closed: every Put from now on fails with
+// ErrQueueClosed, and every pending or future Get fails with
+// ErrQueueClosed once it has drained whatever was already buffered.
+// Calling Close more than once is a no-op.
+func (q *BoundedBlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.isClosed {
+		return
+	}
+	q.isClosed = true
+	close(q.closed)
+}
+
+// drain returns a buffered item if one is immediately available, without
+// blocking. Get and its Timeout/Context variants call this after
+// observing the queue closed, so anything already in the buffer is still
+// delivered before ErrQueueClosed is reported.
+func (q *BoundedBlockingQueue[T]) drain() (T, bool) {
+	select {
+	case v := <-q.items:
+		return v, true
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+// Put blocks until there is room for v or the queue is closed, in which
+// case it returns ErrQueueClosed.
+func (q *BoundedBlockingQueue[T]) Put(v T) error {
+	select {
+	case q.items <- v:
+		return nil
+	case <-q.closed:
+		return ErrQueueClosed
+	}
+}
+
+// Get blocks until an item is available or the queue is closed and
+// drained, in which case it returns ErrQueueClosed.
+func (q *BoundedBlockingQueue[T]) Get() (T, error) {
+	select {
+	case v := <-q.items:
+		return v, nil
+	case <-q.closed:
+		if v, ok := q.drain(); ok {
+			return v, nil
+		}
+		var zero T
+		return zero, ErrQueueClosed
+	}
+}
+
+// PutTimeout blocks until there is room for v, the queue is closed, or
+// timeout elapses, returning ErrQueueClosed or ErrQueueTimeout
+// respectively.
+func (q *BoundedBlockingQueue[T]) PutTimeout(v T, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case q.items <- v:
+		return nil
+	case <-q.closed:
+		return ErrQueueClosed
+	case <-timer.C:
+		return ErrQueueTimeout
+	}
+}
+
+// GetTimeout blocks until an item is available, the queue is closed and
+// drained, or timeout elapses, returning ErrQueueClosed or
+// ErrQueueTimeout respectively.
+func (q *BoundedBlockingQueue[T]) GetTimeout(timeout time.Duration) (T, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case v := <-q.items:
+		return v, nil
+	case <-q.closed:
+		if v, ok := q.drain(); ok {
+			return v, nil
+		}
+		var zero T
+		return zero, ErrQueueClosed
+	case <-timer.C:
+		var zero T
+		return zero, ErrQueueTimeout
+	}
+}
+
+// PutContext blocks until there is room for v, the queue is closed, or
+// ctx is done.
+func (q *BoundedBlockingQueue[T]) PutContext(ctx context.Context, v T) error {
+	select {
+	case q.items <- v:
+		return nil
+	case <-q.closed:
+		return ErrQueueClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// GetContext blocks until an item is available, the queue is closed and
+// drained, or ctx is done.
+func (q *BoundedBlockingQueue[T]) GetContext(ctx context.Context) (T, error) {
+	select {
+	case v := <-q.items:
+		return v, nil
+	case <-q.closed:
+		if v, ok := q.drain(); ok {
+			return v, nil
+		}
+		var zero T
+		return zero, ErrQueueClosed
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,46 @@
+package boruvka
+
+import "testing"
+
+func TestParallelBoruvkaMST(t *testing.T) {
+	t.Run("simple graph", func(t *testing.T) {
+		edges := []BoruvkaEdge{
+			{U: 0, V: 1, Weight: 10},
+			{U: 0, V: 2, Weight: 6},
+			{U: 0, V: 3, Weight: 5},
+			{U: 1, V: 3, Weight: 15},
+			{U: 2, V: 3, Weight: 4},
+		}
+		mst, weight := ParallelBoruvkaMST(4, edges, 2)
+
+		if len(mst) != 3 {
+			t.Errorf("ParallelBoruvkaMST() returned %d edges, want 3", len(mst))
+		}
+		if weight != 19 {
+			t.Errorf("ParallelBoruvkaMST() total weight = %d, want 19", weight)
+		}
+	})
+
+	t.Run("single vertex has no edges", func(t *testing.T) {
+		mst, weight := ParallelBoruvkaMST(1, nil, 2)
+		if len(mst) != 0 || weight != 0 {
+			t.Errorf("ParallelBoruvkaMST() = (%v, %d), want (empty, 0)", mst, weight)
+		}
+	})
+
+	t.Run("disconnected graph stops early", func(t *testing.T) {
+		edges := []BoruvkaEdge{{U: 0, V: 1, Weight: 1}}
+		mst, weight := ParallelBoruvkaMST(4, edges, 2)
+		if len(mst) != 1 || weight != 1 {
+			t.Errorf("ParallelBoruvkaMST() = (%v, %d), want one edge of weight 1", mst, weight)
+		}
+	})
+
+	t.Run("workers defaults to at least one", func(t *testing.T) {
+		edges := []BoruvkaEdge{{U: 0, V: 1, Weight: 2}}
+		mst, weight := ParallelBoruvkaMST(2, edges, 0)
+		if len(mst) != 1 || weight != 2 {
+			t.Errorf("ParallelBoruvkaMST() with workers=0 = (%v, %d), want one edge of weight 2", mst, weight)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package boruvka
+
+import (
+	"sync"
+)
+
+// BoruvkaEdge is a weighted undirected edge between two vertices.
+type BoruvkaEdge struct {
+	U, V, Weight int
+}
+
+// boruvkaDSU is a simple union-find used to track components during Borůvka's
+// algorithm.
+type boruvkaDSU struct {
+	parent []int
+}
+
+func newBoruvkaDSU(n int) *boruvkaDSU {
+	p := make([]int, n)
+	for i := range p {
+		p[i] = i
+	}
+	return &boruvkaDSU{parent: p}
+}
+
+func (d *boruvkaDSU) find(x int) int {
+	for d.parent[x] != x {
+		d.parent[x] = d.parent[d.parent[x]]
+		x = d.parent[x]
+	}
+	return x
+}
+
+func (d *boruvkaDSU) union(x, y int) {
+	rx, ry := d.find(x), d.find(y)
+	if rx != ry {
+		d.parent[rx] = ry
+	}
+}
+
+// ParallelBoruvkaMST computes a minimum spanning forest of an undirected
+// weighted graph (given as an edge list over n vertices) using Borůvka's
+// algorithm. Each round, the cheapest outgoing edge of every component is
+// found in parallel by splitting the edge list across workers goroutines;
+// the per-worker winners are then merged sequentially and added to the MST.
+func ParallelBoruvkaMST(n int, edges []BoruvkaEdge, workers int) ([]BoruvkaEdge, int) {
+	dsu := newBoruvkaDSU(n)
+	mst := make([]BoruvkaEdge, 0, n-1)
+	totalWeight := 0
+	numComponents := n
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	for numComponents > 1 {
+		cheapest := make(map[int]BoruvkaEdge)
+
+		chunkSize := (len(edges) + workers - 1) / workers
+		if chunkSize == 0 {
+			chunkSize = 1
+		}
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for start := 0; start < len(edges); start += chunkSize {
+			end := start + chunkSize
+			if end > len(edges) {
+				end = len(edges)
+			}
+			wg.Add(1)
+			go func(chunk []BoruvkaEdge) {
+				defer wg.Done()
+				local := make(map[int]BoruvkaEdge)
+				for _, e := range chunk {
+					cu, cv := dsu.find(e.U), dsu.find(e.V)
+					if cu == cv {
+						continue
+					}
+					if best, ok := local[cu]; !ok || e.Weight < best.Weight {
+						local[cu] = e
+					}
+					if best, ok := local[cv]; !ok || e.Weight < best.Weight {
+						local[cv] = e
+					}
+				}
+				mu.Lock()
+				for comp, e := range local {
+					if best, ok := cheapest[comp]; !ok || e.Weight < best.Weight {
+						cheapest[comp] = e
+					}
+				}
+				mu.Unlock()
+			}(edges[start:end])
+		}
+		wg.Wait()
+
+		if len(cheapest) == 0 {
+			break // remaining components are disconnected from each other
+		}
+
+		progress := false
+		for _, e := range cheapest {
+			if dsu.find(e.U) != dsu.find(e.V) {
+				dsu.union(e.U, e.V)
+				mst = append(mst, e)
+				totalWeight += e.Weight
+				numComponents--
+				progress = true
+			}
+		}
+		if !progress {
+			break
+		}
+	}
+
+	return mst, totalWeight
+}
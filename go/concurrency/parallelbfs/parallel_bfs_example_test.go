@@ -0,0 +1,40 @@
+package parallelbfs
+
+import "fmt"
+
+// canonicalizeLabels remaps arbitrary component ids to 0, 1, 2, ... in
+// order of first appearance, so output only depends on which vertices
+// share a component, not on which one of them the underlying union-find
+// happened to pick as representative (a choice that depends on the
+// interleaving of concurrent Union calls and so isn't deterministic
+// across runs).
+func canonicalizeLabels(labels []int) []int {
+	next := 0
+	seen := make(map[int]int)
+	out := make([]int, len(labels))
+	for i, l := range labels {
+		c, ok := seen[l]
+		if !ok {
+			c = next
+			seen[l] = c
+			next++
+		}
+		out[i] = c
+	}
+	return out
+}
+
+func ExampleParallelBFS() {
+	g := NewGraph(8)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 7)
+
+	fmt.Println("distances from vertex 0:", ParallelBFS(g, 0, 4))
+	fmt.Println("component labels:", canonicalizeLabels(ParallelConnectedComponents(g, 4)))
+	// Output:
+	// distances from vertex 0: [0 1 2 -1 -1 -1 -1 -1]
+	// component labels: [0 0 0 1 1 2 2 2]
+}
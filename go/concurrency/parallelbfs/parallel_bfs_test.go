@@ -0,0 +1,71 @@
+package parallelbfs
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+func buildGraph(n int, edges [][2]int) *Graph {
+	g := NewGraph(n)
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func TestParallelBFS(t *testing.T) {
+	t.Run("single node", func(t *testing.T) {
+		g := NewGraph(1)
+		want := []int{0}
+		if got := ParallelBFS(g, 0, 4); !slices.Equal(got, want) {
+			t.Errorf("ParallelBFS() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("linear chain", func(t *testing.T) {
+		g := buildGraph(4, [][2]int{{0, 1}, {1, 2}, {2, 3}})
+		want := []int{0, 1, 2, 3}
+		if got := ParallelBFS(g, 0, 3); !slices.Equal(got, want) {
+			t.Errorf("ParallelBFS() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("disconnected vertex stays unreached", func(t *testing.T) {
+		g := buildGraph(3, [][2]int{{0, 1}})
+		want := []int{0, 1, -1}
+		if got := ParallelBFS(g, 0, 2); !slices.Equal(got, want) {
+			t.Errorf("ParallelBFS() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestParallelConnectedComponents(t *testing.T) {
+	g := buildGraph(5, [][2]int{{0, 1}, {1, 2}, {3, 4}})
+	labels := ParallelConnectedComponents(g, 3)
+
+	if labels[0] != labels[1] || labels[1] != labels[2] {
+		t.Errorf("expected 0,1,2 to share a component, got %v", labels)
+	}
+	if labels[3] != labels[4] {
+		t.Errorf("expected 3,4 to share a component, got %v", labels)
+	}
+	if labels[0] == labels[3] {
+		t.Errorf("expected {0,1,2} and {3,4} to be different components, got %v", labels)
+	}
+}
+
+func TestGraphBFSSeq(t *testing.T) {
+	g := buildGraph(4, [][2]int{{0, 1}, {0, 2}, {1, 3}})
+
+	var got []int
+	g.BFSSeq(0)(func(v int) bool {
+		got = append(got, v)
+		return true
+	})
+	sort.Ints(got)
+	want := []int{0, 1, 2, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("BFSSeq(0) visited %v, want %v", got, want)
+	}
+}
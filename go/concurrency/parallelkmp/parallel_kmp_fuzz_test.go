@@ -0,0 +1,66 @@
+package parallelkmp
+
+import (
+	"slices"
+	"testing"
+)
+
+func bruteForceFindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	var matches []int
+	for i := 0; i+m <= n; i++ {
+		if text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func FuzzKMPStringMatcher(f *testing.F) {
+	seeds := []struct {
+		text, pattern string
+	}{
+		{"", ""},
+		{"abc", ""},
+		{"", "abc"},
+		{"abcabcabc", "abc"},
+		{"aaaaaa", "aa"},
+		{"mississippi", "issi"},
+	}
+	for _, s := range seeds {
+		f.Add(s.text, s.pattern)
+	}
+
+	f.Fuzz(func(t *testing.T, text, pattern string) {
+		got := KMPStringMatcher(text, pattern)
+		want := bruteForceFindAll(text, pattern)
+		if !slices.Equal(got, want) {
+			t.Fatalf("KMPStringMatcher(%q, %q) = %v, want %v (brute force)", text, pattern, got, want)
+		}
+	})
+}
+
+func FuzzParallelSearch(f *testing.F) {
+	seeds := []struct {
+		text, pattern string
+	}{
+		{"", ""},
+		{"abcabcabc", "abc"},
+		{"aaaaaaaaaa", "aaa"},
+		{"mississippi river", "ssi"},
+	}
+	for _, s := range seeds {
+		f.Add(s.text, s.pattern)
+	}
+
+	f.Fuzz(func(t *testing.T, text, pattern string) {
+		got := ParallelSearch(text, pattern, 4)
+		want := bruteForceFindAll(text, pattern)
+		if !slices.Equal(got, want) {
+			t.Fatalf("ParallelSearch(%q, %q, 4) = %v, want %v (brute force)", text, pattern, got, want)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package parallelkmp
+
+import (
+	"sort"
+	"sync"
+)
+
+// KMPStringMatcher finds all starting indices of pattern within text using
+// the Knuth-Morris-Pratt algorithm.
+func KMPStringMatcher(text, pattern string) []int {
+	matches := make([]int, 0)
+	m := len(pattern)
+	if m == 0 || m > len(text) {
+		return matches
+	}
+
+	lps := make([]int, m)
+	length := 0
+	for i := 1; i < m; {
+		if pattern[i] == pattern[length] {
+			length++
+			lps[i] = length
+			i++
+		} else if length != 0 {
+			length = lps[length-1]
+		} else {
+			lps[i] = 0
+			i++
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(text) {
+		if text[i] == pattern[j] {
+			i++
+			j++
+			if j == m {
+				matches = append(matches, i-j)
+				j = lps[j-1]
+			}
+		} else if j != 0 {
+			j = lps[j-1]
+		} else {
+			i++
+		}
+	}
+	return matches
+}
+
+// ParallelSearch splits text into workers overlapping chunks (each chunk
+// overlaps the next by len(pattern)-1 bytes so matches spanning a chunk
+// boundary aren't missed), runs KMPStringMatcher on each chunk in its own
+// goroutine, and merges the results into a deduplicated, sorted slice of
+// absolute match indices into text.
+func ParallelSearch(text, pattern string, workers int) []int {
+	n := len(text)
+	m := len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	overlap := m - 1
+	chunkSize := (n + workers - 1) / workers
+	if chunkSize < m {
+		chunkSize = m
+	}
+
+	type chunkResult struct {
+		offset  int
+		matches []int
+	}
+
+	var wg sync.WaitGroup
+	resultsCh := make(chan chunkResult, workers)
+
+	for start := 0; start < n; start += chunkSize {
+		end := start + chunkSize + overlap
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			local := KMPStringMatcher(text[start:end], pattern)
+			resultsCh <- chunkResult{offset: start, matches: local}
+		}(start, end)
+		if end == n {
+			break
+		}
+	}
+
+	wg.Wait()
+	close(resultsCh)
+
+	seen := make(map[int]bool)
+	merged := make([]int, 0)
+	for r := range resultsCh {
+		for _, idx := range r.matches {
+			abs := r.offset + idx
+			if !seen[abs] {
+				seen[abs] = true
+				merged = append(merged, abs)
+			}
+		}
+	}
+	sort.Ints(merged)
+	return merged
+}
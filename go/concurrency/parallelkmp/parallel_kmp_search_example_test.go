@@ -0,0 +1,14 @@
+package parallelkmp
+
+import "fmt"
+
+func ExampleParallelSearch() {
+	text := "ABABDABACDABABCABABABABCABAB"
+	pattern := "ABAB"
+
+	fmt.Println("sequential:", KMPStringMatcher(text, pattern))
+	fmt.Println("parallel:  ", ParallelSearch(text, pattern, 4))
+	// Output:
+	// sequential: [0 10 15 17 19 24]
+	// parallel:   [0 10 15 17 19 24]
+}
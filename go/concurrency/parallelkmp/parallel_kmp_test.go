@@ -0,0 +1,55 @@
+package parallelkmp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestKMPStringMatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		pattern string
+		want    []int
+	}{
+		{"empty pattern", "abc", "", nil},
+		{"pattern longer than text", "ab", "abc", nil},
+		{"single match", "abxabcabcaby", "abcaby", []int{6}},
+		{"overlapping matches", "aaaa", "aa", []int{0, 1, 2}},
+		{"no match", "abcdef", "xyz", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := KMPStringMatcher(tt.text, tt.pattern)
+			if !slices.Equal(got, tt.want) && !(len(got) == 0 && len(tt.want) == 0) {
+				t.Errorf("KMPStringMatcher(%q, %q) = %v, want %v", tt.text, tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParallelSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		pattern string
+		workers int
+		want    []int
+	}{
+		{"empty pattern", "abcabc", "", 4, nil},
+		{"single worker", "abxabcabcaby", "abc", 1, []int{3, 6}},
+		{"multiple workers", "abxabcabcaby", "abc", 4, []int{3, 6}},
+		{"match spans chunk boundary", "aaaaaaaaaa", "aaa", 5, []int{0, 1, 2, 3, 4, 5, 6, 7}},
+		{"no match", "abcdefgh", "xyz", 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParallelSearch(tt.text, tt.pattern, tt.workers)
+			if !slices.Equal(got, tt.want) && !(len(got) == 0 && len(tt.want) == 0) {
+				t.Errorf("ParallelSearch(%q, %q, %d) = %v, want %v", tt.text, tt.pattern, tt.workers, got, tt.want)
+			}
+		})
+	}
+}
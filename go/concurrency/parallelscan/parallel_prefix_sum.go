@@ -0,0 +1,92 @@
+package parallelscan
+
+import (
+	"sync"
+)
+
+// SequentialScanThreshold is the slice length below which ParallelScan
+// falls back to a plain sequential scan instead of spawning goroutines.
+const SequentialScanThreshold = 1024
+
+// ParallelScan computes the inclusive prefix scan of in under the associative
+// combine function, writing the result into a newly allocated slice. For
+// slices shorter than SequentialScanThreshold it runs sequentially, since the
+// goroutine overhead would dominate the work.
+func ParallelScan[T any](in []T, workers int, combine func(a, b T) T) []T {
+	n := len(in)
+	out := make([]T, n)
+	if n == 0 {
+		return out
+	}
+	if n < SequentialScanThreshold || workers <= 1 {
+		return SequentialScan(in, combine)
+	}
+
+	chunkSize := (n + workers - 1) / workers
+	chunks := (n + chunkSize - 1) / chunkSize
+
+	// Phase 1: scan each chunk independently in parallel.
+	var wg sync.WaitGroup
+	chunkTotals := make([]T, chunks)
+	for c := 0; c < chunks; c++ {
+		lo := c * chunkSize
+		hi := lo + chunkSize
+		if hi > n {
+			hi = n
+		}
+		wg.Add(1)
+		go func(c, lo, hi int) {
+			defer wg.Done()
+			acc := in[lo]
+			out[lo] = acc
+			for i := lo + 1; i < hi; i++ {
+				acc = combine(acc, in[i])
+				out[i] = acc
+			}
+			chunkTotals[c] = acc
+		}(c, lo, hi)
+	}
+	wg.Wait()
+
+	// Phase 2: sequentially fold chunk totals into per-chunk offsets.
+	// offsets[c] is the combined total of all chunks before c.
+	offsets := make([]T, chunks)
+	running := chunkTotals[0]
+	for c := 1; c < chunks; c++ {
+		offsets[c] = running
+		running = combine(running, chunkTotals[c])
+	}
+
+	// Phase 3: apply each chunk's offset (from all earlier chunks) in parallel.
+	for c := 1; c < chunks; c++ {
+		lo := c * chunkSize
+		hi := lo + chunkSize
+		if hi > n {
+			hi = n
+		}
+		wg.Add(1)
+		go func(offset T, lo, hi int) {
+			defer wg.Done()
+			for i := lo; i < hi; i++ {
+				out[i] = combine(offset, out[i])
+			}
+		}(offsets[c], lo, hi)
+	}
+	wg.Wait()
+
+	return out
+}
+
+// SequentialScan is the fallback serial implementation used both directly
+// for small inputs and as the baseline for benchmarking ParallelScan.
+func SequentialScan[T any](in []T, combine func(a, b T) T) []T {
+	out := make([]T, len(in))
+	if len(in) == 0 {
+		return out
+	}
+	out[0] = in[0]
+	for i := 1; i < len(in); i++ {
+		out[i] = combine(out[i-1], in[i])
+	}
+	return out
+}
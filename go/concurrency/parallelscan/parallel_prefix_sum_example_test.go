@@ -0,0 +1,17 @@
+package parallelscan
+
+import "fmt"
+
+func ExampleParallelScan() {
+	data := make([]int, 20)
+	for i := range data {
+		data[i] = i + 1
+	}
+
+	sum := func(a, b int) int { return a + b }
+	fmt.Println("sequential:", SequentialScan(data, sum))
+	fmt.Println("parallel:  ", ParallelScan(data, 4, sum))
+	// Output:
+	// sequential: [1 3 6 10 15 21 28 36 45 55 66 78 91 105 120 136 153 171 190 210]
+	// parallel:   [1 3 6 10 15 21 28 36 45 55 66 78 91 105 120 136 153 171 190 210]
+}
@@ -0,0 +1,57 @@
+package parallelscan
+
+import (
+	"slices"
+	"testing"
+)
+
+func sum(a, b int) int { return a + b }
+
+func TestSequentialScan(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{5}, []int{5}},
+		{"running sum", []int{1, 2, 3, 4}, []int{1, 3, 6, 10}},
+		{"negatives", []int{-1, 2, -3, 4}, []int{-1, 1, -2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SequentialScan(tt.in, sum); !slices.Equal(got, tt.want) {
+				t.Errorf("SequentialScan(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParallelScan(t *testing.T) {
+	t.Run("below threshold matches sequential", func(t *testing.T) {
+		in := []int{1, 2, 3, 4, 5}
+		want := SequentialScan(in, sum)
+		if got := ParallelScan(in, 4, sum); !slices.Equal(got, want) {
+			t.Errorf("ParallelScan(%v) = %v, want %v", in, got, want)
+		}
+	})
+
+	t.Run("above threshold matches sequential", func(t *testing.T) {
+		in := make([]int, SequentialScanThreshold*3)
+		for i := range in {
+			in[i] = i + 1
+		}
+		want := SequentialScan(in, sum)
+		got := ParallelScan(in, 8, sum)
+		if !slices.Equal(got, want) {
+			t.Errorf("ParallelScan() mismatch vs SequentialScan() over %d elements", len(in))
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		if got := ParallelScan(nil, 4, sum); len(got) != 0 {
+			t.Errorf("ParallelScan(nil) = %v, want empty", got)
+		}
+	})
+}
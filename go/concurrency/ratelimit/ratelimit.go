@@ -0,0 +1,209 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock abstracts time so limiters can be tested deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock implements Clock using the system clock.
+type SystemClock struct{}
+
+// Now returns the current wall-clock time.
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// Limiter is the common interface implemented by every rate limiting
+// algorithm in this file: check whether n units are currently allowed, or
+// block until they are (or the context is cancelled).
+type Limiter interface {
+	Allow(n int) bool
+	Wait(ctx context.Context, n int) error
+}
+
+// --- Token bucket ---------------------------------------------------------
+
+// TokenBucketLimiter allows bursts up to capacity tokens, refilling at
+// rate tokens per second.
+type TokenBucketLimiter struct {
+	mu       sync.Mutex
+	clock    Clock
+	capacity float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewTokenBucketLimiter creates a limiter with the given burst capacity and
+// refill rate (tokens/second), using clock to read the current time.
+func NewTokenBucketLimiter(capacity, rate float64, clock Clock) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		clock:    clock,
+		capacity: capacity,
+		rate:     rate,
+		tokens:   capacity,
+		last:     clock.Now(),
+	}
+}
+
+func (l *TokenBucketLimiter) refill() {
+	now := l.clock.Now()
+	elapsed := now.Sub(l.last).Seconds()
+	l.tokens += elapsed * l.rate
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+}
+
+// Allow reports whether n tokens are available right now, consuming them if so.
+func (l *TokenBucketLimiter) Allow(n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.refill()
+	if l.tokens >= float64(n) {
+		l.tokens -= float64(n)
+		return true
+	}
+	return false
+}
+
+// Wait blocks until n tokens are available or ctx is done.
+func (l *TokenBucketLimiter) Wait(ctx context.Context, n int) error {
+	for {
+		if l.Allow(n) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+// --- Leaky bucket ----------------------------------------------------------
+
+// LeakyBucketLimiter models a queue of capacity that drains at rate
+// units per second; requests are allowed as long as the queue isn't full.
+type LeakyBucketLimiter struct {
+	mu       sync.Mutex
+	clock    Clock
+	capacity float64
+	rate     float64
+	level    float64
+	last     time.Time
+}
+
+// NewLeakyBucketLimiter creates a limiter with the given queue capacity and
+// drain rate (units/second).
+func NewLeakyBucketLimiter(capacity, rate float64, clock Clock) *LeakyBucketLimiter {
+	return &LeakyBucketLimiter{
+		clock:    clock,
+		capacity: capacity,
+		rate:     rate,
+		last:     clock.Now(),
+	}
+}
+
+func (l *LeakyBucketLimiter) leak() {
+	now := l.clock.Now()
+	elapsed := now.Sub(l.last).Seconds()
+	l.level -= elapsed * l.rate
+	if l.level < 0 {
+		l.level = 0
+	}
+	l.last = now
+}
+
+// Allow reports whether n units fit in the bucket right now, adding them if so.
+func (l *LeakyBucketLimiter) Allow(n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.leak()
+	if l.level+float64(n) <= l.capacity {
+		l.level += float64(n)
+		return true
+	}
+	return false
+}
+
+// Wait blocks until n units fit in the bucket or ctx is done.
+func (l *LeakyBucketLimiter) Wait(ctx context.Context, n int) error {
+	for {
+		if l.Allow(n) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+// --- Sliding window ----------------------------------------------------------
+
+// SlidingWindowLimiter allows at most limit requests within any window
+// duration, tracked via request timestamps.
+type SlidingWindowLimiter struct {
+	mu         sync.Mutex
+	clock      Clock
+	limit      int
+	window     time.Duration
+	timestamps []time.Time
+}
+
+// NewSlidingWindowLimiter creates a limiter allowing at most limit requests
+// per window.
+func NewSlidingWindowLimiter(limit int, window time.Duration, clock Clock) *SlidingWindowLimiter {
+	return &SlidingWindowLimiter{
+		clock:  clock,
+		limit:  limit,
+		window: window,
+	}
+}
+
+func (l *SlidingWindowLimiter) evict(now time.Time) {
+	cutoff := now.Add(-l.window)
+	i := 0
+	for i < len(l.timestamps) && l.timestamps[i].Before(cutoff) {
+		i++
+	}
+	l.timestamps = l.timestamps[i:]
+}
+
+// Allow reports whether n requests fit in the current window, recording
+// them if so. Note: n is typically 1 for this algorithm; n > 1 is treated
+// as n individual requests arriving at once.
+func (l *SlidingWindowLimiter) Allow(n int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	l.evict(now)
+	if len(l.timestamps)+n > l.limit {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		l.timestamps = append(l.timestamps, now)
+	}
+	return true
+}
+
+// Wait blocks until n requests fit in the current window or ctx is done.
+func (l *SlidingWindowLimiter) Wait(ctx context.Context, n int) error {
+	for {
+		if l.Allow(n) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced Clock for deterministic limiter tests.
+// It is safe for concurrent use since TestTokenBucketLimiterWait advances it
+// from a different goroutine than the one polling it via Wait.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestTokenBucketLimiter(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewTokenBucketLimiter(5, 1, clock)
+
+	if !l.Allow(5) {
+		t.Fatalf("Allow(5) on a full bucket should succeed")
+	}
+	if l.Allow(1) {
+		t.Errorf("Allow(1) on an empty bucket should fail")
+	}
+
+	clock.Advance(2 * time.Second)
+	if !l.Allow(2) {
+		t.Errorf("Allow(2) after refilling for 2s at rate 1/s should succeed")
+	}
+}
+
+func TestLeakyBucketLimiter(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewLeakyBucketLimiter(5, 1, clock)
+
+	if !l.Allow(5) {
+		t.Fatalf("Allow(5) on an empty leaky bucket should succeed")
+	}
+	if l.Allow(1) {
+		t.Errorf("Allow(1) on a full leaky bucket should fail")
+	}
+
+	clock.Advance(2 * time.Second)
+	if !l.Allow(2) {
+		t.Errorf("Allow(2) after leaking for 2s at rate 1/s should succeed")
+	}
+}
+
+func TestSlidingWindowLimiter(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewSlidingWindowLimiter(3, time.Second, clock)
+
+	if !l.Allow(3) {
+		t.Fatalf("Allow(3) within the limit should succeed")
+	}
+	if l.Allow(1) {
+		t.Errorf("Allow(1) over the limit should fail")
+	}
+
+	clock.Advance(2 * time.Second)
+	if !l.Allow(1) {
+		t.Errorf("Allow(1) after the window has elapsed should succeed")
+	}
+}
+
+func TestTokenBucketLimiterWait(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewTokenBucketLimiter(1, 1000, clock)
+
+	l.Allow(1) // drain the bucket
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- l.Wait(context.Background(), 1) }()
+
+	// Give Wait a chance to observe the empty bucket, then let it refill.
+	time.Sleep(5 * time.Millisecond)
+	clock.Advance(time.Second)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Wait() = %v, want nil once tokens are available", err)
+		}
+	case <-ctx.Done():
+		t.Errorf("Wait() did not return after the bucket refilled")
+	}
+}
@@ -0,0 +1,49 @@
+package ringbuffer
+
+import "testing"
+
+func TestSPSCRingBuffer(t *testing.T) {
+	t.Run("pop on empty buffer fails", func(t *testing.T) {
+		r := NewSPSCRingBuffer(4)
+		if _, ok := r.Pop(); ok {
+			t.Errorf("Pop() on empty buffer should fail")
+		}
+	})
+
+	t.Run("push and pop preserve FIFO order", func(t *testing.T) {
+		r := NewSPSCRingBuffer(4)
+		for _, v := range []int{1, 2, 3} {
+			if !r.Push(v) {
+				t.Fatalf("Push(%d) should succeed", v)
+			}
+		}
+		for _, want := range []int{1, 2, 3} {
+			got, ok := r.Pop()
+			if !ok || got != want {
+				t.Errorf("Pop() = (%d, %v), want (%d, true)", got, ok, want)
+			}
+		}
+	})
+
+	t.Run("push fails once full", func(t *testing.T) {
+		r := NewSPSCRingBuffer(2) // rounds up to capacity 2
+		if !r.Push(1) || !r.Push(2) {
+			t.Fatalf("filling the buffer to capacity should succeed")
+		}
+		if r.Push(3) {
+			t.Errorf("Push() on a full buffer should fail")
+		}
+	})
+
+	t.Run("capacity rounds up to a power of two", func(t *testing.T) {
+		r := NewSPSCRingBuffer(3) // rounds up to 4
+		for i := 0; i < 4; i++ {
+			if !r.Push(i) {
+				t.Fatalf("Push(%d) should succeed within rounded-up capacity", i)
+			}
+		}
+		if r.Push(4) {
+			t.Errorf("Push() beyond rounded-up capacity should fail")
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package ringbuffer
+
+import (
+	"sync/atomic"
+)
+
+// SPSCRingBuffer is a fixed-capacity lock-free ring buffer for a single
+// producer and a single consumer. capacity is rounded up to the next power
+// of two so index wrapping can use a bitmask instead of a modulo.
+//
+// head and tail are padded onto separate 64-byte cache lines from each
+// other and from the read-only mask/buf fields: head is only ever written
+// by the consumer and tail only by the producer, so without padding the
+// two would false-share a cache line and every Push would bounce it off
+// the consumer's core (and vice versa for Pop), which defeats much of the
+// point of avoiding a channel's locking in the first place.
+type SPSCRingBuffer struct {
+	mask uint64
+	buf  []int
+
+	_    [64]byte
+	head uint64 // next slot the consumer will read
+
+	_    [56]byte
+	tail uint64 // next slot the producer will write
+}
+
+// NewSPSCRingBuffer creates a ring buffer able to hold at least capacity
+// elements.
+func NewSPSCRingBuffer(capacity int) *SPSCRingBuffer {
+	size := 1
+	for size < capacity {
+		size <<= 1
+	}
+	return &SPSCRingBuffer{
+		mask: uint64(size - 1),
+		buf:  make([]int, size),
+	}
+}
+
+// Push attempts to enqueue v. It returns false if the buffer is full.
+// Only the single producer goroutine may call Push.
+func (r *SPSCRingBuffer) Push(v int) bool {
+	head := atomic.LoadUint64(&r.head)
+	tail := atomic.LoadUint64(&r.tail)
+	if tail-head > r.mask {
+		return false
+	}
+	r.buf[tail&r.mask] = v
+	atomic.StoreUint64(&r.tail, tail+1)
+	return true
+}
+
+// Pop attempts to dequeue the oldest element. It returns false if the
+// buffer is empty. Only the single consumer goroutine may call Pop.
+func (r *SPSCRingBuffer) Pop() (int, bool) {
+	head := atomic.LoadUint64(&r.head)
+	tail := atomic.LoadUint64(&r.tail)
+	if head == tail {
+		return 0, false
+	}
+	v := r.buf[head&r.mask]
+	atomic.StoreUint64(&r.head, head+1)
+	return v, true
+}
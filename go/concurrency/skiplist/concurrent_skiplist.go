@@ -0,0 +1,265 @@
+package skiplist
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ghostmkg/dsa-code/go/iterutil"
+)
+
+const skipListMaxLevel = 16
+
+// skipListNode is a node in the skip list. forward pointers are atomic
+// so Get and Range can traverse the list without taking any lock at
+// all; mu, marked, and fullyLinked support the fine-grained, lock-
+// coupled insert/delete algorithm Put and Delete use (Herlihy &
+// Shavit's "optimistic" concurrent skip list): marked flags a node
+// mid-deletion, fullyLinked flags a node still being spliced in by
+// Put, and mu is only ever held by whichever single Put/Delete call is
+// currently splicing this node's own forward pointers.
+type skipListNode struct {
+	key         int
+	value       atomic.Int64
+	topLevel    int
+	forward     []atomic.Pointer[skipListNode]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+func newSkipListNode(key, value, topLevel int) *skipListNode {
+	n := &skipListNode{key: key, topLevel: topLevel, forward: make([]atomic.Pointer[skipListNode], topLevel+1)}
+	n.value.Store(int64(value))
+	return n
+}
+
+// ConcurrentSkipList is an ordered int->int map backed by a skip list
+// using fine-grained, per-node locking instead of one lock guarding the
+// whole structure: Get and Range never take a lock at all (every
+// forward pointer is read atomically), and Put/Delete only ever lock
+// the handful of predecessor nodes they're about to splice, so unrelated
+// inserts/deletes at distant keys proceed in parallel instead of
+// serializing on a single mutex.
+type ConcurrentSkipList struct {
+	head  *skipListNode
+	rng   *rand.Rand
+	rngMu sync.Mutex
+}
+
+// NewConcurrentSkipList creates an empty concurrent skip list.
+func NewConcurrentSkipList() *ConcurrentSkipList {
+	head := newSkipListNode(0, 0, skipListMaxLevel-1)
+	head.fullyLinked.Store(true)
+	return &ConcurrentSkipList{
+		head: head,
+		rng:  rand.New(rand.NewSource(1)),
+	}
+}
+
+// randomLevel is the only state Put/Delete share without lock coupling,
+// so it keeps its own small mutex rather than relying on *rand.Rand's
+// (undocumented) internal synchronization.
+func (s *ConcurrentSkipList) randomLevel() int {
+	s.rngMu.Lock()
+	defer s.rngMu.Unlock()
+	lvl := 0
+	for lvl < skipListMaxLevel-1 && s.rng.Intn(2) == 0 {
+		lvl++
+	}
+	return lvl
+}
+
+// find does a lock-free top-down traversal, filling preds[level] and
+// succs[level] with, respectively, the last node at that level with a
+// key less than key and the first node at that level with a key not
+// less than key. It returns the highest level at which a node with
+// exactly key was encountered, or -1 if key isn't present at any level.
+func (s *ConcurrentSkipList) find(key int, preds, succs []*skipListNode) int {
+	lFound := -1
+	pred := s.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.forward[level].Load()
+		for curr != nil && curr.key < key {
+			pred = curr
+			curr = pred.forward[level].Load()
+		}
+		if lFound == -1 && curr != nil && curr.key == key {
+			lFound = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return lFound
+}
+
+// Get returns the value for key and whether it was present. It never
+// takes a lock, so it's always concurrent with every other Get and with
+// any Put/Delete in flight.
+func (s *ConcurrentSkipList) Get(key int) (int, bool) {
+	pred := s.head
+	var curr *skipListNode
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr = pred.forward[level].Load()
+		for curr != nil && curr.key < key {
+			pred = curr
+			curr = pred.forward[level].Load()
+		}
+	}
+	if curr != nil && curr.key == key && curr.fullyLinked.Load() && !curr.marked.Load() {
+		return int(curr.value.Load()), true
+	}
+	return 0, false
+}
+
+// lockDistinct locks pred if it isn't the same node as the last entry in
+// locked, and returns the (possibly extended) slice of distinctly locked
+// predecessors. Because preds[level] only ever moves further left (or
+// stays put) as level increases, repeats are always contiguous, so
+// comparing against just the most recently locked node is enough to
+// avoid double-locking — and to know exactly what to unlock afterward.
+func lockDistinct(locked []*skipListNode, pred *skipListNode) []*skipListNode {
+	if len(locked) > 0 && locked[len(locked)-1] == pred {
+		return locked
+	}
+	pred.mu.Lock()
+	return append(locked, pred)
+}
+
+func unlockAll(locked []*skipListNode) {
+	for _, p := range locked {
+		p.mu.Unlock()
+	}
+}
+
+// Put inserts or updates key with value.
+func (s *ConcurrentSkipList) Put(key, value int) {
+	topLevel := s.randomLevel()
+	preds := make([]*skipListNode, skipListMaxLevel)
+	succs := make([]*skipListNode, skipListMaxLevel)
+
+	for {
+		lFound := s.find(key, preds, succs)
+		if lFound != -1 {
+			found := succs[lFound]
+			if found.marked.Load() {
+				continue // racing with a Delete of this same key; retry
+			}
+			for !found.fullyLinked.Load() {
+				runtime.Gosched() // another Put is still splicing this node in
+			}
+			found.value.Store(int64(value))
+			return
+		}
+
+		var locked []*skipListNode
+		valid := true
+		for level := 0; valid && level <= topLevel; level++ {
+			pred, succ := preds[level], succs[level]
+			locked = lockDistinct(locked, pred)
+			valid = !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.forward[level].Load() == succ
+		}
+		if !valid {
+			unlockAll(locked)
+			continue
+		}
+
+		newNode := newSkipListNode(key, value, topLevel)
+		for level := 0; level <= topLevel; level++ {
+			newNode.forward[level].Store(succs[level])
+		}
+		for level := 0; level <= topLevel; level++ {
+			preds[level].forward[level].Store(newNode)
+		}
+		newNode.fullyLinked.Store(true)
+		unlockAll(locked)
+		return
+	}
+}
+
+// Delete removes key if present.
+func (s *ConcurrentSkipList) Delete(key int) {
+	preds := make([]*skipListNode, skipListMaxLevel)
+	succs := make([]*skipListNode, skipListMaxLevel)
+
+	var victim *skipListNode
+	isMarked := false
+	topLevel := -1
+
+	for {
+		lFound := s.find(key, preds, succs)
+		if !isMarked {
+			if lFound == -1 {
+				return
+			}
+			victim = succs[lFound]
+			if victim.topLevel != lFound || !victim.fullyLinked.Load() || victim.marked.Load() {
+				return // racing with another Delete of this exact node
+			}
+			topLevel = victim.topLevel
+
+			victim.mu.Lock()
+			if victim.marked.Load() {
+				victim.mu.Unlock()
+				return
+			}
+			victim.marked.Store(true)
+			isMarked = true
+		}
+
+		var locked []*skipListNode
+		valid := true
+		for level := 0; valid && level <= topLevel; level++ {
+			pred := preds[level]
+			locked = lockDistinct(locked, pred)
+			valid = !pred.marked.Load() && pred.forward[level].Load() == victim
+		}
+		if !valid {
+			unlockAll(locked)
+			continue
+		}
+
+		for level := topLevel; level >= 0; level-- {
+			preds[level].forward[level].Store(victim.forward[level].Load())
+		}
+		victim.mu.Unlock()
+		unlockAll(locked)
+		return
+	}
+}
+
+// Range returns a point-in-time-ish snapshot of all keys in ascending
+// order. Since traversal is lock-free, a concurrent Put/Delete can cause
+// the snapshot to reflect a state that existed at some point during the
+// call rather than at one precise instant, but it will never observe a
+// partially-linked or torn node.
+func (s *ConcurrentSkipList) Range() []int {
+	keys := make([]int, 0)
+	for x := s.head.forward[0].Load(); x != nil; x = x.forward[0].Load() {
+		if x.fullyLinked.Load() && !x.marked.Load() {
+			keys = append(keys, x.key)
+		}
+	}
+	return keys
+}
+
+// All returns a key/value iterator over the same kind of snapshot
+// Range produces, in ascending key order.
+func (s *ConcurrentSkipList) All() iterutil.Seq2[int, int] {
+	type pair struct{ key, value int }
+	snapshot := make([]pair, 0)
+	for x := s.head.forward[0].Load(); x != nil; x = x.forward[0].Load() {
+		if x.fullyLinked.Load() && !x.marked.Load() {
+			snapshot = append(snapshot, pair{x.key, int(x.value.Load())})
+		}
+	}
+
+	return func(yield func(int, int) bool) {
+		for _, p := range snapshot {
+			if !yield(p.key, p.value) {
+				return
+			}
+		}
+	}
+}
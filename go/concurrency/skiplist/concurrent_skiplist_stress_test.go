@@ -0,0 +1,160 @@
+package skiplist
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// TestConcurrentSkipListLinearizability hammers a single skip list with
+// concurrent writers and readers and checks that every key a writer
+// committed is observable with its last-written value once all writers
+// have finished, i.e. no update is lost or torn.
+func TestConcurrentSkipListLinearizability(t *testing.T) {
+	sl := NewConcurrentSkipList()
+	const writers = 8
+	const keysPerWriter = 200
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < keysPerWriter; i++ {
+				key := w*keysPerWriter + i
+				sl.Put(key, key*2)
+				if _, ok := sl.Get(key); !ok {
+					t.Errorf("key %d not visible immediately after Put", key)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for w := 0; w < writers; w++ {
+		for i := 0; i < keysPerWriter; i++ {
+			key := w*keysPerWriter + i
+			v, ok := sl.Get(key)
+			if !ok || v != key*2 {
+				t.Fatalf("key %d: got (%d, %v), want (%d, true)", key, v, ok, key*2)
+			}
+		}
+	}
+}
+
+// TestConcurrentSkipListOverlappingPutsAndDeletes hammers a small, shared
+// key range with Put and Delete racing on the very same keys, alongside
+// readers calling Get/Range/All throughout, so the fine-grained
+// lock-coupled algorithm's retry loops actually get exercised: Put
+// spinning on a found-but-not-yet-fullyLinked node, Put retrying after
+// colliding with a Delete's marked node, Delete retrying after a
+// predecessor's forward pointer moved out from under it, and Range/All
+// racing a splice in either direction. TestConcurrentSkipListLinearizability
+// above only ever writes disjoint per-goroutine key ranges and never
+// deletes, so none of that ever happens there.
+func TestConcurrentSkipListOverlappingPutsAndDeletes(t *testing.T) {
+	sl := NewConcurrentSkipList()
+	const keyRange = 16
+	const putters = 6
+	const deleters = 4
+	const readers = 4
+	const opsPerGoroutine = 2000
+
+	var stop atomic.Bool
+	var writers, readersWg sync.WaitGroup
+
+	for p := 0; p < putters; p++ {
+		writers.Add(1)
+		go func(p int) {
+			defer writers.Done()
+			for i := 0; i < opsPerGoroutine; i++ {
+				key := i % keyRange
+				sl.Put(key, p*1_000_000+i)
+			}
+		}(p)
+	}
+	for d := 0; d < deleters; d++ {
+		writers.Add(1)
+		go func(d int) {
+			defer writers.Done()
+			for i := 0; i < opsPerGoroutine; i++ {
+				sl.Delete((i + d) % keyRange)
+			}
+		}(d)
+	}
+	for r := 0; r < readers; r++ {
+		readersWg.Add(1)
+		go func() {
+			defer readersWg.Done()
+			for !stop.Load() {
+				keys := sl.Range()
+				for i := 1; i < len(keys); i++ {
+					if keys[i-1] >= keys[i] {
+						t.Errorf("Range() not strictly increasing: %v", keys)
+						break
+					}
+				}
+				sl.All()(func(key, value int) bool {
+					if v, ok := sl.Get(key); !ok || v != value {
+						t.Errorf("All() yielded (%d, %d) but Get(%d) = (%d, %v)", key, value, key, v, ok)
+					}
+					return true
+				})
+			}
+		}()
+	}
+
+	writers.Wait()
+	stop.Store(true)
+	readersWg.Wait()
+
+	// Drive every key to a known final state so the structure's
+	// invariants can be checked deterministically: even keys present
+	// with a known value, odd keys absent.
+	for key := 0; key < keyRange; key++ {
+		if key%2 == 0 {
+			sl.Put(key, key*10)
+		} else {
+			sl.Delete(key)
+		}
+	}
+
+	var wantKeys []int
+	for key := 0; key < keyRange; key += 2 {
+		wantKeys = append(wantKeys, key)
+	}
+
+	gotKeys := sl.Range()
+	if !sort.IntsAreSorted(gotKeys) {
+		t.Fatalf("Range() after final pass not sorted: %v", gotKeys)
+	}
+	if len(gotKeys) != len(wantKeys) {
+		t.Fatalf("Range() after final pass = %v, want %v", gotKeys, wantKeys)
+	}
+	for i, key := range wantKeys {
+		if gotKeys[i] != key {
+			t.Fatalf("Range() after final pass = %v, want %v", gotKeys, wantKeys)
+		}
+		if v, ok := sl.Get(key); !ok || v != key*10 {
+			t.Fatalf("Get(%d) after final pass = (%d, %v), want (%d, true)", key, v, ok, key*10)
+		}
+	}
+	for key := 1; key < keyRange; key += 2 {
+		if _, ok := sl.Get(key); ok {
+			t.Fatalf("Get(%d) after final pass = present, want absent", key)
+		}
+	}
+
+	seen := 0
+	sl.All()(func(key, value int) bool {
+		if key%2 != 0 || value != key*10 {
+			t.Fatalf("All() yielded (%d, %d), want an even key mapped to key*10", key, value)
+		}
+		seen++
+		return true
+	})
+	if seen != len(wantKeys) {
+		t.Fatalf("All() yielded %d pairs, want %d", seen, len(wantKeys))
+	}
+}
@@ -0,0 +1,41 @@
+package unionfind
+
+import "testing"
+
+func TestConcurrentUnionFind(t *testing.T) {
+	u := NewConcurrentUnionFind(5)
+
+	t.Run("elements start in singleton sets", func(t *testing.T) {
+		if u.Connected(0, 1) {
+			t.Errorf("Connected(0, 1) = true, want false before any Union")
+		}
+	})
+
+	t.Run("union connects two sets", func(t *testing.T) {
+		if !u.Union(0, 1) {
+			t.Errorf("Union(0, 1) = false, want true for first merge")
+		}
+		if !u.Connected(0, 1) {
+			t.Errorf("Connected(0, 1) = false, want true after Union")
+		}
+	})
+
+	t.Run("union on already-connected elements returns false", func(t *testing.T) {
+		if u.Union(0, 1) {
+			t.Errorf("Union(0, 1) = true, want false when already connected")
+		}
+	})
+
+	t.Run("transitive connectivity", func(t *testing.T) {
+		u.Union(1, 2)
+		if !u.Connected(0, 2) {
+			t.Errorf("Connected(0, 2) = false, want true via transitive union")
+		}
+	})
+
+	t.Run("unrelated elements stay disconnected", func(t *testing.T) {
+		if u.Connected(3, 4) {
+			t.Errorf("Connected(3, 4) = true, want false")
+		}
+	})
+}
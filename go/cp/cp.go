@@ -0,0 +1,152 @@
+// Package cp is a small competitive-programming I/O template: a fast
+// buffered token scanner and writer, a couple of typedefs contest
+// solutions reach for constantly, and a Run harness that handles the
+// "read T, solve T test cases" loop so individual solutions only need to
+// write the solve function itself.
+package cp
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+)
+
+// Pair is the generic two-value typedef contest code reaches for
+// constantly (coordinates, (value, index), edges before they're split
+// into adjacency lists, ...).
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Scanner is a fast, buffered, whitespace-delimited token reader. Unlike
+// fmt.Scan, it reads in large chunks instead of one byte at a time, which
+// matters once input runs into the tens of megabytes.
+type Scanner struct {
+	r   *bufio.Reader
+	err error
+}
+
+// NewScanner returns a Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: bufio.NewReaderSize(r, 1<<20)}
+}
+
+// Err returns the first error encountered by the Scanner, if any.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// token reads the next whitespace-delimited token, or "" at EOF.
+func (s *Scanner) token() string {
+	// Skip leading whitespace.
+	var b byte
+	var err error
+	for {
+		b, err = s.r.ReadByte()
+		if err != nil {
+			s.setErr(err)
+			return ""
+		}
+		if !isSpace(b) {
+			break
+		}
+	}
+
+	var buf []byte
+	buf = append(buf, b)
+	for {
+		b, err = s.r.ReadByte()
+		if err != nil {
+			break
+		}
+		if isSpace(b) {
+			break
+		}
+		buf = append(buf, b)
+	}
+	return string(buf)
+}
+
+func (s *Scanner) setErr(err error) {
+	if s.err == nil && err != io.EOF {
+		s.err = err
+	}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+// ReadInt reads the next token as an int.
+func (s *Scanner) ReadInt() int {
+	tok := s.token()
+	n, err := strconv.Atoi(tok)
+	if err != nil && tok != "" {
+		s.setErr(err)
+	}
+	return n
+}
+
+// ReadInts reads n ints.
+func (s *Scanner) ReadInts(n int) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = s.ReadInt()
+	}
+	return out
+}
+
+// ReadString reads the next whitespace-delimited token.
+func (s *Scanner) ReadString() string {
+	return s.token()
+}
+
+// ReadStrings reads n tokens.
+func (s *Scanner) ReadStrings(n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = s.ReadString()
+	}
+	return out
+}
+
+// ReadGraph reads an n-vertex, m-edge undirected, 0-indexed graph as m
+// lines of "u v", and returns it as an adjacency list.
+func (s *Scanner) ReadGraph(n, m int) [][]int {
+	adj := make([][]int, n)
+	for i := 0; i < m; i++ {
+		u, v := s.ReadInt(), s.ReadInt()
+		adj[u] = append(adj[u], v)
+		adj[v] = append(adj[v], u)
+	}
+	return adj
+}
+
+// Writer is a thin wrapper around bufio.Writer; callers must call Flush
+// (or defer it) before the program exits so buffered output isn't lost.
+type Writer struct {
+	*bufio.Writer
+}
+
+// NewWriter returns a Writer writing to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{Writer: bufio.NewWriterSize(w, 1<<20)}
+}
+
+// Run implements the common contest-style harness: read a test case
+// count T from in, call solve once per test case (1-indexed) with a
+// Scanner/Writer pair already wired up to in/out, then flush out.
+//
+// Solutions that read a single test case (no leading T) can call solve
+// directly with their own Scanner/Writer instead of using Run.
+func Run(in io.Reader, out io.Writer, solve func(tc int, sc *Scanner, w *Writer)) {
+	sc := NewScanner(in)
+	w := NewWriter(out)
+	defer w.Flush()
+
+	t := sc.ReadInt()
+	for tc := 1; tc <= t; tc++ {
+		solve(tc, sc, w)
+	}
+}
@@ -0,0 +1,62 @@
+package cp
+
+import (
+	"bytes"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestScannerReadInt(t *testing.T) {
+	sc := NewScanner(strings.NewReader("  42\n-7 \t 0"))
+	want := []int{42, -7, 0}
+	for _, w := range want {
+		if got := sc.ReadInt(); got != w {
+			t.Errorf("ReadInt() = %d, want %d", got, w)
+		}
+	}
+	if sc.Err() != nil {
+		t.Errorf("Err() = %v, want nil", sc.Err())
+	}
+}
+
+func TestScannerReadInts(t *testing.T) {
+	sc := NewScanner(strings.NewReader("1 2 3 4"))
+	if got, want := sc.ReadInts(4), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("ReadInts(4) = %v, want %v", got, want)
+	}
+}
+
+func TestScannerReadStrings(t *testing.T) {
+	sc := NewScanner(strings.NewReader("foo bar baz"))
+	if got, want := sc.ReadStrings(3), []string{"foo", "bar", "baz"}; !slices.Equal(got, want) {
+		t.Errorf("ReadStrings(3) = %v, want %v", got, want)
+	}
+}
+
+func TestScannerReadGraph(t *testing.T) {
+	sc := NewScanner(strings.NewReader("0 1\n1 2"))
+	adj := sc.ReadGraph(3, 2)
+	want := [][]int{{1}, {0, 2}, {1}}
+	for i := range want {
+		if !slices.Equal(adj[i], want[i]) {
+			t.Errorf("ReadGraph()[%d] = %v, want %v", i, adj[i], want[i])
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	in := strings.NewReader("3\n1\n2\n3\n")
+	var out bytes.Buffer
+
+	Run(in, &out, func(tc int, sc *Scanner, w *Writer) {
+		n := sc.ReadInt()
+		w.WriteString(strconv.Itoa(n * n))
+		w.WriteByte('\n')
+	})
+
+	if got, want := out.String(), "1\n4\n9\n"; got != want {
+		t.Errorf("Run() output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,24 @@
+// Package dsaerr defines the sentinel errors shared by this repository's
+// algorithm entry points, so callers can check for a specific failure mode
+// with errors.Is instead of string-matching a panic message.
+package dsaerr
+
+import "errors"
+
+var (
+	// ErrEmptyInput is returned when an algorithm is given an input with
+	// no elements to work on (e.g. an empty slice to search or sum).
+	ErrEmptyInput = errors.New("dsaerr: empty input")
+
+	// ErrNegativeWeight is returned by algorithms that assume non-negative
+	// edge weights (e.g. Dijkstra) when given a negative one.
+	ErrNegativeWeight = errors.New("dsaerr: negative edge weight")
+
+	// ErrCycleDetected is returned by algorithms that require an acyclic
+	// graph (e.g. topological sort) when a cycle is found.
+	ErrCycleDetected = errors.New("dsaerr: cycle detected")
+
+	// ErrNotFound is returned when a lookup (a target value, a key, a
+	// node) does not exist in the input.
+	ErrNotFound = errors.New("dsaerr: not found")
+)
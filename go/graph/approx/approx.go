@@ -0,0 +1,139 @@
+// Package approx collects greedy and matching-based approximation
+// algorithms for NP-hard covering and routing problems, each with a
+// documented worst-case approximation ratio: greedy set cover (ratio
+// H(n), the n-th harmonic number), vertex cover via maximal matching
+// (ratio 2), and metric TSP via MST doubling (ratio 2). None of these
+// are exact solvers — see each function's doc comment for its bound,
+// and the package's tests for a brute-force comparison on small
+// instances.
+package approx
+
+import "sort"
+
+// SetCover greedily picks, at each step, the set covering the most
+// elements of universe not yet covered, until every element is covered
+// or no remaining set covers anything new. It returns the indices into
+// sets that were chosen, and is an H(n)-approximation of the optimal
+// cover, where H(n) = 1 + 1/2 + ... + 1/n and n = len(universe) — the
+// best ratio any polynomial-time algorithm can guarantee unless P = NP.
+func SetCover(universe []int, sets [][]int) []int {
+	uncovered := make(map[int]bool, len(universe))
+	for _, e := range universe {
+		uncovered[e] = true
+	}
+
+	var chosen []int
+	for len(uncovered) > 0 {
+		best, bestGain := -1, 0
+		for i, s := range sets {
+			gain := 0
+			for _, e := range s {
+				if uncovered[e] {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+		if best == -1 {
+			break // no remaining set covers anything new
+		}
+		for _, e := range sets[best] {
+			delete(uncovered, e)
+		}
+		chosen = append(chosen, best)
+	}
+
+	sort.Ints(chosen)
+	return chosen
+}
+
+// Edge is an undirected, weighted edge between vertices U and V.
+type Edge struct {
+	U, V, Weight int
+}
+
+// VertexCover returns a set of vertices (indices in [0, n)) covering
+// every edge, built by finding a maximal matching greedily and taking
+// both endpoints of each matched edge. Since any vertex cover must
+// contain at least one endpoint of every edge in a matching, and the
+// matching is maximal (no edge left uncovered can be added), this is a
+// 2-approximation of the minimum vertex cover.
+func VertexCover(edges []Edge, n int) []int {
+	matched := make([]bool, n)
+	coverSet := make(map[int]bool)
+
+	for _, e := range edges {
+		if !matched[e.U] && !matched[e.V] {
+			matched[e.U] = true
+			matched[e.V] = true
+			coverSet[e.U] = true
+			coverSet[e.V] = true
+		}
+	}
+
+	cover := make([]int, 0, len(coverSet))
+	for v := range coverSet {
+		cover = append(cover, v)
+	}
+	sort.Ints(cover)
+	return cover
+}
+
+// MetricTSP2Approx approximates a minimum-weight Hamiltonian cycle over
+// n points given their pairwise dist matrix (dist must be symmetric and
+// satisfy the triangle inequality — the "metric" in the name). It builds
+// a minimum spanning tree with Prim's algorithm, then walks it in
+// preorder, which visits every vertex and "doubles" each MST edge at
+// most once; since the optimal tour's weight is at least the MST's
+// weight, and this tour's weight is at most twice the MST's weight, it
+// is a 2-approximation of the optimal tour.
+func MetricTSP2Approx(dist [][]float64) []int {
+	n := len(dist)
+	if n == 0 {
+		return nil
+	}
+	if n == 1 {
+		return []int{0}
+	}
+
+	parent := make([]int, n)
+	children := make([][]int, n)
+	inTree := make([]bool, n)
+	minEdge := make([]float64, n)
+	for i := range minEdge {
+		minEdge[i] = dist[0][i]
+		parent[i] = 0
+	}
+	inTree[0] = true
+	minEdge[0] = 0
+
+	for count := 1; count < n; count++ {
+		u := -1
+		for v := 0; v < n; v++ {
+			if !inTree[v] && (u == -1 || minEdge[v] < minEdge[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		children[parent[u]] = append(children[parent[u]], u)
+		for v := 0; v < n; v++ {
+			if !inTree[v] && dist[u][v] < minEdge[v] {
+				minEdge[v] = dist[u][v]
+				parent[v] = u
+			}
+		}
+	}
+
+	tour := make([]int, 0, n)
+	var walk func(u int)
+	walk = func(u int) {
+		tour = append(tour, u)
+		for _, c := range children[u] {
+			walk(c)
+		}
+	}
+	walk(0)
+	return tour
+}
@@ -0,0 +1,217 @@
+package approx
+
+import (
+	"math"
+	"testing"
+)
+
+func coveredAll(universe []int, sets [][]int, chosen []int) bool {
+	covered := make(map[int]bool)
+	for _, i := range chosen {
+		for _, e := range sets[i] {
+			covered[e] = true
+		}
+	}
+	for _, e := range universe {
+		if !covered[e] {
+			return false
+		}
+	}
+	return true
+}
+
+// exactSetCover brute-forces the minimum number of sets needed to cover
+// universe, by trying every subset size in increasing order.
+func exactSetCover(universe []int, sets [][]int) int {
+	n := len(sets)
+	for size := 0; size <= n; size++ {
+		var found bool
+		var try func(start, remaining int, chosen []int) bool
+		try = func(start, remaining int, chosen []int) bool {
+			if remaining == 0 {
+				if coveredAll(universe, sets, chosen) {
+					found = true
+				}
+				return found
+			}
+			for i := start; i <= n-remaining; i++ {
+				if try(i+1, remaining-1, append(chosen, i)) {
+					return true
+				}
+			}
+			return false
+		}
+		if try(0, size, nil) {
+			return size
+		}
+	}
+	return n
+}
+
+func TestSetCover(t *testing.T) {
+	universe := []int{1, 2, 3, 4, 5}
+	sets := [][]int{
+		{1, 2, 3},
+		{2, 4},
+		{3, 4},
+		{4, 5},
+	}
+	chosen := SetCover(universe, sets)
+	if !coveredAll(universe, sets, chosen) {
+		t.Fatalf("SetCover(%v, %v) = %v does not cover the universe", universe, sets, chosen)
+	}
+
+	opt := exactSetCover(universe, sets)
+	if len(chosen) > 2*opt+1 {
+		t.Errorf("SetCover chose %d sets, optimal is %d — suspiciously far from the H(n) bound", len(chosen), opt)
+	}
+}
+
+func TestSetCoverEmptyUniverse(t *testing.T) {
+	if got := SetCover(nil, [][]int{{1, 2}}); len(got) != 0 {
+		t.Errorf("SetCover(nil, ...) = %v, want empty", got)
+	}
+}
+
+func edgesCoverAll(edges []Edge, cover []int) bool {
+	in := make(map[int]bool, len(cover))
+	for _, v := range cover {
+		in[v] = true
+	}
+	for _, e := range edges {
+		if !in[e.U] && !in[e.V] {
+			return false
+		}
+	}
+	return true
+}
+
+// exactVertexCover brute-forces the minimum vertex cover size over n
+// vertices by trying every subset size in increasing order.
+func exactVertexCover(edges []Edge, n int) int {
+	for size := 0; size <= n; size++ {
+		var found bool
+		var try func(start, remaining int, chosen []int) bool
+		try = func(start, remaining int, chosen []int) bool {
+			if remaining == 0 {
+				if edgesCoverAll(edges, chosen) {
+					found = true
+				}
+				return found
+			}
+			for v := start; v <= n-remaining; v++ {
+				if try(v+1, remaining-1, append(chosen, v)) {
+					return true
+				}
+			}
+			return false
+		}
+		if try(0, size, nil) {
+			return size
+		}
+	}
+	return n
+}
+
+func TestVertexCover(t *testing.T) {
+	edges := []Edge{
+		{U: 0, V: 1},
+		{U: 1, V: 2},
+		{U: 2, V: 3},
+		{U: 3, V: 0},
+		{U: 0, V: 2},
+	}
+	cover := VertexCover(edges, 4)
+	if !edgesCoverAll(edges, cover) {
+		t.Fatalf("VertexCover(%v, 4) = %v does not cover every edge", edges, cover)
+	}
+
+	opt := exactVertexCover(edges, 4)
+	if len(cover) > 2*opt {
+		t.Errorf("VertexCover chose %d vertices, optimal is %d — exceeds the 2-approximation bound", len(cover), opt)
+	}
+}
+
+func TestVertexCoverNoEdges(t *testing.T) {
+	if got := VertexCover(nil, 3); len(got) != 0 {
+		t.Errorf("VertexCover(nil, 3) = %v, want empty", got)
+	}
+}
+
+func tourLength(dist [][]float64, tour []int) float64 {
+	total := 0.0
+	for i := range tour {
+		j := (i + 1) % len(tour)
+		total += dist[tour[i]][tour[j]]
+	}
+	return total
+}
+
+// exactTSP brute-forces the minimum Hamiltonian cycle length by trying
+// every permutation of vertices 1..n-1 (vertex 0 fixed as the start).
+func exactTSP(dist [][]float64) float64 {
+	n := len(dist)
+	rest := make([]int, 0, n-1)
+	for i := 1; i < n; i++ {
+		rest = append(rest, i)
+	}
+	best := math.Inf(1)
+	var permute func(k int)
+	permute = func(k int) {
+		if k == len(rest) {
+			tour := append([]int{0}, rest...)
+			if l := tourLength(dist, tour); l < best {
+				best = l
+			}
+			return
+		}
+		for i := k; i < len(rest); i++ {
+			rest[k], rest[i] = rest[i], rest[k]
+			permute(k + 1)
+			rest[k], rest[i] = rest[i], rest[k]
+		}
+	}
+	permute(0)
+	return best
+}
+
+func TestMetricTSP2Approx(t *testing.T) {
+	// Points on a line: distances are metric (satisfy the triangle
+	// inequality) by construction.
+	points := []float64{0, 1, 4, 9, 10}
+	n := len(points)
+	dist := make([][]float64, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		for j := range dist[i] {
+			dist[i][j] = math.Abs(points[i] - points[j])
+		}
+	}
+
+	tour := MetricTSP2Approx(dist)
+	if len(tour) != n {
+		t.Fatalf("MetricTSP2Approx returned %d vertices, want %d", len(tour), n)
+	}
+	seen := make([]bool, n)
+	for _, v := range tour {
+		if seen[v] {
+			t.Fatalf("tour %v visits vertex %d twice", tour, v)
+		}
+		seen[v] = true
+	}
+
+	got := tourLength(dist, tour)
+	opt := exactTSP(dist)
+	if got > 2*opt+1e-9 {
+		t.Errorf("tour length %v exceeds 2x optimal %v", got, opt)
+	}
+}
+
+func TestMetricTSP2ApproxSmall(t *testing.T) {
+	if got := MetricTSP2Approx(nil); got != nil {
+		t.Errorf("MetricTSP2Approx(nil) = %v, want nil", got)
+	}
+	if got := MetricTSP2Approx([][]float64{{0}}); len(got) != 1 {
+		t.Errorf("MetricTSP2Approx single point = %v, want one vertex", got)
+	}
+}
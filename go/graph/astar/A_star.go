@@ -1,13 +1,12 @@
-package main
+package astar
 
 import (
 	"container/heap"
-	"fmt"
 	"math"
 )
 
 type Point struct {
-	x, y int
+	X, Y int
 }
 
 type Node struct {
@@ -46,7 +45,7 @@ func (pq *PriorityQueue) Pop() interface{} {
 }
 
 func heuristic(a, b Point) float64 {
-	return math.Abs(float64(a.x-b.x)) + math.Abs(float64(a.y-b.y))
+	return math.Abs(float64(a.X-b.X)) + math.Abs(float64(a.Y-b.Y))
 }
 
 func AStar(grid [][]int, start, goal Point) []Point {
@@ -80,7 +79,7 @@ func AStar(grid [][]int, start, goal Point) []Point {
 		visited[curr.pos] = true
 
 		for _, d := range dirs {
-			nx, ny := curr.pos.x+d.x, curr.pos.y+d.y
+			nx, ny := curr.pos.X+d.X, curr.pos.Y+d.Y
 			neighbor := Point{nx, ny}
 
 			if nx < 0 || ny < 0 || nx >= rows || ny >= cols || grid[nx][ny] == 1 {
@@ -104,28 +103,3 @@ func AStar(grid [][]int, start, goal Point) []Point {
 
 	return nil
 }
-
-func main() {
-	grid := [][]int{
-		{0, 0, 0, 0, 0},
-		{0, 1, 1, 1, 0},
-		{0, 0, 0, 0, 0},
-		{0, 1, 0, 1, 0},
-		{0, 0, 0, 0, 0},
-	}
-
-	start := Point{0, 0}
-	goal := Point{4, 4}
-
-	path := AStar(grid, start, goal)
-
-	if path != nil {
-		fmt.Println("Path found:")
-		for _, p := range path {
-			fmt.Printf("(%d,%d) ", p.x, p.y)
-		}
-		fmt.Printf("\nSteps: %d\n", len(path)-1)
-	} else {
-		fmt.Println("No path found")
-	}
-}
@@ -0,0 +1,35 @@
+package astar
+
+import "fmt"
+
+func ExampleAStar() {
+	grid := [][]int{
+		{0, 0, 0, 0, 0},
+		{0, 1, 1, 1, 0},
+		{0, 0, 0, 0, 0},
+		{0, 1, 0, 1, 0},
+		{0, 0, 0, 0, 0},
+	}
+
+	start := Point{X: 0, Y: 0}
+	goal := Point{X: 4, Y: 4}
+
+	path := AStar(grid, start, goal)
+	if path == nil {
+		fmt.Println("No path found")
+		return
+	}
+
+	fmt.Println("Path found:")
+	for i, p := range path {
+		if i > 0 {
+			fmt.Print(" ")
+		}
+		fmt.Printf("(%d,%d)", p.X, p.Y)
+	}
+	fmt.Printf("\nSteps: %d\n", len(path)-1)
+	// Output:
+	// Path found:
+	// (0,0) (1,0) (2,0) (3,0) (4,0) (4,1) (4,2) (4,3) (4,4)
+	// Steps: 8
+}
@@ -0,0 +1,64 @@
+package astar
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAStar(t *testing.T) {
+	t.Run("straight line path", func(t *testing.T) {
+		grid := [][]int{
+			{0, 0, 0},
+			{0, 0, 0},
+			{0, 0, 0},
+		}
+		path := AStar(grid, Point{0, 0}, Point{0, 2})
+		if len(path) == 0 {
+			t.Fatalf("AStar() returned no path")
+		}
+		if path[0] != (Point{0, 0}) || path[len(path)-1] != (Point{0, 2}) {
+			t.Errorf("AStar() path = %v, want it to start at {0 0} and end at {0 2}", path)
+		}
+		if len(path) != 3 {
+			t.Errorf("AStar() path length = %d, want 3 (shortest path on an open grid)", len(path))
+		}
+	})
+
+	t.Run("path around an obstacle", func(t *testing.T) {
+		grid := [][]int{
+			{0, 1, 0},
+			{0, 1, 0},
+			{0, 0, 0},
+		}
+		path := AStar(grid, Point{0, 0}, Point{0, 2})
+		if len(path) == 0 {
+			t.Fatalf("AStar() returned no path around the obstacle")
+		}
+		for _, p := range path {
+			if grid[p.X][p.Y] == 1 {
+				t.Errorf("AStar() path %v passes through a blocked cell %v", path, p)
+			}
+		}
+	})
+
+	t.Run("no path when fully blocked", func(t *testing.T) {
+		grid := [][]int{
+			{0, 1, 0},
+			{0, 1, 0},
+			{0, 1, 0},
+		}
+		path := AStar(grid, Point{0, 0}, Point{0, 2})
+		if path != nil {
+			t.Errorf("AStar() = %v, want nil (no path exists)", path)
+		}
+	})
+
+	t.Run("start equals goal", func(t *testing.T) {
+		grid := [][]int{{0}}
+		path := AStar(grid, Point{0, 0}, Point{0, 0})
+		want := []Point{{0, 0}}
+		if !slices.Equal(path, want) {
+			t.Errorf("AStar() = %v, want %v", path, want)
+		}
+	})
+}
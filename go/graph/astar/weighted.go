@@ -0,0 +1,203 @@
+package astar
+
+import "math"
+
+// Options configures AStarWeighted's movement model and post-processing.
+type Options struct {
+	// Diagonal enables 8-directional movement (the four ordinal
+	// directions in addition to the four cardinal ones) with the octile
+	// heuristic. The zero value sticks to 4-directional movement with
+	// the Manhattan heuristic AStar itself uses.
+	Diagonal bool
+	// Smooth runs the found path through line-of-sight smoothing,
+	// removing waypoints that a straight line can bypass without
+	// crossing an impassable cell.
+	Smooth bool
+}
+
+// Result is the outcome of AStarWeighted: the path found (nil if none)
+// and every distinct cell popped off the open set while searching, in
+// the order it was popped, for visualizing how the search explored the
+// grid.
+type Result struct {
+	Path     []Point
+	Explored []Point
+}
+
+// sqrt2 is the diagonal step length on a unit grid.
+const sqrt2 = math.Sqrt2
+
+// octileHeuristic is the admissible heuristic for 8-directional movement
+// on a unit grid: it anticipates that min(dx, dy) of the steps toward
+// the goal can be taken diagonally.
+func octileHeuristic(a, b Point) float64 {
+	dx := math.Abs(float64(a.X - b.X))
+	dy := math.Abs(float64(a.Y - b.Y))
+	if dx < dy {
+		dx, dy = dy, dx
+	}
+	return dx + (sqrt2-1)*dy
+}
+
+// AStarWeighted extends AStar with weighted terrain costs, optional
+// 8-directional movement, and optional path smoothing. cost[x][y] is the
+// price of moving into cell (x, y); a cost of 0 or less marks the cell
+// impassable. The explored-node set is every cell AStarWeighted finished
+// expanding, in expansion order, so callers can visualize the search.
+func AStarWeighted(cost [][]float64, start, goal Point, opts Options) Result {
+	rows, cols := len(cost), 0
+	if rows > 0 {
+		cols = len(cost[0])
+	}
+
+	dirs := []Point{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+	heuristic := heuristic
+	if opts.Diagonal {
+		dirs = append(dirs, Point{1, 1}, Point{1, -1}, Point{-1, 1}, Point{-1, -1})
+		heuristic = octileHeuristic
+	}
+
+	pq := &PriorityQueue{}
+	startNode := &Node{pos: start, g: 0, h: heuristic(start, goal)}
+	*pq = append(*pq, startNode)
+
+	visited := make(map[Point]bool)
+	costSoFar := map[Point]float64{start: 0}
+	var explored []Point
+
+	for pq.Len() > 0 {
+		curr := popMin(pq)
+
+		if visited[curr.pos] {
+			continue
+		}
+		visited[curr.pos] = true
+		explored = append(explored, curr.pos)
+
+		if curr.pos == goal {
+			path := []Point{}
+			for n := curr; n != nil; n = n.parent {
+				path = append([]Point{n.pos}, path...)
+			}
+			if opts.Smooth {
+				path = smoothPath(path, cost)
+			}
+			return Result{Path: path, Explored: explored}
+		}
+
+		for _, d := range dirs {
+			nx, ny := curr.pos.X+d.X, curr.pos.Y+d.Y
+			neighbor := Point{nx, ny}
+
+			if nx < 0 || ny < 0 || nx >= rows || ny >= cols || cost[nx][ny] <= 0 {
+				continue
+			}
+
+			stepLength := 1.0
+			if d.X != 0 && d.Y != 0 {
+				stepLength = sqrt2
+			}
+			newG := curr.g + cost[nx][ny]*stepLength
+
+			if existing, ok := costSoFar[neighbor]; !ok || newG < existing {
+				costSoFar[neighbor] = newG
+				*pq = append(*pq, &Node{
+					pos:    neighbor,
+					g:      newG,
+					h:      heuristic(neighbor, goal),
+					parent: curr,
+				})
+			}
+		}
+	}
+
+	return Result{Explored: explored}
+}
+
+// popMin removes and returns the PriorityQueue's lowest-f node by linear
+// scan. AStarWeighted reuses PriorityQueue only as a plain slice (no
+// container/heap) so it can share Node and PriorityQueue's Less with
+// AStar without also needing heap's index bookkeeping here.
+func popMin(pq *PriorityQueue) *Node {
+	s := *pq
+	best := 0
+	for i := 1; i < len(s); i++ {
+		if s[i].f() < s[best].f() {
+			best = i
+		}
+	}
+	node := s[best]
+	s[best] = s[len(s)-1]
+	*pq = s[:len(s)-1]
+	return node
+}
+
+// lineOfSight reports whether every cell on the grid line between a and
+// b (via Bresenham's algorithm) is passable, so the straight segment a-b
+// can safely replace the waypoints between them.
+func lineOfSight(a, b Point, cost [][]float64) bool {
+	x0, y0 := a.X, a.Y
+	x1, y1 := b.X, b.Y
+	dx := abs(x1 - x0)
+	dy := abs(y1 - y0)
+
+	sx, sy := 1, 1
+	if x1 < x0 {
+		sx = -1
+	}
+	if y1 < y0 {
+		sy = -1
+	}
+
+	err := dx - dy
+	x, y := x0, y0
+	for {
+		if cost[x][y] <= 0 {
+			return false
+		}
+		if x == x1 && y == y1 {
+			return true
+		}
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x += sx
+		}
+		if e2 < dx {
+			err += dx
+			y += sy
+		}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// smoothPath removes waypoints from path that a direct line of sight
+// makes unnecessary: starting from each waypoint, it jumps as far ahead
+// as it can while the straight line to that farther waypoint stays clear
+// of impassable cells.
+func smoothPath(path []Point, cost [][]float64) []Point {
+	if len(path) <= 2 {
+		return path
+	}
+
+	smoothed := []Point{path[0]}
+	anchor := 0
+	for anchor < len(path)-1 {
+		next := anchor + 1
+		for j := len(path) - 1; j > anchor+1; j-- {
+			if lineOfSight(path[anchor], path[j], cost) {
+				next = j
+				break
+			}
+		}
+		smoothed = append(smoothed, path[next])
+		anchor = next
+	}
+	return smoothed
+}
@@ -0,0 +1,98 @@
+package astar
+
+import "testing"
+
+func uniformCost(grid [][]int) [][]float64 {
+	cost := make([][]float64, len(grid))
+	for i, row := range grid {
+		cost[i] = make([]float64, len(row))
+		for j, v := range row {
+			if v == 1 {
+				cost[i][j] = 0
+			} else {
+				cost[i][j] = 1
+			}
+		}
+	}
+	return cost
+}
+
+func TestAStarWeightedPrefersCheaperTerrain(t *testing.T) {
+	// Two open lanes of equal length; the left lane is expensive swamp.
+	cost := [][]float64{
+		{10, 1},
+		{10, 1},
+		{10, 1},
+	}
+	result := AStarWeighted(cost, Point{0, 1}, Point{2, 1}, Options{})
+	if len(result.Path) == 0 {
+		t.Fatalf("AStarWeighted() found no path")
+	}
+	for _, p := range result.Path {
+		if p.Y == 0 {
+			t.Errorf("AStarWeighted() path %v uses the expensive lane at %v", result.Path, p)
+		}
+	}
+}
+
+func TestAStarWeightedImpassableCell(t *testing.T) {
+	grid := [][]int{
+		{0, 1, 0},
+		{0, 1, 0},
+		{0, 1, 0},
+	}
+	cost := uniformCost(grid)
+	result := AStarWeighted(cost, Point{0, 0}, Point{0, 2}, Options{})
+	if result.Path != nil {
+		t.Errorf("AStarWeighted() = %v, want nil (no path exists)", result.Path)
+	}
+	if len(result.Explored) == 0 {
+		t.Errorf("AStarWeighted() explored no nodes despite searching")
+	}
+}
+
+func TestAStarWeightedDiagonalShortensPath(t *testing.T) {
+	grid := [][]int{
+		{0, 0, 0},
+		{0, 0, 0},
+		{0, 0, 0},
+	}
+	cost := uniformCost(grid)
+
+	straight := AStarWeighted(cost, Point{0, 0}, Point{2, 2}, Options{})
+	diagonal := AStarWeighted(cost, Point{0, 0}, Point{2, 2}, Options{Diagonal: true})
+
+	if len(diagonal.Path) >= len(straight.Path) {
+		t.Errorf("diagonal path length = %d, want shorter than 4-directional path length %d", len(diagonal.Path), len(straight.Path))
+	}
+	if len(diagonal.Path) != 3 {
+		t.Errorf("diagonal path = %v, want 3 waypoints (two diagonal steps)", diagonal.Path)
+	}
+}
+
+func TestAStarWeightedSmoothingRemovesRedundantWaypoints(t *testing.T) {
+	grid := [][]int{
+		{0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0},
+	}
+	cost := uniformCost(grid)
+
+	unsmoothed := AStarWeighted(cost, Point{0, 0}, Point{0, 4}, Options{})
+	smoothed := AStarWeighted(cost, Point{0, 0}, Point{0, 4}, Options{Smooth: true})
+
+	if len(smoothed.Path) >= len(unsmoothed.Path) {
+		t.Errorf("smoothed path length = %d, want fewer waypoints than unsmoothed length %d", len(smoothed.Path), len(unsmoothed.Path))
+	}
+	if smoothed.Path[0] != (Point{0, 0}) || smoothed.Path[len(smoothed.Path)-1] != (Point{0, 4}) {
+		t.Errorf("smoothed path = %v, want it to still start at {0 0} and end at {0 4}", smoothed.Path)
+	}
+}
+
+func TestAStarWeightedStartEqualsGoal(t *testing.T) {
+	cost := [][]float64{{1}}
+	result := AStarWeighted(cost, Point{0, 0}, Point{0, 0}, Options{})
+	if len(result.Path) != 1 || result.Path[0] != (Point{0, 0}) {
+		t.Errorf("AStarWeighted() path = %v, want [{0 0}]", result.Path)
+	}
+}
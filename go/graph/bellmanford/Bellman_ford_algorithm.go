@@ -0,0 +1,39 @@
+package bellmanford
+
+import (
+	"math"
+)
+
+// Edge represents a weighted edge from `from` to `to`
+type Edge struct {
+	From, To, Weight int
+}
+
+// Bellman-Ford Algorithm
+// Returns distance array and a boolean indicating if a negative cycle exists
+func BellmanFord(edges []Edge, V int, start int) ([]int, bool) {
+	// Initialize distances
+	dist := make([]int, V)
+	for i := 0; i < V; i++ {
+		dist[i] = math.MaxInt32
+	}
+	dist[start] = 0
+
+	// Relax all edges V-1 times
+	for i := 0; i < V-1; i++ {
+		for _, edge := range edges {
+			if dist[edge.From] != math.MaxInt32 && dist[edge.From]+edge.Weight < dist[edge.To] {
+				dist[edge.To] = dist[edge.From] + edge.Weight
+			}
+		}
+	}
+
+	// Check for negative weight cycles
+	for _, edge := range edges {
+		if dist[edge.From] != math.MaxInt32 && dist[edge.From]+edge.Weight < dist[edge.To] {
+			return dist, true // Negative cycle detected
+		}
+	}
+
+	return dist, false
+}
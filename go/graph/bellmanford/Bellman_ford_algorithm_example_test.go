@@ -0,0 +1,36 @@
+package bellmanford
+
+import "fmt"
+
+func ExampleBellmanFord() {
+	V := 5
+	edges := []Edge{
+		{From: 0, To: 1, Weight: -1},
+		{From: 0, To: 2, Weight: 4},
+		{From: 1, To: 2, Weight: 3},
+		{From: 1, To: 3, Weight: 2},
+		{From: 1, To: 4, Weight: 2},
+		{From: 3, To: 2, Weight: 5},
+		{From: 3, To: 1, Weight: 1},
+		{From: 4, To: 3, Weight: -3},
+	}
+
+	start := 0
+	dist, hasNegativeCycle := BellmanFord(edges, V, start)
+	if hasNegativeCycle {
+		fmt.Println("Graph contains a negative weight cycle")
+		return
+	}
+
+	fmt.Println("Shortest distances from node", start, ":")
+	for i, d := range dist {
+		fmt.Printf("To %d = %d\n", i, d)
+	}
+	// Output:
+	// Shortest distances from node 0 :
+	// To 0 = 0
+	// To 1 = -1
+	// To 2 = 2
+	// To 3 = -2
+	// To 4 = 1
+}
@@ -0,0 +1,48 @@
+package bellmanford
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestBellmanFord(t *testing.T) {
+	t.Run("simple graph no negative cycle", func(t *testing.T) {
+		edges := []Edge{
+			{From: 0, To: 1, Weight: 4},
+			{From: 0, To: 2, Weight: 1},
+			{From: 2, To: 1, Weight: 2},
+			{From: 1, To: 3, Weight: 1},
+			{From: 2, To: 3, Weight: 5},
+		}
+		dist, hasNegCycle := BellmanFord(edges, 4, 0)
+		want := []int{0, 3, 1, 4}
+		if hasNegCycle {
+			t.Errorf("BellmanFord() reported a negative cycle, want none")
+		}
+		if !slices.Equal(dist, want) {
+			t.Errorf("BellmanFord() dist = %v, want %v", dist, want)
+		}
+	})
+
+	t.Run("negative cycle detected", func(t *testing.T) {
+		edges := []Edge{
+			{From: 0, To: 1, Weight: 1},
+			{From: 1, To: 2, Weight: -1},
+			{From: 2, To: 0, Weight: -1},
+		}
+		_, hasNegCycle := BellmanFord(edges, 3, 0)
+		if !hasNegCycle {
+			t.Errorf("BellmanFord() did not report the negative cycle")
+		}
+	})
+
+	t.Run("unreachable node stays at infinity", func(t *testing.T) {
+		edges := []Edge{{From: 0, To: 1, Weight: 5}}
+		dist, _ := BellmanFord(edges, 3, 0)
+		want := []int{0, 5, math.MaxInt32}
+		if !slices.Equal(dist, want) {
+			t.Errorf("BellmanFord() dist = %v, want %v", dist, want)
+		}
+	})
+}
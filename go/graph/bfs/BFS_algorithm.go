@@ -0,0 +1,49 @@
+package bfs
+
+import (
+	"fmt"
+	"sync"
+)
+
+// visitedPool and queuePool let repeated BFS calls reuse their scratch
+// buffers instead of each allocating its own visited slice and queue.
+var (
+	visitedPool = sync.Pool{New: func() any { return make([]bool, 0, 64) }}
+	queuePool   = sync.Pool{New: func() any { return make([]int, 0, 64) }}
+)
+
+func BFS(start int, graph [][]int) {
+	n := len(graph)
+
+	visited := visitedPool.Get().([]bool)
+	if cap(visited) < n {
+		visited = make([]bool, n)
+	} else {
+		visited = visited[:n]
+		for i := range visited {
+			visited[i] = false
+		}
+	}
+	defer visitedPool.Put(visited[:0])
+
+	// head tracks the front of the queue by index instead of re-slicing
+	// queue on every dequeue, so its backing array (and the pool slot it
+	// came from) keeps its full capacity across calls.
+	queue := append(queuePool.Get().([]int)[:0], start)
+	defer queuePool.Put(queue[:0])
+	head := 0
+	visited[start] = true
+
+	for head < len(queue) {
+		node := queue[head]
+		head++
+		fmt.Print(node, " ")
+
+		for _, nei := range graph[node] {
+			if !visited[nei] {
+				visited[nei] = true
+				queue = append(queue, nei) // enqueue
+			}
+		}
+	}
+}
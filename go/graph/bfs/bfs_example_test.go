@@ -0,0 +1,15 @@
+package bfs
+
+func ExampleBFS() {
+	graph := [][]int{
+		{1, 2},    // 0 -> 1, 2
+		{0, 3, 4}, // 1 -> 0, 3, 4
+		{0, 5},    // 2 -> 0, 5
+		{1},       // 3 -> 1
+		{1, 5},    // 4 -> 1, 5
+		{2, 4},    // 5 -> 2, 4
+	}
+
+	BFS(0, graph)
+	// Output: 0 1 2 3 4 5
+}
@@ -0,0 +1,34 @@
+package bfs
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/visualize"
+)
+
+// BFSTrace runs the same traversal as BFS but, instead of printing visited
+// nodes, records the queue (the "frontier") after each dequeue so the
+// traversal can be replayed frame by frame with visualize.Tracer.Replay.
+func BFSTrace(start int, graph [][]int) (visited []int, trace *visualize.Tracer) {
+	n := len(graph)
+	seen := make([]bool, n)
+	queue := []int{start}
+	seen[start] = true
+	trace = visualize.NewTracer()
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		visited = append(visited, node)
+
+		for _, nei := range graph[node] {
+			if !seen[nei] {
+				seen[nei] = true
+				queue = append(queue, nei)
+			}
+		}
+
+		trace.Capture(fmt.Sprintf("visit %d", node), visualize.ArrayASCII(queue))
+	}
+	return visited, trace
+}
@@ -0,0 +1,19 @@
+package bfs
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBFSTrace(t *testing.T) {
+	graph := [][]int{{1, 2}, {2}, {}}
+
+	visited, trace := BFSTrace(0, graph)
+
+	if want := []int{0, 1, 2}; !slices.Equal(visited, want) {
+		t.Errorf("BFSTrace() visited = %v, want %v", visited, want)
+	}
+	if len(trace.Frames()) != len(visited) {
+		t.Errorf("len(trace.Frames()) = %d, want %d", len(trace.Frames()), len(visited))
+	}
+}
@@ -0,0 +1,14 @@
+package dfs
+
+import "fmt"
+
+func DFS(node int, visited []bool, graph [][]int) {
+	visited[node] = true
+	fmt.Print(node, " ")
+
+	for _, nei := range graph[node] {
+		if !visited[nei] {
+			DFS(nei, visited, graph)
+		}
+	}
+}
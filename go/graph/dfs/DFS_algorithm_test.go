@@ -0,0 +1,53 @@
+package dfs
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func captureOutput(t *testing.T, fn func()) string {
+	t.Helper()
+
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+
+	fn()
+
+	w.Close()
+	os.Stdout = orig
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("io.Copy: %v", err)
+	}
+	return buf.String()
+}
+
+func TestDFS(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph [][]int
+		start int
+		want  string
+	}{
+		{"single node", [][]int{{}}, 0, "0 "},
+		{"linear chain", [][]int{{1}, {2}, {}}, 0, "0 1 2 "},
+		{"branching graph", [][]int{{1, 2}, {}, {3}, {}}, 0, "0 1 2 3 "},
+		{"cycle", [][]int{{1}, {2}, {0}}, 0, "0 1 2 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visited := make([]bool, len(tt.graph))
+			if got := captureOutput(t, func() { DFS(tt.start, visited, tt.graph) }); got != tt.want {
+				t.Errorf("DFS(%d, _, %v) output = %q, want %q", tt.start, tt.graph, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package dfs
+
+func ExampleDFS() {
+	graph := [][]int{
+		{1, 2},    // 0 -> 1, 2
+		{0, 3, 4}, // 1 -> 0, 3, 4
+		{0, 5},    // 2 -> 0, 5
+		{1},       // 3 -> 1
+		{1, 5},    // 4 -> 1, 5
+		{2, 4},    // 5 -> 2, 4
+	}
+
+	visited := make([]bool, len(graph))
+	DFS(0, visited, graph)
+	// Output: 0 1 3 4 5 2
+}
@@ -0,0 +1,85 @@
+package dijkstra
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+type Edge struct {
+	To     int
+	Weight int
+}
+
+type PriorityQueueItem struct {
+	node     int
+	distance int
+}
+
+type PriorityQueue []PriorityQueueItem
+
+func (pq PriorityQueue) Len() int { return len(pq) }
+func (pq PriorityQueue) Less(i, j int) bool {
+	return pq[i].distance < pq[j].distance
+}
+func (pq PriorityQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *PriorityQueue) Push(x interface{}) {
+	*pq = append(*pq, x.(PriorityQueueItem))
+}
+
+func (pq *PriorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[0 : n-1]
+	return item
+}
+
+// Dijkstra’s Algorithm. It returns dsaerr.ErrNegativeWeight if graph
+// contains a negative edge weight, since Dijkstra's algorithm doesn't
+// produce correct results in that case.
+func Dijkstra(graph [][]Edge, start int) ([]int, error) {
+	for _, edges := range graph {
+		for _, edge := range edges {
+			if edge.Weight < 0 {
+				return nil, dsaerr.ErrNegativeWeight
+			}
+		}
+	}
+
+	n := len(graph)
+	dist := make([]int, n)
+	for i := 0; i < n; i++ {
+		dist[i] = math.MaxInt32 // infinity
+	}
+	dist[start] = 0
+
+	pq := &PriorityQueue{}
+	heap.Init(pq)
+	heap.Push(pq, PriorityQueueItem{node: start, distance: 0})
+
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(PriorityQueueItem)
+		u := cur.node
+		d := cur.distance
+
+		// Skip if we already found a better path
+		if d > dist[u] {
+			continue
+		}
+
+		// Relax edges
+		for _, edge := range graph[u] {
+			v := edge.To
+			w := edge.Weight
+			if dist[u]+w < dist[v] {
+				dist[v] = dist[u] + w
+				heap.Push(pq, PriorityQueueItem{node: v, distance: dist[v]})
+			}
+		}
+	}
+
+	return dist, nil
+}
@@ -0,0 +1,35 @@
+package dijkstra
+
+import "fmt"
+
+func ExampleDijkstra() {
+	// 0 --4--> 1
+	// 0 --1--> 2
+	// 2 --2--> 1
+	// 1 --1--> 3
+	// 2 --5--> 3
+	graph := [][]Edge{
+		{{To: 1, Weight: 4}, {To: 2, Weight: 1}},
+		{{To: 0, Weight: 4}, {To: 2, Weight: 2}, {To: 3, Weight: 1}},
+		{{To: 0, Weight: 1}, {To: 1, Weight: 2}, {To: 3, Weight: 5}},
+		{{To: 1, Weight: 1}, {To: 2, Weight: 5}},
+	}
+
+	start := 0
+	dist, err := Dijkstra(graph, start)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+
+	fmt.Printf("Shortest distances from node %d:\n", start)
+	for i, d := range dist {
+		fmt.Printf("To %d = %d\n", i, d)
+	}
+	// Output:
+	// Shortest distances from node 0:
+	// To 0 = 0
+	// To 1 = 3
+	// To 2 = 1
+	// To 3 = 4
+}
@@ -0,0 +1,42 @@
+package dijkstra
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+func TestDijkstraAgainstBruteForceOracle(t *testing.T) {
+	r := testutil.NewRand(1)
+
+	for trial := 0; trial < 100; trial++ {
+		n := r.Intn(8) + 1
+		edges := testutil.RandomConnectedGraph(r, n, n, 10)
+
+		graph := make([][]Edge, n)
+		for _, e := range edges {
+			graph[e.From] = append(graph[e.From], Edge{To: e.To, Weight: e.Weight})
+		}
+
+		got, err := Dijkstra(graph, 0)
+		if err != nil {
+			t.Fatalf("trial %d: Dijkstra() error = %v (graph=%v)", trial, err, edges)
+		}
+		want := testutil.BruteForceShortestPaths(edges, n, 0)
+
+		for v := 0; v < n; v++ {
+			gotDist := got[v]
+			wantDist := want[v]
+			if wantDist == -1 {
+				if gotDist != math.MaxInt32 {
+					t.Fatalf("trial %d: Dijkstra()[%d] = %d, want unreachable (graph=%v)", trial, v, gotDist, edges)
+				}
+				continue
+			}
+			if gotDist != wantDist {
+				t.Fatalf("trial %d: Dijkstra()[%d] = %d, want %d (graph=%v)", trial, v, gotDist, wantDist, edges)
+			}
+		}
+	}
+}
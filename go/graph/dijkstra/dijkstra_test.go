@@ -0,0 +1,67 @@
+package dijkstra
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+func TestDijkstra(t *testing.T) {
+	t.Run("simple graph", func(t *testing.T) {
+		graph := [][]Edge{
+			{{To: 1, Weight: 4}, {To: 2, Weight: 1}},
+			{{To: 3, Weight: 1}},
+			{{To: 1, Weight: 2}, {To: 3, Weight: 5}},
+			{},
+		}
+		want := []int{0, 3, 1, 4}
+		got, err := Dijkstra(graph, 0)
+		if err != nil {
+			t.Fatalf("Dijkstra(graph, 0) error = %v", err)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("Dijkstra(graph, 0) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("single node", func(t *testing.T) {
+		graph := [][]Edge{{}}
+		want := []int{0}
+		got, err := Dijkstra(graph, 0)
+		if err != nil {
+			t.Fatalf("Dijkstra(graph, 0) error = %v", err)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("Dijkstra(graph, 0) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unreachable node", func(t *testing.T) {
+		graph := [][]Edge{
+			{{To: 1, Weight: 1}},
+			{},
+			{},
+		}
+		got, err := Dijkstra(graph, 0)
+		if err != nil {
+			t.Fatalf("Dijkstra(graph, 0) error = %v", err)
+		}
+		want := []int{0, 1, math.MaxInt32}
+		if !slices.Equal(got, want) {
+			t.Errorf("Dijkstra(graph, 0) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("negative weight", func(t *testing.T) {
+		graph := [][]Edge{
+			{{To: 1, Weight: -1}},
+			{},
+		}
+		if _, err := Dijkstra(graph, 0); !errors.Is(err, dsaerr.ErrNegativeWeight) {
+			t.Errorf("Dijkstra(graph, 0) error = %v, want %v", err, dsaerr.ErrNegativeWeight)
+		}
+	})
+}
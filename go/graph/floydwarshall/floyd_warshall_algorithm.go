@@ -0,0 +1,30 @@
+package floydwarshall
+
+import (
+	"math"
+)
+
+func FloydWarshall(graph [][]int, vertices int) [][]int {
+	// Create a copy of the graph to store shortest distances
+	dist := make([][]int, vertices)
+	for i := range graph {
+		dist[i] = make([]int, vertices)
+		for j := range graph[i] {
+			dist[i][j] = graph[i][j]
+		}
+	}
+
+	// Main Floyd–Warshall algorithm
+	for k := 0; k < vertices; k++ {
+		for i := 0; i < vertices; i++ {
+			for j := 0; j < vertices; j++ {
+				if dist[i][k] != math.MaxInt32 && dist[k][j] != math.MaxInt32 &&
+					dist[i][k]+dist[k][j] < dist[i][j] {
+					dist[i][j] = dist[i][k] + dist[k][j]
+				}
+			}
+		}
+	}
+
+	return dist
+}
@@ -0,0 +1,52 @@
+package floydwarshall
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+const inf = math.MaxInt32
+
+func TestFloydWarshall(t *testing.T) {
+	t.Run("simple graph", func(t *testing.T) {
+		graph := [][]int{
+			{0, 3, inf, 7},
+			{8, 0, 2, inf},
+			{5, inf, 0, 1},
+			{2, inf, inf, 0},
+		}
+		want := [][]int{
+			{0, 3, 5, 6},
+			{5, 0, 2, 3},
+			{3, 6, 0, 1},
+			{2, 5, 7, 0},
+		}
+
+		got := FloydWarshall(graph, 4)
+		for i := range want {
+			if !slices.Equal(got[i], want[i]) {
+				t.Errorf("FloydWarshall(graph, 4)[%d] = %v, want %v", i, got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("single vertex", func(t *testing.T) {
+		graph := [][]int{{0}}
+		got := FloydWarshall(graph, 1)
+		if !slices.Equal(got[0], []int{0}) {
+			t.Errorf("FloydWarshall(graph, 1)[0] = %v, want [0]", got[0])
+		}
+	})
+
+	t.Run("disconnected graph stays at infinity", func(t *testing.T) {
+		graph := [][]int{
+			{0, inf},
+			{inf, 0},
+		}
+		got := FloydWarshall(graph, 2)
+		if got[0][1] != inf || got[1][0] != inf {
+			t.Errorf("FloydWarshall(graph, 2) = %v, want unreachable entries to stay inf", got)
+		}
+	})
+}
@@ -1,13 +1,12 @@
-package main
+package kruskal
 
 import (
-	"fmt"
 	"sort"
 )
 
 // Structure to represent an edge
 type Edge struct {
-	src, dest, weight int
+	Src, Dest, Weight int
 }
 
 // Disjoint Set (Union-Find) structure
@@ -55,7 +54,7 @@ func (ds *DisjointSet) Union(x, y int) {
 func KruskalMST(edges []Edge, vertices int) []Edge {
 	// Step 1: Sort edges by weight
 	sort.Slice(edges, func(i, j int) bool {
-		return edges[i].weight < edges[j].weight
+		return edges[i].Weight < edges[j].Weight
 	})
 
 	ds := NewDisjointSet(vertices)
@@ -63,8 +62,8 @@ func KruskalMST(edges []Edge, vertices int) []Edge {
 
 	// Step 2: Iterate through edges and select those that don’t form cycles
 	for _, edge := range edges {
-		rootSrc := ds.Find(edge.src)
-		rootDest := ds.Find(edge.dest)
+		rootSrc := ds.Find(edge.Src)
+		rootDest := ds.Find(edge.Dest)
 
 		if rootSrc != rootDest {
 			mst = append(mst, edge)
@@ -79,30 +78,3 @@ func KruskalMST(edges []Edge, vertices int) []Edge {
 
 	return mst
 }
-
-func main() {
-	var vertices, edgesCount int
-	fmt.Print("Enter number of vertices: ")
-	fmt.Scan(&vertices)
-
-	fmt.Print("Enter number of edges: ")
-	fmt.Scan(&edgesCount)
-
-	edges := make([]Edge, edgesCount)
-
-	fmt.Println("Enter edges in the format: src dest weight")
-	for i := 0; i < edgesCount; i++ {
-		fmt.Scan(&edges[i].src, &edges[i].dest, &edges[i].weight)
-	}
-
-	mst := KruskalMST(edges, vertices)
-
-	fmt.Println("\nEdges in the Minimum Spanning Tree:")
-	totalWeight := 0
-	for _, e := range mst {
-		fmt.Printf("%d -- %d  == %d\n", e.src, e.dest, e.weight)
-		totalWeight += e.weight
-	}
-
-	fmt.Printf("Total weight of MST: %d\n", totalWeight)
-}
@@ -0,0 +1,55 @@
+package kruskal
+
+import (
+	"sort"
+	"testing"
+)
+
+func totalWeight(edges []Edge) int {
+	sum := 0
+	for _, e := range edges {
+		sum += e.Weight
+	}
+	return sum
+}
+
+func TestKruskalMST(t *testing.T) {
+	t.Run("simple graph", func(t *testing.T) {
+		edges := []Edge{
+			{Src: 0, Dest: 1, Weight: 10},
+			{Src: 0, Dest: 2, Weight: 6},
+			{Src: 0, Dest: 3, Weight: 5},
+			{Src: 1, Dest: 3, Weight: 15},
+			{Src: 2, Dest: 3, Weight: 4},
+		}
+		mst := KruskalMST(edges, 4)
+
+		if len(mst) != 3 {
+			t.Fatalf("KruskalMST() returned %d edges, want 3", len(mst))
+		}
+		if got := totalWeight(mst); got != 19 {
+			t.Errorf("KruskalMST() total weight = %d, want 19", got)
+		}
+	})
+
+	t.Run("single vertex has no edges", func(t *testing.T) {
+		mst := KruskalMST([]Edge{}, 1)
+		if len(mst) != 0 {
+			t.Errorf("KruskalMST() = %v, want empty", mst)
+		}
+	})
+
+	t.Run("already minimal triangle skips the costliest edge", func(t *testing.T) {
+		edges := []Edge{
+			{Src: 0, Dest: 1, Weight: 1},
+			{Src: 1, Dest: 2, Weight: 2},
+			{Src: 0, Dest: 2, Weight: 3},
+		}
+		mst := KruskalMST(edges, 3)
+		sort.Slice(mst, func(i, j int) bool { return mst[i].Weight < mst[j].Weight })
+
+		if len(mst) != 2 || mst[0].Weight != 1 || mst[1].Weight != 2 {
+			t.Errorf("KruskalMST() = %v, want weights [1 2]", mst)
+		}
+	})
+}
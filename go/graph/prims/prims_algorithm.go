@@ -1,14 +1,12 @@
-package main
+package prims
 
 import (
 	"container/heap"
-	"fmt"
-	"math"
 )
 
 // Edge represents an edge to a neighbor with a weight
 type Edge struct {
-	to, weight int
+	To, Weight int
 }
 
 // PriorityQueueItem for min-heap
@@ -38,7 +36,7 @@ func (pq *PriorityQueue) Pop() interface{} {
 }
 
 // Prim's Algorithm
-func primMST(graph [][]Edge, start int) int {
+func PrimMST(graph [][]Edge, start int) int {
 	n := len(graph)
 	visited := make([]bool, n)
 	pq := &PriorityQueue{}
@@ -60,32 +58,11 @@ func primMST(graph [][]Edge, start int) int {
 		totalWeight += w
 
 		for _, edge := range graph[u] {
-			if !visited[edge.to] {
-				heap.Push(pq, PriorityQueueItem{node: edge.to, weight: edge.weight})
+			if !visited[edge.To] {
+				heap.Push(pq, PriorityQueueItem{node: edge.To, weight: edge.Weight})
 			}
 		}
 	}
 
 	return totalWeight
 }
-
-func main() {
-	/*
-		Graph (undirected weighted):
-		0 --2-- 1
-		0 --3-- 3
-		1 --2-- 2
-		1 --4-- 3
-		2 --1-- 3
-	*/
-
-	graph := [][]Edge{
-		{{1, 2}, {3, 3}},    // 0
-		{{0, 2}, {2, 2}, {3, 4}}, // 1
-		{{1, 2}, {3, 1}},    // 2
-		{{0, 3}, {1, 4}, {2, 1}}, // 3
-	}
-
-	total := primMST(graph, 0)
-	fmt.Println("Total weight of MST:", total)
-}
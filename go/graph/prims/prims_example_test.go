@@ -0,0 +1,21 @@
+package prims
+
+import "fmt"
+
+func ExamplePrimMST() {
+	// Graph (undirected weighted):
+	// 0 --2-- 1
+	// 0 --3-- 3
+	// 1 --2-- 2
+	// 1 --4-- 3
+	// 2 --1-- 3
+	graph := [][]Edge{
+		{{To: 1, Weight: 2}, {To: 3, Weight: 3}},                     // 0
+		{{To: 0, Weight: 2}, {To: 2, Weight: 2}, {To: 3, Weight: 4}}, // 1
+		{{To: 1, Weight: 2}, {To: 3, Weight: 1}},                     // 2
+		{{To: 0, Weight: 3}, {To: 1, Weight: 4}, {To: 2, Weight: 1}}, // 3
+	}
+
+	fmt.Println("Total weight of MST:", PrimMST(graph, 0))
+	// Output: Total weight of MST: 5
+}
@@ -0,0 +1,48 @@
+package prims
+
+import "testing"
+
+func TestPrimMST(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph [][]Edge
+		start int
+		want  int
+	}{
+		{
+			"single vertex",
+			[][]Edge{{}},
+			0,
+			0,
+		},
+		{
+			"triangle",
+			[][]Edge{
+				{{To: 1, Weight: 1}, {To: 2, Weight: 3}},
+				{{To: 0, Weight: 1}, {To: 2, Weight: 2}},
+				{{To: 0, Weight: 3}, {To: 1, Weight: 2}},
+			},
+			0,
+			3,
+		},
+		{
+			"four node graph",
+			[][]Edge{
+				{{To: 1, Weight: 10}, {To: 2, Weight: 6}, {To: 3, Weight: 5}},
+				{{To: 0, Weight: 10}, {To: 3, Weight: 15}},
+				{{To: 0, Weight: 6}, {To: 3, Weight: 4}},
+				{{To: 0, Weight: 5}, {To: 1, Weight: 15}, {To: 2, Weight: 4}},
+			},
+			0,
+			19,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrimMST(tt.graph, tt.start); got != tt.want {
+				t.Errorf("PrimMST(graph, %d) = %d, want %d", tt.start, got, tt.want)
+			}
+		})
+	}
+}
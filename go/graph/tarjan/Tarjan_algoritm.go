@@ -1,6 +1,4 @@
-package main
-
-import "fmt"
+package tarjan
 
 var time int
 
@@ -37,7 +35,7 @@ func tarjanDFS(u int, graph [][]int, disc, low []int, stack *[]int, inStack []bo
 	}
 }
 
-func tarjan(graph [][]int, n int) [][]int {
+func Tarjan(graph [][]int, n int) [][]int {
 	disc := make([]int, n)
 	low := make([]int, n)
 	inStack := make([]bool, n)
@@ -65,23 +63,3 @@ func min(a, b int) int {
 	}
 	return b
 }
-
-func main() {
-	// Example graph
-	// 0 → 1, 1 → 2, 2 → 0, 1 → 3, 3 → 4
-	graph := [][]int{
-		{1},
-		{2, 3},
-		{0},
-		{4},
-		{},
-	}
-
-	n := len(graph)
-	sccs := tarjan(graph, n)
-
-	fmt.Println("Strongly Connected Components (SCCs):")
-	for _, scc := range sccs {
-		fmt.Println(scc)
-	}
-}
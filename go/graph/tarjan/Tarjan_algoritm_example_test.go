@@ -0,0 +1,24 @@
+package tarjan
+
+import "fmt"
+
+func ExampleTarjan() {
+	// 0 -> 1, 1 -> 2, 2 -> 0, 1 -> 3, 3 -> 4
+	graph := [][]int{
+		{1},
+		{2, 3},
+		{0},
+		{4},
+		{},
+	}
+
+	fmt.Println("Strongly Connected Components (SCCs):")
+	for _, scc := range Tarjan(graph, len(graph)) {
+		fmt.Println(scc)
+	}
+	// Output:
+	// Strongly Connected Components (SCCs):
+	// [4]
+	// [3]
+	// [2 1 0]
+}
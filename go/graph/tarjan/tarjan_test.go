@@ -0,0 +1,64 @@
+package tarjan
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+func normalize(sccs [][]int) [][]int {
+	for _, scc := range sccs {
+		sort.Ints(scc)
+	}
+	sort.Slice(sccs, func(i, j int) bool { return sccs[i][0] < sccs[j][0] })
+	return sccs
+}
+
+func TestTarjan(t *testing.T) {
+	t.Run("single node", func(t *testing.T) {
+		graph := [][]int{{}}
+		want := [][]int{{0}}
+		if got := normalize(Tarjan(graph, 1)); !reflectEqual(got, want) {
+			t.Errorf("Tarjan(graph, 1) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("single SCC (cycle)", func(t *testing.T) {
+		graph := [][]int{{1}, {2}, {0}}
+		got := normalize(Tarjan(graph, 3))
+		want := [][]int{{0, 1, 2}}
+		if !reflectEqual(got, want) {
+			t.Errorf("Tarjan(graph, 3) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("no cycles gives one SCC per node", func(t *testing.T) {
+		graph := [][]int{{1}, {2}, {}}
+		got := normalize(Tarjan(graph, 3))
+		want := [][]int{{0}, {1}, {2}}
+		if !reflectEqual(got, want) {
+			t.Errorf("Tarjan(graph, 3) = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("two separate cycles", func(t *testing.T) {
+		graph := [][]int{{1}, {0}, {3}, {2}}
+		got := normalize(Tarjan(graph, 4))
+		want := [][]int{{0, 1}, {2, 3}}
+		if !reflectEqual(got, want) {
+			t.Errorf("Tarjan(graph, 4) = %v, want %v", got, want)
+		}
+	})
+}
+
+func reflectEqual(a, b [][]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !slices.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package widestpath
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+// UndirectedEdge connects U and V with the given capacity Weight, for
+// the Kruskal-style maximum-spanning-tree route to the widest path.
+type UndirectedEdge struct {
+	U, V, Weight int
+}
+
+// disjointSet is a plain (non-concurrent) union-find with path
+// compression and union by size; building a spanning tree is
+// single-threaded, so it doesn't need the locking the concurrency
+// package's union-find pays for.
+type disjointSet struct {
+	parent []int
+	size   []int
+}
+
+func newDisjointSet(n int) *disjointSet {
+	parent := make([]int, n)
+	size := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+		size[i] = 1
+	}
+	return &disjointSet{parent: parent, size: size}
+}
+
+func (d *disjointSet) find(x int) int {
+	for d.parent[x] != x {
+		d.parent[x] = d.parent[d.parent[x]]
+		x = d.parent[x]
+	}
+	return x
+}
+
+func (d *disjointSet) union(x, y int) bool {
+	rx, ry := d.find(x), d.find(y)
+	if rx == ry {
+		return false
+	}
+	if d.size[rx] < d.size[ry] {
+		rx, ry = ry, rx
+	}
+	d.parent[ry] = rx
+	d.size[rx] += d.size[ry]
+	return true
+}
+
+// MaximumSpanningTree returns a maximum spanning tree of the undirected
+// graph described by edges over n nodes, built by Kruskal's algorithm
+// sorted by descending weight instead of the usual ascending order. If
+// the graph is disconnected, it returns a maximum spanning forest.
+//
+// The widest path between any two nodes in a connected graph always
+// lies inside some maximum spanning tree of that graph: shrinking the
+// search to the tree's n-1 edges is what makes TwoNodeWidestPath cheap
+// for repeated queries against the same graph.
+func MaximumSpanningTree(edges []UndirectedEdge, n int) ([]UndirectedEdge, error) {
+	for _, e := range edges {
+		if e.Weight < 0 {
+			return nil, dsaerr.ErrNegativeWeight
+		}
+	}
+
+	sorted := append([]UndirectedEdge{}, edges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Weight > sorted[j].Weight
+	})
+
+	ds := newDisjointSet(n)
+	var mst []UndirectedEdge
+	for _, e := range sorted {
+		if ds.union(e.U, e.V) {
+			mst = append(mst, e)
+		}
+	}
+	return mst, nil
+}
+
+// TwoNodeWidestPath returns the bottleneck capacity of the widest path
+// between u and v: the maximum spanning tree's unique path between them,
+// whose weakest edge is the answer. It reports false if u and v aren't
+// connected.
+func TwoNodeWidestPath(edges []UndirectedEdge, n, u, v int) (int, bool, error) {
+	mst, err := MaximumSpanningTree(edges, n)
+	if err != nil {
+		return 0, false, err
+	}
+
+	adj := make([][]UndirectedEdge, n)
+	for _, e := range mst {
+		adj[e.U] = append(adj[e.U], e)
+		adj[e.V] = append(adj[e.V], UndirectedEdge{U: e.V, V: e.U, Weight: e.Weight})
+	}
+
+	if u == v {
+		return math.MaxInt32, true, nil
+	}
+
+	visited := make([]bool, n)
+	visited[u] = true
+	type frame struct {
+		node       int
+		bottleneck int
+	}
+	queue := []frame{{node: u, bottleneck: math.MaxInt32}}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, e := range adj[cur.node] {
+			if visited[e.V] {
+				continue
+			}
+			visited[e.V] = true
+			b := min(cur.bottleneck, e.Weight)
+			if e.V == v {
+				return b, true, nil
+			}
+			queue = append(queue, frame{node: e.V, bottleneck: b})
+		}
+	}
+	return 0, false, nil
+}
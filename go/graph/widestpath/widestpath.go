@@ -0,0 +1,113 @@
+// Package widestpath finds maximum-bottleneck paths: the path between
+// two nodes whose weakest edge is as strong as possible, rather than
+// the path whose edges sum to the least. Network-reliability and
+// capacity-routing problems ask this question; the existing
+// shortest-path packages (dijkstra, bellmanford, floydwarshall) only
+// answer the sum-of-weights version.
+//
+// Two classic algorithms solve it, and both live here: WidestPath runs
+// a modified single-source Dijkstra that relaxes on bottleneck capacity
+// instead of distance sum, while MaximumSpanningTree and
+// TwoNodeWidestPath take the Kruskal route — the widest path between
+// any two nodes is always a path in the graph's maximum spanning tree.
+package widestpath
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+// Edge is a directed edge to To with the given capacity Weight.
+type Edge struct {
+	To     int
+	Weight int
+}
+
+type pqItem struct {
+	node       int
+	bottleneck int
+}
+
+type maxPQ []pqItem
+
+func (pq maxPQ) Len() int { return len(pq) }
+func (pq maxPQ) Less(i, j int) bool {
+	// Max-heap: the largest bottleneck capacity pops first.
+	return pq[i].bottleneck > pq[j].bottleneck
+}
+func (pq maxPQ) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *maxPQ) Push(x interface{}) {
+	*pq = append(*pq, x.(pqItem))
+}
+
+func (pq *maxPQ) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[0 : n-1]
+	return item
+}
+
+// WidestPath returns, for every node, the bottleneck capacity of the
+// widest path from start to it: the maximum over all paths of the
+// minimum edge weight on that path. start's own entry is
+// math.MaxInt32, meaning no edge constrains it yet. A node with no path
+// from start gets 0, since a path with no edges has no capacity at all
+// (the widest-path analogue of dijkstra's "unreachable" sentinel, which
+// can't be reused here: infinity already means "start node" above).
+//
+// It returns dsaerr.ErrNegativeWeight if graph contains a negative edge
+// weight, since bottleneck capacity isn't a meaningful concept for
+// negative weights.
+func WidestPath(graph [][]Edge, start int) ([]int, error) {
+	for _, edges := range graph {
+		for _, edge := range edges {
+			if edge.Weight < 0 {
+				return nil, dsaerr.ErrNegativeWeight
+			}
+		}
+	}
+
+	n := len(graph)
+	bottleneck := make([]int, n)
+	for i := 0; i < n; i++ {
+		bottleneck[i] = 0
+	}
+	bottleneck[start] = math.MaxInt32
+
+	pq := &maxPQ{}
+	heap.Init(pq)
+	heap.Push(pq, pqItem{node: start, bottleneck: bottleneck[start]})
+
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(pqItem)
+		u := cur.node
+		b := cur.bottleneck
+
+		// Stale entry: we've since found a wider path to u.
+		if b < bottleneck[u] {
+			continue
+		}
+
+		for _, edge := range graph[u] {
+			v := edge.To
+			candidate := min(bottleneck[u], edge.Weight)
+			if candidate > bottleneck[v] {
+				bottleneck[v] = candidate
+				heap.Push(pq, pqItem{node: v, bottleneck: candidate})
+			}
+		}
+	}
+
+	return bottleneck, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,136 @@
+package widestpath
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+func TestWidestPath(t *testing.T) {
+	t.Run("simple graph", func(t *testing.T) {
+		// 0 --4--> 1, 0 --1--> 2, 2 --2--> 1, 1 --1--> 3, 2 --5--> 3
+		graph := [][]Edge{
+			{{To: 1, Weight: 4}, {To: 2, Weight: 1}},
+			{{To: 3, Weight: 1}},
+			{{To: 1, Weight: 2}, {To: 3, Weight: 5}},
+			{},
+		}
+		got, err := WidestPath(graph, 0)
+		if err != nil {
+			t.Fatalf("WidestPath() error = %v", err)
+		}
+		want := []int{math.MaxInt32, 4, 1, 1}
+		if !slices.Equal(got, want) {
+			t.Errorf("WidestPath() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unreachable node", func(t *testing.T) {
+		graph := [][]Edge{
+			{{To: 1, Weight: 5}},
+			{},
+			{},
+		}
+		got, err := WidestPath(graph, 0)
+		if err != nil {
+			t.Fatalf("WidestPath() error = %v", err)
+		}
+		want := []int{math.MaxInt32, 5, 0}
+		if !slices.Equal(got, want) {
+			t.Errorf("WidestPath() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("negative weight", func(t *testing.T) {
+		graph := [][]Edge{
+			{{To: 1, Weight: -1}},
+			{},
+		}
+		if _, err := WidestPath(graph, 0); !errors.Is(err, dsaerr.ErrNegativeWeight) {
+			t.Errorf("WidestPath() error = %v, want %v", err, dsaerr.ErrNegativeWeight)
+		}
+	})
+
+	t.Run("picks the wider of two paths", func(t *testing.T) {
+		// 0->1 direct capacity 2; 0->2->1 via capacities 10 and 8 -> bottleneck 8.
+		graph := [][]Edge{
+			{{To: 1, Weight: 2}, {To: 2, Weight: 10}},
+			{},
+			{{To: 1, Weight: 8}},
+		}
+		got, err := WidestPath(graph, 0)
+		if err != nil {
+			t.Fatalf("WidestPath() error = %v", err)
+		}
+		if got[1] != 8 {
+			t.Errorf("WidestPath()[1] = %d, want 8", got[1])
+		}
+	})
+}
+
+func TestMaximumSpanningTree(t *testing.T) {
+	edges := []UndirectedEdge{
+		{U: 0, V: 1, Weight: 2},
+		{U: 0, V: 2, Weight: 10},
+		{U: 2, V: 1, Weight: 8},
+		{U: 1, V: 3, Weight: 1},
+	}
+	mst, err := MaximumSpanningTree(edges, 4)
+	if err != nil {
+		t.Fatalf("MaximumSpanningTree() error = %v", err)
+	}
+	if len(mst) != 3 {
+		t.Fatalf("MaximumSpanningTree() has %d edges, want 3", len(mst))
+	}
+	var total int
+	for _, e := range mst {
+		total += e.Weight
+	}
+	if total != 10+8+1 {
+		t.Errorf("MaximumSpanningTree() total weight = %d, want %d", total, 10+8+1)
+	}
+}
+
+func TestTwoNodeWidestPath(t *testing.T) {
+	edges := []UndirectedEdge{
+		{U: 0, V: 1, Weight: 2},
+		{U: 0, V: 2, Weight: 10},
+		{U: 2, V: 1, Weight: 8},
+		{U: 1, V: 3, Weight: 1},
+	}
+
+	got, ok, err := TwoNodeWidestPath(edges, 4, 0, 3)
+	if err != nil {
+		t.Fatalf("TwoNodeWidestPath() error = %v", err)
+	}
+	if !ok {
+		t.Fatalf("TwoNodeWidestPath() reported unreachable, want a path")
+	}
+	if got != 1 {
+		t.Errorf("TwoNodeWidestPath(0, 3) = %d, want 1", got)
+	}
+
+	if got, ok, err := TwoNodeWidestPath(edges, 4, 0, 2); err != nil || !ok || got != 10 {
+		t.Errorf("TwoNodeWidestPath(0, 2) = (%d, %v, %v), want (10, true, nil)", got, ok, err)
+	}
+
+	if got, ok, err := TwoNodeWidestPath(edges, 4, 0, 0); err != nil || !ok || got != math.MaxInt32 {
+		t.Errorf("TwoNodeWidestPath(0, 0) = (%d, %v, %v), want (MaxInt32, true, nil)", got, ok, err)
+	}
+}
+
+func TestTwoNodeWidestPathDisconnected(t *testing.T) {
+	edges := []UndirectedEdge{
+		{U: 0, V: 1, Weight: 5},
+	}
+	_, ok, err := TwoNodeWidestPath(edges, 3, 0, 2)
+	if err != nil {
+		t.Fatalf("TwoNodeWidestPath() error = %v", err)
+	}
+	if ok {
+		t.Errorf("TwoNodeWidestPath() reported connected, want unreachable")
+	}
+}
@@ -0,0 +1,19 @@
+// Package iterutil defines the single shared iterator shapes used across
+// this module's containers (trees, skip lists, graphs). The shapes match
+// the standard library's iter.Seq / iter.Seq2 (Go 1.23+), so once this
+// module's go directive is raised to 1.23 or later, every All()/Seq()
+// method here works directly with range-over-func:
+//
+//	for v := range someContainer.All() { ... }
+//
+// Until then, callers invoke the returned function directly with a
+// yield callback, which is ordinary Go and needs no new language support.
+package iterutil
+
+// Seq is a single-value iterator: it calls yield once per element, in
+// order, stopping early if yield returns false.
+type Seq[V any] func(yield func(V) bool)
+
+// Seq2 is a key/value iterator: it calls yield once per pair, in order,
+// stopping early if yield returns false.
+type Seq2[K, V any] func(yield func(K, V) bool)
@@ -0,0 +1,98 @@
+// Package judge runs a solver function against input/expected-output file
+// pairs under a testdata/ directory, LeetCode-judge style, so a problem
+// solution can ship with a real test suite instead of a handful of
+// hand-written table cases.
+//
+// A case "foo" is the pair testdata/foo.in (the solver's input, verbatim)
+// and testdata/foo.out (the expected output, compared after trimming
+// trailing whitespace). Load finds every such pair in a directory; Run
+// feeds each one to a solver under a per-case time limit.
+package judge
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Case is one loaded input/expected-output pair.
+type Case struct {
+	Name  string // base file name, without the .in/.out extension
+	Input string
+	Want  string
+}
+
+// Load reads every "*.in" file in dir that has a matching "*.out" file and
+// returns the resulting cases, sorted by name for a deterministic run
+// order.
+func Load(dir string) ([]Case, error) {
+	ins, err := filepath.Glob(filepath.Join(dir, "*.in"))
+	if err != nil {
+		return nil, err
+	}
+
+	var cases []Case
+	for _, inPath := range ins {
+		name := strings.TrimSuffix(filepath.Base(inPath), ".in")
+		outPath := filepath.Join(dir, name+".out")
+
+		input, err := os.ReadFile(inPath)
+		if err != nil {
+			return nil, fmt.Errorf("judge: reading %s: %w", inPath, err)
+		}
+		want, err := os.ReadFile(outPath)
+		if err != nil {
+			return nil, fmt.Errorf("judge: reading %s: %w", outPath, err)
+		}
+
+		cases = append(cases, Case{Name: name, Input: string(input), Want: string(want)})
+	}
+
+	sort.Slice(cases, func(i, j int) bool { return cases[i].Name < cases[j].Name })
+	return cases, nil
+}
+
+// Run feeds every case to solve as a t.Run subtest, failing a case if
+// solve's output (after trimming trailing whitespace) doesn't match the
+// expected output, or if solve doesn't return within timeLimit.
+func Run(t *testing.T, cases []Case, timeLimit time.Duration, solve func(input string) (string, error)) {
+	t.Helper()
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			got, err := runWithTimeout(c.Input, timeLimit, solve)
+			if err != nil {
+				t.Fatalf("solve(%q) error = %v", c.Name, err)
+			}
+			if strings.TrimRight(got, "\n") != strings.TrimRight(c.Want, "\n") {
+				t.Errorf("solve(%q) = %q, want %q", c.Name, got, c.Want)
+			}
+		})
+	}
+}
+
+// runWithTimeout runs solve in its own goroutine and fails it if it takes
+// longer than timeLimit, instead of hanging the whole test suite.
+func runWithTimeout(input string, timeLimit time.Duration, solve func(string) (string, error)) (string, error) {
+	type result struct {
+		out string
+		err error
+	}
+	done := make(chan result, 1)
+
+	go func() {
+		out, err := solve(input)
+		done <- result{out, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.out, r.err
+	case <-time.After(timeLimit):
+		return "", fmt.Errorf("judge: exceeded time limit of %s", timeLimit)
+	}
+}
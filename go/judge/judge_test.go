@@ -0,0 +1,63 @@
+package judge
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeCase(t *testing.T, dir, name, in, out string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name+".in"), []byte(in), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".out"), []byte(out), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeCase(t, dir, "b", "2\n", "4\n")
+	writeCase(t, dir, "a", "3\n", "9\n")
+
+	cases, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(cases) != 2 {
+		t.Fatalf("Load() = %v, want 2 cases", cases)
+	}
+	if cases[0].Name != "a" || cases[1].Name != "b" {
+		t.Errorf("Load() order = [%s, %s], want [a, b]", cases[0].Name, cases[1].Name)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	writeCase(t, dir, "square", "4\n", "16\n")
+
+	cases, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	Run(t, cases, time.Second, func(input string) (string, error) {
+		n := 0
+		fmt.Sscanf(strings.TrimSpace(input), "%d", &n)
+		return fmt.Sprintf("%d\n", n*n), nil
+	})
+}
+
+func TestRunWithTimeoutExceeded(t *testing.T) {
+	_, err := runWithTimeout("1", 10*time.Millisecond, func(string) (string, error) {
+		time.Sleep(time.Second)
+		return "1\n", nil
+	})
+	if err == nil {
+		t.Errorf("runWithTimeout() error = nil, want a time-limit-exceeded error")
+	}
+}
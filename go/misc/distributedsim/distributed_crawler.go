@@ -1,4 +1,4 @@
-package main
+package distributedsim
 
 import (
 	"context"
@@ -106,27 +106,3 @@ func (c *Crawler) Crawl(ctx context.Context, urls []string) chan string {
 
 	return results
 }
-
-func main() {
-	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
-	defer cancel()
-
-	rateLimiter := NewRateLimiter(5, time.Second) // 5 requests/sec
-	coordinator := NewCoordinator()
-	crawler := NewCrawler(rateLimiter, coordinator)
-
-	urls := []string{
-		"https://golang.org",
-		"https://github.com",
-		"https://example.com",
-		"https://google.com",
-		"https://stackoverflow.com",
-	}
-
-	results := crawler.Crawl(ctx, urls)
-
-	fmt.Println("Crawl results:")
-	for res := range results {
-		fmt.Println(res)
-	}
-}
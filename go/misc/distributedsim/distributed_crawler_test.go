@@ -0,0 +1,63 @@
+package distributedsim
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCoordinatorLock(t *testing.T) {
+	c := NewCoordinator()
+
+	if !c.Lock("http://example.com") {
+		t.Errorf("first Lock() on a fresh URL should succeed")
+	}
+	if c.Lock("http://example.com") {
+		t.Errorf("second Lock() on an already-visited URL should fail")
+	}
+	if !c.Lock("http://other.com") {
+		t.Errorf("Lock() on a different URL should succeed")
+	}
+}
+
+func TestCrawl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rl := NewRateLimiter(10, time.Second)
+	coord := NewCoordinator()
+	crawler := NewCrawler(rl, coord)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	urls := []string{server.URL, server.URL}
+	results := crawler.Crawl(ctx, urls)
+
+	var seen []string
+	for r := range results {
+		seen = append(seen, r)
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("got %d results, want 2", len(seen))
+	}
+
+	var crawled, skipped int
+	for _, r := range seen {
+		switch {
+		case strings.Contains(r, "Crawled"):
+			crawled++
+		case strings.Contains(r, "Skipped"):
+			skipped++
+		}
+	}
+	if crawled != 1 || skipped != 1 {
+		t.Errorf("got %d crawled, %d skipped results, want 1 and 1: %v", crawled, skipped, seen)
+	}
+}
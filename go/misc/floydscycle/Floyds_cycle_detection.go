@@ -0,0 +1,19 @@
+// given the head of a linked list,determine if it contains a cycle.
+package floydscycle
+
+type ListNode struct {
+	Val  int
+	Next *ListNode
+}
+
+func HasCycle(head *ListNode) bool {
+	slow, fast := head, head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package floydscycle
+
+import "fmt"
+
+func ExampleHasCycle() {
+	n1 := &ListNode{Val: 1}
+	n2 := &ListNode{Val: 2}
+	n3 := &ListNode{Val: 3}
+	n4 := &ListNode{Val: 4}
+	n1.Next = n2
+	n2.Next = n3
+	n3.Next = n4
+	n4.Next = n2 // creates a cycle
+
+	fmt.Println("Has Cycle?", HasCycle(n1))
+	// Output: Has Cycle? true
+}
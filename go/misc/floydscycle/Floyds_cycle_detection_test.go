@@ -0,0 +1,44 @@
+package floydscycle
+
+import "testing"
+
+func TestHasCycle(t *testing.T) {
+	t.Run("empty list", func(t *testing.T) {
+		if HasCycle(nil) {
+			t.Errorf("HasCycle(nil) = true, want false")
+		}
+	})
+
+	t.Run("single node no cycle", func(t *testing.T) {
+		head := &ListNode{Val: 1}
+		if HasCycle(head) {
+			t.Errorf("HasCycle(single node) = true, want false")
+		}
+	})
+
+	t.Run("single node self cycle", func(t *testing.T) {
+		head := &ListNode{Val: 1}
+		head.Next = head
+		if !HasCycle(head) {
+			t.Errorf("HasCycle(self-cycle) = false, want true")
+		}
+	})
+
+	t.Run("acyclic list", func(t *testing.T) {
+		head := &ListNode{Val: 1, Next: &ListNode{Val: 2, Next: &ListNode{Val: 3}}}
+		if HasCycle(head) {
+			t.Errorf("HasCycle(acyclic list) = true, want false")
+		}
+	})
+
+	t.Run("cyclic list", func(t *testing.T) {
+		n3 := &ListNode{Val: 3}
+		n2 := &ListNode{Val: 2, Next: n3}
+		n1 := &ListNode{Val: 1, Next: n2}
+		n3.Next = n1 // cycle back to head
+
+		if !HasCycle(n1) {
+			t.Errorf("HasCycle(cyclic list) = false, want true")
+		}
+	})
+}
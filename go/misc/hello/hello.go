@@ -0,0 +1,6 @@
+package hello
+
+// Hello returns the classic greeting.
+func Hello() string {
+	return "Hello, World!"
+}
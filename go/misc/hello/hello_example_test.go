@@ -0,0 +1,8 @@
+package hello
+
+import "fmt"
+
+func ExampleHello() {
+	fmt.Println(Hello())
+	// Output: Hello, World!
+}
@@ -0,0 +1,30 @@
+package kadane
+
+import "github.com/ghostmkg/dsa-code/go/dsaerr"
+
+// Function implementing Kadane's Algorithm. It returns dsaerr.ErrEmptyInput
+// for an empty arr, since there's no subarray to sum.
+func Kadane(arr []int) (int, error) {
+	if len(arr) == 0 {
+		return 0, dsaerr.ErrEmptyInput
+	}
+
+	maxSum := arr[0]
+	currentSum := arr[0]
+
+	for i := 1; i < len(arr); i++ {
+		// Update current sum
+		if currentSum < 0 {
+			currentSum = arr[i]
+		} else {
+			currentSum += arr[i]
+		}
+
+		// Update max sum
+		if currentSum > maxSum {
+			maxSum = currentSum
+		}
+	}
+
+	return maxSum, nil
+}
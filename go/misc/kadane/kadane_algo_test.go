@@ -0,0 +1,39 @@
+package kadane
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+func TestKadane(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want int
+	}{
+		{"single element", []int{5}, 5},
+		{"all positive", []int{1, 2, 3, 4}, 10},
+		{"all negative", []int{-3, -1, -4, -2}, -1},
+		{"mixed", []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Kadane(tt.in)
+			if err != nil {
+				t.Fatalf("Kadane(%v) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Kadane(%v) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKadaneEmptyInput(t *testing.T) {
+	if _, err := Kadane(nil); !errors.Is(err, dsaerr.ErrEmptyInput) {
+		t.Errorf("Kadane(nil) error = %v, want %v", err, dsaerr.ErrEmptyInput)
+	}
+}
@@ -0,0 +1,14 @@
+package kadane
+
+import "fmt"
+
+func ExampleKadane() {
+	arr := []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}
+	result, err := Kadane(arr)
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println("Maximum Subarray Sum:", result)
+	// Output: Maximum Subarray Sum: 6
+}
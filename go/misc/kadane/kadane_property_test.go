@@ -0,0 +1,25 @@
+package kadane
+
+import (
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+func TestKadaneAgainstBruteForceOracle(t *testing.T) {
+	r := testutil.NewRand(1)
+
+	for trial := 0; trial < 200; trial++ {
+		n := r.Intn(20) + 1 // Kadane panics on an empty slice, so keep n >= 1
+		arr := testutil.RandomInts(r, n, 50)
+
+		got, err := Kadane(arr)
+		if err != nil {
+			t.Fatalf("trial %d: Kadane(%v) error = %v", trial, arr, err)
+		}
+		want := testutil.BruteForceMaxSubarraySum(arr)
+		if got != want {
+			t.Fatalf("trial %d: Kadane(%v) = %d, want %d (brute force)", trial, arr, got, want)
+		}
+	}
+}
@@ -1,11 +1,10 @@
-package main
+package kadanealt
 
 import (
-	"fmt"
 	"math"
 )
 
-func kadane(arr []int) int {
+func Kadane(arr []int) int {
 	maxSum := math.MinInt32
 	currentSum := 0
 
@@ -24,11 +23,3 @@ func max(a, b int) int {
 	}
 	return b
 }
-
-func main() {
-	arr := []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}
-	fmt.Println("Array:", arr)
-
-	result := kadane(arr)
-	fmt.Println("Maximum Subarray Sum:", result)
-}
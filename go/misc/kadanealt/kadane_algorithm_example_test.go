@@ -0,0 +1,13 @@
+package kadanealt
+
+import "fmt"
+
+func ExampleKadane() {
+	arr := []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}
+	fmt.Println("Array:", arr)
+
+	fmt.Println("Maximum Subarray Sum:", Kadane(arr))
+	// Output:
+	// Array: [-2 1 -3 4 -1 2 1 -5 4]
+	// Maximum Subarray Sum: 6
+}
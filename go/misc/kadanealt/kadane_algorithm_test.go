@@ -0,0 +1,28 @@
+package kadanealt
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKadane(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want int
+	}{
+		{"empty", []int{}, math.MinInt32},
+		{"single element", []int{5}, 5},
+		{"all positive", []int{1, 2, 3, 4}, 10},
+		{"all negative", []int{-3, -1, -4, -2}, -1},
+		{"mixed", []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Kadane(tt.in); got != tt.want {
+				t.Errorf("Kadane(%v) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
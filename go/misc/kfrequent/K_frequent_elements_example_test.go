@@ -0,0 +1,9 @@
+package kfrequent
+
+import "fmt"
+
+func ExampleTopKFrequent() {
+	nums := []int{1, 1, 1, 2, 2, 3}
+	fmt.Println("Top 2 frequent elements:", TopKFrequent(nums, 2))
+	// Output: Top 2 frequent elements: [2 1]
+}
@@ -0,0 +1,33 @@
+package kfrequent
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestTopKFrequent(t *testing.T) {
+	tests := []struct {
+		name string
+		nums []int
+		k    int
+		want []int
+	}{
+		{"classic case", []int{1, 1, 1, 2, 2, 3}, 2, []int{1, 2}},
+		{"single element", []int{1}, 1, []int{1}},
+		{"k equals distinct count", []int{4, 4, 5, 5, 6}, 3, []int{4, 5, 6}},
+		{"negatives", []int{-1, -1, -2}, 1, []int{-1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TopKFrequent(tt.nums, tt.k)
+			sort.Ints(got)
+			want := slices.Clone(tt.want)
+			sort.Ints(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("TopKFrequent(%v, %d) = %v, want %v", tt.nums, tt.k, got, want)
+			}
+		})
+	}
+}
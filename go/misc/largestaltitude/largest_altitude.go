@@ -0,0 +1,11 @@
+package largestaltitude
+
+func LargestAltitude(gain []int) int {
+	ans := 0
+	sum := 0
+	for _, value := range gain {
+		sum += value
+		ans = max(ans, sum)
+	}
+	return ans
+}
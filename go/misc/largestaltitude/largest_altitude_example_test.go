@@ -0,0 +1,9 @@
+package largestaltitude
+
+import "fmt"
+
+func ExampleLargestAltitude() {
+	gain := []int{-5, 1, 5, 0, -7}
+	fmt.Println("largest altitude is", LargestAltitude(gain))
+	// Output: largest altitude is 1
+}
@@ -0,0 +1,25 @@
+package largestaltitude
+
+import "testing"
+
+func TestLargestAltitude(t *testing.T) {
+	tests := []struct {
+		name string
+		gain []int
+		want int
+	}{
+		{"empty", []int{}, 0},
+		{"single gain", []int{5}, 5},
+		{"all negative stays at zero", []int{-1, -2, -3}, 0},
+		{"classic case", []int{-5, 1, 5, 0, -7}, 1},
+		{"rising then falling", []int{-4, -3, -2, -1, 4, 3, 4}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LargestAltitude(tt.gain); got != tt.want {
+				t.Errorf("LargestAltitude(%v) = %d, want %d", tt.gain, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package pacificatlantic solves LeetCode 417, Pacific Atlantic Water
+// Flow: given a grid of cell heights, find every cell from which water
+// can flow (downhill, never uphill) to both the Pacific (top/left edges)
+// and the Atlantic (bottom/right edges).
+package pacificatlantic
+
+// PacificAtlantic returns the coordinates, as [row, col] pairs, of every
+// cell that can reach both oceans. It works backwards from each ocean's
+// border, flowing "uphill or flat" from the border inward, since that's
+// the reverse of water flowing downhill into the ocean; a cell reachable
+// from both border searches can reach both oceans.
+func PacificAtlantic(heights [][]int) [][]int {
+	rows := len(heights)
+	if rows == 0 {
+		return nil
+	}
+	cols := len(heights[0])
+	if cols == 0 {
+		return nil
+	}
+
+	pacific := make([][]bool, rows)
+	atlantic := make([][]bool, rows)
+	for i := range pacific {
+		pacific[i] = make([]bool, cols)
+		atlantic[i] = make([]bool, cols)
+	}
+
+	for c := 0; c < cols; c++ {
+		flood(heights, pacific, 0, c)
+		flood(heights, atlantic, rows-1, c)
+	}
+	for r := 0; r < rows; r++ {
+		flood(heights, pacific, r, 0)
+		flood(heights, atlantic, r, cols-1)
+	}
+
+	var result [][]int
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if pacific[r][c] && atlantic[r][c] {
+				result = append(result, []int{r, c})
+			}
+		}
+	}
+	return result
+}
+
+func flood(heights [][]int, reached [][]bool, r, c int) {
+	if reached[r][c] {
+		return
+	}
+	reached[r][c] = true
+
+	for _, d := range [4][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		nr, nc := r+d[0], c+d[1]
+		if nr < 0 || nr >= len(heights) || nc < 0 || nc >= len(heights[0]) {
+			continue
+		}
+		if reached[nr][nc] || heights[nr][nc] < heights[r][c] {
+			continue
+		}
+		flood(heights, reached, nr, nc)
+	}
+}
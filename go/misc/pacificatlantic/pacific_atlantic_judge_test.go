@@ -0,0 +1,67 @@
+package pacificatlantic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghostmkg/dsa-code/go/judge"
+)
+
+// solve parses the judge input format ("rows cols" then that many rows of
+// space-separated heights) and formats PacificAtlantic's result as one
+// "r c" line per cell, sorted for a deterministic comparison against the
+// expected output file.
+func solve(input string) (string, error) {
+	fields := strings.Fields(input)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("pacificatlantic: input too short")
+	}
+	rows, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return "", err
+	}
+	cols, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return "", err
+	}
+
+	heights := make([][]int, rows)
+	idx := 2
+	for r := 0; r < rows; r++ {
+		heights[r] = make([]int, cols)
+		for c := 0; c < cols; c++ {
+			v, err := strconv.Atoi(fields[idx])
+			if err != nil {
+				return "", err
+			}
+			heights[r][c] = v
+			idx++
+		}
+	}
+
+	cells := PacificAtlantic(heights)
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i][0] != cells[j][0] {
+			return cells[i][0] < cells[j][0]
+		}
+		return cells[i][1] < cells[j][1]
+	})
+
+	var b strings.Builder
+	for _, cell := range cells {
+		fmt.Fprintf(&b, "%d %d\n", cell[0], cell[1])
+	}
+	return b.String(), nil
+}
+
+func TestPacificAtlanticJudged(t *testing.T) {
+	cases, err := judge.Load("testdata")
+	if err != nil {
+		t.Fatalf("judge.Load() error = %v", err)
+	}
+	judge.Run(t, cases, time.Second, solve)
+}
@@ -0,0 +1,40 @@
+package pacificatlantic
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestPacificAtlantic(t *testing.T) {
+	heights := [][]int{
+		{1, 2, 2, 3, 5},
+		{3, 2, 3, 4, 4},
+		{2, 4, 5, 3, 1},
+		{6, 7, 1, 4, 5},
+		{5, 1, 1, 2, 4},
+	}
+	want := [][]int{{0, 4}, {1, 3}, {1, 4}, {2, 2}, {3, 0}, {3, 1}, {4, 0}}
+
+	got := PacificAtlantic(heights)
+	sort.Slice(got, func(i, j int) bool {
+		if got[i][0] != got[j][0] {
+			return got[i][0] < got[j][0]
+		}
+		return got[i][1] < got[j][1]
+	})
+
+	if len(got) != len(want) {
+		t.Fatalf("PacificAtlantic() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i][0] != want[i][0] || got[i][1] != want[i][1] {
+			t.Errorf("PacificAtlantic()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPacificAtlanticEmpty(t *testing.T) {
+	if got := PacificAtlantic(nil); got != nil {
+		t.Errorf("PacificAtlantic(nil) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,79 @@
+// Package registry is a self-registration point for the algorithms in this
+// module: each algorithm package (or its cmd/dsa wrapper) calls Register in
+// an init() function to advertise its name, category, and complexity, so
+// tooling like the dsa CLI can list and describe them without a hand-maintained
+// table.
+package registry
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Entry describes one runnable algorithm.
+type Entry struct {
+	// Name is the unique identifier used to look the algorithm up, e.g. "kmp".
+	Name string
+	// Category groups related algorithms for listing, e.g. "sorting", "graph".
+	Category string
+	// TimeComplexity and SpaceComplexity are short Big-O descriptions, e.g.
+	// "O(n log n)" or "O(V + E)". They describe the typical/expected case;
+	// algorithms with notably different worst cases should say so, e.g.
+	// "O(n log n) avg, O(n^2) worst".
+	TimeComplexity  string
+	SpaceComplexity string
+	// Describe is a one-line human-readable summary, including example usage
+	// where the algorithm is runnable from the CLI.
+	Describe string
+	// Run executes the algorithm against CLI-style args. It may be nil for
+	// entries that only exist for discovery (no CLI wrapper written yet).
+	Run func(args []string) error
+	// SizedRun, if set, runs the algorithm against a generated input of
+	// size n and discards the result. It exists so tooling (the
+	// complexity estimator) can time the algorithm at increasing input
+	// sizes without parsing CLI args; it may be nil for entries that
+	// don't have a natural "run at size n" shape.
+	SizedRun func(n int)
+}
+
+var entries = map[string]Entry{}
+
+// Register adds e to the registry. It panics on a duplicate name, since that
+// indicates two algorithms collided on an identifier at init time.
+func Register(e Entry) {
+	if e.Name == "" {
+		panic("registry: entry must have a Name")
+	}
+	if _, exists := entries[e.Name]; exists {
+		panic(fmt.Sprintf("registry: duplicate algorithm %q", e.Name))
+	}
+	entries[e.Name] = e
+}
+
+// Lookup returns the entry registered under name, if any.
+func Lookup(name string) (Entry, bool) {
+	e, ok := entries[name]
+	return e, ok
+}
+
+// All returns every registered entry, sorted by name.
+func All() []Entry {
+	out := make([]Entry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// ByCategory returns every registered entry in category, sorted by name.
+func ByCategory(category string) []Entry {
+	out := make([]Entry, 0)
+	for _, e := range entries {
+		if e.Category == category {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,87 @@
+package registry
+
+import "testing"
+
+func TestRegisterAndLookup(t *testing.T) {
+	defer resetForTest()
+
+	Register(Entry{Name: "test-algo", Category: "sorting", TimeComplexity: "O(n log n)"})
+
+	e, ok := Lookup("test-algo")
+	if !ok {
+		t.Fatalf("Lookup(%q) = false, want true", "test-algo")
+	}
+	if e.Category != "sorting" {
+		t.Errorf("Lookup(%q).Category = %q, want %q", "test-algo", e.Category, "sorting")
+	}
+
+	if _, ok := Lookup("does-not-exist"); ok {
+		t.Errorf("Lookup(%q) = true, want false", "does-not-exist")
+	}
+}
+
+func TestRegisterDuplicatePanics(t *testing.T) {
+	defer resetForTest()
+
+	Register(Entry{Name: "dup"})
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register() with a duplicate name should panic")
+		}
+	}()
+	Register(Entry{Name: "dup"})
+}
+
+func TestRegisterEmptyNamePanics(t *testing.T) {
+	defer resetForTest()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register() with an empty name should panic")
+		}
+	}()
+	Register(Entry{Name: ""})
+}
+
+func TestAllIsSortedByName(t *testing.T) {
+	defer resetForTest()
+
+	Register(Entry{Name: "zebra"})
+	Register(Entry{Name: "apple"})
+	Register(Entry{Name: "mango"})
+
+	all := All()
+	if len(all) != 3 {
+		t.Fatalf("All() returned %d entries, want 3", len(all))
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Name >= all[i].Name {
+			t.Errorf("All() not sorted: %v", all)
+		}
+	}
+}
+
+func TestByCategory(t *testing.T) {
+	defer resetForTest()
+
+	Register(Entry{Name: "bubblesort", Category: "sorting"})
+	Register(Entry{Name: "quicksort", Category: "sorting"})
+	Register(Entry{Name: "bfs", Category: "graph"})
+
+	sorting := ByCategory("sorting")
+	if len(sorting) != 2 {
+		t.Fatalf("ByCategory(%q) returned %d entries, want 2", "sorting", len(sorting))
+	}
+
+	if got := ByCategory("nonexistent"); len(got) != 0 {
+		t.Errorf("ByCategory(%q) = %v, want empty", "nonexistent", got)
+	}
+}
+
+// resetForTest clears the package-level registry between tests, since
+// Register panics on duplicates and tests otherwise would collide with each
+// other's fixture entries.
+func resetForTest() {
+	entries = map[string]Entry{}
+}
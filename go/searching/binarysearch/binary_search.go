@@ -0,0 +1,21 @@
+package binarysearch
+
+import "cmp"
+
+func BinarySearch[T cmp.Ordered](arr []T, target T) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high {
+		mid := low + (high-low)/2 // Avoids overflow
+
+		if arr[mid] == target {
+			return mid
+		} else if arr[mid] < target {
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+	return -1 // Not found
+}
@@ -0,0 +1,19 @@
+package binarysearch
+
+import "fmt"
+
+func ExampleBinarySearch() {
+	sortedArr := []int{2, 5, 8, 12, 16, 23, 38, 56, 72, 91}
+	t1, t2 := 23, 10
+
+	fmt.Printf("Searching for %d in array: %v\n", t1, sortedArr)
+	fmt.Printf("Found %d at index: %d\n", t1, BinarySearch(sortedArr, t1))
+
+	fmt.Printf("Searching for %d in array: %v\n", t2, sortedArr)
+	fmt.Printf("Found %d at index: %d\n", t2, BinarySearch(sortedArr, t2))
+	// Output:
+	// Searching for 23 in array: [2 5 8 12 16 23 38 56 72 91]
+	// Found 23 at index: 5
+	// Searching for 10 in array: [2 5 8 12 16 23 38 56 72 91]
+	// Found 10 at index: -1
+}
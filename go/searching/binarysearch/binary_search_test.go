@@ -0,0 +1,35 @@
+package binarysearch
+
+import "testing"
+
+func TestBinarySearch(t *testing.T) {
+	tests := []struct {
+		name   string
+		arr    []int
+		target int
+		want   int
+	}{
+		{"empty", []int{}, 5, -1},
+		{"single element found", []int{5}, 5, 0},
+		{"single element not found", []int{5}, 1, -1},
+		{"found first", []int{2, 5, 8, 12}, 2, 0},
+		{"found last", []int{2, 5, 8, 12}, 12, 3},
+		{"found middle", []int{2, 5, 8, 12, 16}, 8, 2},
+		{"not found", []int{2, 5, 8, 12}, 10, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinarySearch(tt.arr, tt.target); got != tt.want {
+				t.Errorf("BinarySearch(%v, %d) = %d, want %d", tt.arr, tt.target, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		arr := []string{"apple", "banana", "cherry"}
+		if got := BinarySearch(arr, "banana"); got != 1 {
+			t.Errorf("BinarySearch(%v, banana) = %d, want 1", arr, got)
+		}
+	})
+}
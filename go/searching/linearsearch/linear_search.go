@@ -0,0 +1,11 @@
+package linearsearch
+
+// Linear search function
+func LinearSearch[T comparable](arr []T, target T) int {
+	for i, value := range arr {
+		if value == target {
+			return i
+		}
+	}
+	return -1
+}
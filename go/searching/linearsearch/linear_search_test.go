@@ -0,0 +1,35 @@
+package linearsearch
+
+import "testing"
+
+func TestLinearSearch(t *testing.T) {
+	tests := []struct {
+		name   string
+		arr    []int
+		target int
+		want   int
+	}{
+		{"empty", []int{}, 5, -1},
+		{"single element found", []int{5}, 5, 0},
+		{"single element not found", []int{5}, 1, -1},
+		{"found first", []int{2, 5, 8, 12}, 2, 0},
+		{"found last", []int{2, 5, 8, 12}, 12, 3},
+		{"duplicates returns first match", []int{3, 1, 3, 2}, 3, 0},
+		{"not found", []int{2, 5, 8, 12}, 10, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LinearSearch(tt.arr, tt.target); got != tt.want {
+				t.Errorf("LinearSearch(%v, %d) = %d, want %d", tt.arr, tt.target, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		arr := []string{"apple", "banana", "cherry"}
+		if got := LinearSearch(arr, "cherry"); got != 2 {
+			t.Errorf("LinearSearch(%v, cherry) = %d, want 2", arr, got)
+		}
+	})
+}
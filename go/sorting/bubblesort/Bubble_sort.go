@@ -0,0 +1,17 @@
+package bubblesort
+
+import "cmp"
+
+// BubbleSort sorts arr in place for any ordered type.
+func BubbleSort[T cmp.Ordered](arr []T) {
+	n := len(arr)
+	for i := 0; i < n-1; i++ {
+		// Last i elements are already in place
+		for j := 0; j < n-i-1; j++ {
+			if arr[j] > arr[j+1] {
+				// Swap arr[j] and arr[j+1]
+				arr[j], arr[j+1] = arr[j+1], arr[j]
+			}
+		}
+	}
+}
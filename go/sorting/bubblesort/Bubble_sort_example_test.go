@@ -0,0 +1,14 @@
+package bubblesort
+
+import "fmt"
+
+func ExampleBubbleSort() {
+	arr := []int{64, 34, 25, 12, 22, 11, 90}
+	fmt.Println("Original array:", arr)
+
+	BubbleSort(arr)
+	fmt.Println("Sorted array:  ", arr)
+	// Output:
+	// Original array: [64 34 25 12 22 11 90]
+	// Sorted array:   [11 12 22 25 34 64 90]
+}
@@ -0,0 +1,40 @@
+package bubblesort
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBubbleSort(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"already sorted", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{"duplicates", []int{3, 1, 3, 2, 1}, []int{1, 1, 2, 3, 3}},
+		{"negatives", []int{-3, 5, -1, 0}, []int{-3, -1, 0, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(tt.in)
+			BubbleSort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("BubbleSort(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		got := []string{"banana", "apple", "cherry"}
+		want := []string{"apple", "banana", "cherry"}
+		BubbleSort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("BubbleSort(%v) = %v, want %v", got, got, want)
+		}
+	})
+}
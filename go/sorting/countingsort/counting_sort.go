@@ -1,8 +1,4 @@
-package main
-
-import (
-	"fmt"
-)
+package countingsort
 
 // CountingSort sorts an array of non-negative integers using counting sort algorithm.
 func CountingSort(arr []int) []int {
@@ -38,20 +34,3 @@ func CountingSort(arr []int) []int {
 
 	return arr
 }
-
-func main() {
-	var n int
-	fmt.Print("Enter number of elements: ")
-	fmt.Scan(&n)
-
-	arr := make([]int, n)
-	fmt.Println("Enter elements:")
-
-	for i := 0; i < n; i++ {
-		fmt.Scan(&arr[i])
-	}
-
-	fmt.Println("Original array:", arr)
-	sorted := CountingSort(arr)
-	fmt.Println("Sorted array:  ", sorted)
-}
@@ -0,0 +1,29 @@
+package countingsort
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCountingSort(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{5}, []int{5}},
+		{"already sorted", []int{0, 1, 2, 3}, []int{0, 1, 2, 3}},
+		{"reverse sorted", []int{4, 3, 2, 1, 0}, []int{0, 1, 2, 3, 4}},
+		{"duplicates", []int{2, 0, 2, 1, 0}, []int{0, 0, 1, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CountingSort(slices.Clone(tt.in))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CountingSort(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,7 @@
-package main
+package introsort
 
 import (
-	"bufio"
-	"fmt"
 	"math"
-	"os"
-	"strconv"
-	"strings"
 )
 
 // Introsort is the main entry point for the algorithm.
@@ -127,36 +122,3 @@ func heapify(arr []int, n, i, offset int) {
 }
 
 // main is the driver function that demonstrates introsort interactively.
-func main() {
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Println("--- Interactive Introsort in Go ---")
-	fmt.Print("Enter the elements of the array (space-separated): ")
-
-	input, err := reader.ReadString('\n')
-	if err != nil {
-		fmt.Println("Error reading input:", err)
-		return
-	}
-	input = strings.TrimSpace(input)
-
-	if input == "" {
-		fmt.Println("No input provided. Exiting.")
-		return
-	}
-
-	parts := strings.Split(input, " ")
-	arr := make([]int, 0, len(parts))
-
-	for _, part := range parts {
-		num, err := strconv.Atoi(part)
-		if err != nil {
-			fmt.Printf("Invalid input '%s'. Please enter numbers only. Exiting.\n", part)
-			return
-		}
-		arr = append(arr, num)
-	}
-
-	fmt.Println("\nOriginal array:", arr)
-	Introsort(arr)
-	fmt.Println("Sorted array:  ", arr)
-}
@@ -1,12 +1,12 @@
-package main
+package introsortgeneric
 
 import (
-	"fmt"
+	"cmp"
 	"math"
 )
 
 // Introsort entry point (generic for any ordered type)
-func IntroSort[T constraints.Ordered](arr []T) {
+func IntroSort[T cmp.Ordered](arr []T) {
 	if len(arr) <= 1 {
 		return
 	}
@@ -14,7 +14,7 @@ func IntroSort[T constraints.Ordered](arr []T) {
 	introsortImpl(arr, depthLimit)
 }
 
-func introsortImpl[T constraints.Ordered](arr []T, depthLimit int) {
+func introsortImpl[T cmp.Ordered](arr []T, depthLimit int) {
 	const INSERTION_THRESHOLD = 16
 
 	n := len(arr)
@@ -38,7 +38,7 @@ func introsortImpl[T constraints.Ordered](arr []T, depthLimit int) {
 	introsortImpl(arr[pivot+1:], depthLimit-1)
 }
 
-func insertionSort[T constraints.Ordered](arr []T) {
+func insertionSort[T cmp.Ordered](arr []T) {
 	for i := 1; i < len(arr); i++ {
 		for j := i; j > 0 && arr[j] < arr[j-1]; j-- {
 			arr[j], arr[j-1] = arr[j-1], arr[j]
@@ -46,7 +46,7 @@ func insertionSort[T constraints.Ordered](arr []T) {
 	}
 }
 
-func partitionMedian3[T constraints.Ordered](arr []T) int {
+func partitionMedian3[T cmp.Ordered](arr []T) int {
 	n := len(arr)
 	mid := n / 2
 
@@ -75,7 +75,7 @@ func partitionMedian3[T constraints.Ordered](arr []T) int {
 	return i
 }
 
-func heapSort[T constraints.Ordered](arr []T) {
+func heapSort[T cmp.Ordered](arr []T) {
 	n := len(arr)
 	// Build max-heap
 	for i := n/2 - 1; i >= 0; i-- {
@@ -88,7 +88,7 @@ func heapSort[T constraints.Ordered](arr []T) {
 	}
 }
 
-func siftDown[T constraints.Ordered](arr []T, start, end int) {
+func siftDown[T cmp.Ordered](arr []T, start, end int) {
 	root := start
 	for {
 		child := 2*root + 1
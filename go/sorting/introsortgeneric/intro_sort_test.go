@@ -0,0 +1,44 @@
+package introsortgeneric
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIntroSort(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{3}, []int{3}},
+		{"already sorted", []int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+		{"duplicates", []int{3, 1, 2, 3, 1}, []int{1, 1, 2, 3, 3}},
+		{
+			"larger random-ish input",
+			[]int{9, 3, 7, 1, 8, 2, 5, 0, 6, 4, 3, 7, 9, 1, 5, 2, 8, 0, 6, 4},
+			[]int{0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(tt.in)
+			IntroSort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("IntroSort(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		got := []string{"pear", "apple", "mango", "fig", "kiwi"}
+		want := []string{"apple", "fig", "kiwi", "mango", "pear"}
+		IntroSort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("IntroSort(%v) = %v, want %v", got, got, want)
+		}
+	})
+}
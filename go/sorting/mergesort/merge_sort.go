@@ -0,0 +1,39 @@
+package mergesort
+
+import "cmp"
+
+// merge combines two sorted slices into one sorted slice
+func merge[T cmp.Ordered](left, right []T) []T {
+	result := []T{}
+	i, j := 0, 0
+
+	// Compare elements from both slices and pick the smaller one
+	for i < len(left) && j < len(right) {
+		if left[i] < right[j] {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+
+	// Add any remaining elements
+	result = append(result, left[i:]...)
+	result = append(result, right[j:]...)
+
+	return result
+}
+
+// MergeSort recursively splits and sorts the slice for any ordered type.
+func MergeSort[T cmp.Ordered](arr []T) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	mid := len(arr) / 2
+	left := MergeSort(arr[:mid])
+	right := MergeSort(arr[mid:])
+
+	return merge(left, right)
+}
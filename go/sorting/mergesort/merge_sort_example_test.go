@@ -0,0 +1,12 @@
+package mergesort
+
+import "fmt"
+
+func ExampleMergeSort() {
+	sorted := MergeSort([]int{5, 3, 8, 1, 9, 2})
+	fmt.Println("Sorted array:")
+	fmt.Println(sorted)
+	// Output:
+	// Sorted array:
+	// [1 2 3 5 8 9]
+}
@@ -0,0 +1,38 @@
+package mergesort
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeSort(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{42}, []int{42}},
+		{"already sorted", []int{1, 2, 3, 4}, []int{1, 2, 3, 4}},
+		{"reverse sorted", []int{4, 3, 2, 1}, []int{1, 2, 3, 4}},
+		{"duplicates", []int{2, 1, 2, 1}, []int{1, 1, 2, 2}},
+		{"negatives", []int{-5, 3, -1, 0, 2}, []int{-5, -1, 0, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeSort(slices.Clone(tt.in))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MergeSort(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		got := MergeSort([]string{"banana", "apple", "cherry"})
+		want := []string{"apple", "banana", "cherry"}
+		if !slices.Equal(got, want) {
+			t.Errorf("MergeSort = %v, want %v", got, want)
+		}
+	})
+}
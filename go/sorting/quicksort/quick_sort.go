@@ -0,0 +1,28 @@
+// File: quick_sort.go
+
+package quicksort
+
+import "cmp"
+
+func partition[T cmp.Ordered](arr []T, low, high int) int {
+	pivot := arr[high]
+	i := low - 1
+
+	for j := low; j < high; j++ {
+		if arr[j] <= pivot {
+			i++
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+	}
+	arr[i+1], arr[high] = arr[high], arr[i+1]
+	return i + 1
+}
+
+// QuickSort sorts arr[low:high+1] in place for any ordered type.
+func QuickSort[T cmp.Ordered](arr []T, low, high int) {
+	if low < high {
+		pi := partition(arr, low, high)
+		QuickSort(arr, low, pi-1)
+		QuickSort(arr, pi+1, high)
+	}
+}
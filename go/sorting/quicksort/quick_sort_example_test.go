@@ -0,0 +1,14 @@
+package quicksort
+
+import "fmt"
+
+func ExampleQuickSort() {
+	data := []int{10, 7, 8, 9, 1, 5}
+
+	fmt.Println("Original:", data)
+	QuickSort(data, 0, len(data)-1)
+	fmt.Println("Sorted:", data)
+	// Output:
+	// Original: [10 7 8 9 1 5]
+	// Sorted: [1 5 7 8 9 10]
+}
@@ -0,0 +1,32 @@
+package quicksort
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestQuickSort(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{7}, []int{7}},
+		{"already sorted", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"reverse sorted", []int{9, 7, 5, 3, 1}, []int{1, 3, 5, 7, 9}},
+		{"duplicates", []int{4, 2, 4, 1, 2}, []int{1, 2, 2, 4, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clone(tt.in)
+			if len(got) > 0 {
+				QuickSort(got, 0, len(got)-1)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("QuickSort(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
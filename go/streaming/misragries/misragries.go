@@ -0,0 +1,77 @@
+// Package misragries implements the Misra-Gries algorithm for finding
+// heavy hitters (the most frequent items) in a data stream using only
+// O(k) memory, far less than counting every distinct item exactly would
+// need on a stream with many distinct values.
+package misragries
+
+import "sort"
+
+// Entry is one tracked item and its estimated count.
+type Entry struct {
+	Item  string
+	Count int
+}
+
+// Counter tracks up to k-1 candidate heavy hitters. Any item whose true
+// frequency exceeds n/k (n = total items seen) is guaranteed to still be
+// tracked when Update finishes processing the stream; every estimate is an
+// undercount by at most n/k.
+type Counter struct {
+	k      int
+	counts map[string]int
+	n      int
+}
+
+// NewCounter returns a Counter sized to find items occurring more than a
+// 1/k fraction of the time. k must be at least 2.
+func NewCounter(k int) *Counter {
+	if k < 2 {
+		panic("misragries: NewCounter requires k >= 2")
+	}
+	return &Counter{k: k, counts: make(map[string]int, k-1)}
+}
+
+// Update processes one occurrence of item.
+func (c *Counter) Update(item string) {
+	c.n++
+	if _, ok := c.counts[item]; ok {
+		c.counts[item]++
+		return
+	}
+	if len(c.counts) < c.k-1 {
+		c.counts[item] = 1
+		return
+	}
+	// Table is full and item isn't tracked: every tracked counter pays a
+	// "toll" of 1, and any that hit zero are evicted, making room for
+	// future items without ever tracking more than k-1 at once.
+	for tracked, count := range c.counts {
+		if count == 1 {
+			delete(c.counts, tracked)
+		} else {
+			c.counts[tracked] = count - 1
+		}
+	}
+}
+
+// ErrorBound returns n/k, the maximum amount any tracked item's Count can
+// undercount its true frequency by.
+func (c *Counter) ErrorBound() int {
+	return c.n / c.k
+}
+
+// TopK returns every currently tracked item, sorted by estimated count
+// descending (ties broken by item for determinism).
+func (c *Counter) TopK() []Entry {
+	entries := make([]Entry, 0, len(c.counts))
+	for item, count := range c.counts {
+		entries = append(entries, Entry{Item: item, Count: count})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Item < entries[j].Item
+	})
+	return entries
+}
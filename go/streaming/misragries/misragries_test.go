@@ -0,0 +1,97 @@
+package misragries
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+// zipfianStream returns a deterministic stream of numItems item labels
+// ("item0".."item(numRanks-1)") whose frequencies roughly follow a Zipf
+// distribution: rank r's weight is proportional to 1/(r+1), so a handful
+// of items dominate the stream the way real heavy-hitter workloads do.
+func zipfianStream(r *rand.Rand, numRanks, numItems int) []string {
+	weights := make([]int, numRanks)
+	total := 0
+	for rank := 0; rank < numRanks; rank++ {
+		w := 1000 / (rank + 1)
+		weights[rank] = w
+		total += w
+	}
+
+	stream := make([]string, 0, numItems)
+	for len(stream) < numItems {
+		roll := r.Intn(total)
+		for rank, w := range weights {
+			if roll < w {
+				stream = append(stream, fmt.Sprintf("item%d", rank))
+				break
+			}
+			roll -= w
+		}
+	}
+	return stream
+}
+
+func exactCounts(stream []string) map[string]int {
+	counts := make(map[string]int)
+	for _, item := range stream {
+		counts[item]++
+	}
+	return counts
+}
+
+func TestUpdateAndErrorBound(t *testing.T) {
+	r := testutil.NewRand(7)
+	stream := zipfianStream(r, 20, 5000)
+	exact := exactCounts(stream)
+
+	c := NewCounter(10)
+	for _, item := range stream {
+		c.Update(item)
+	}
+
+	bound := c.ErrorBound()
+	for _, e := range c.TopK() {
+		if exact[e.Item]-e.Count > bound || e.Count > exact[e.Item] {
+			t.Errorf("item %q: estimate %d, exact %d, error bound %d — estimate should undercount by at most the bound and never overcount",
+				e.Item, e.Count, exact[e.Item], bound)
+		}
+	}
+}
+
+func TestTopKFindsActualHeaviestItems(t *testing.T) {
+	r := testutil.NewRand(11)
+	stream := zipfianStream(r, 20, 8000)
+	exact := exactCounts(stream)
+
+	c := NewCounter(6)
+	for _, item := range stream {
+		c.Update(item)
+	}
+
+	top := c.TopK()
+	if len(top) == 0 {
+		t.Fatalf("TopK() returned no entries")
+	}
+	// The single most frequent item overall should always survive with a
+	// table this much larger than the number of truly dominant items.
+	var trueTopItem string
+	trueTopCount := -1
+	for item, count := range exact {
+		if count > trueTopCount {
+			trueTopItem, trueTopCount = item, count
+		}
+	}
+	found := false
+	for _, e := range top {
+		if e.Item == trueTopItem {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("TopK() = %v, missing the true heaviest item %q (count %d)", top, trueTopItem, trueTopCount)
+	}
+}
@@ -0,0 +1,80 @@
+// Package spacesaving implements the Space-Saving algorithm (Metwally,
+// Agrawal, and Abbadi) for finding heavy hitters in a data stream with
+// O(k) memory. Unlike Misra-Gries, every tracked item's estimate is an
+// overcount (never an undercount), and each comes with its own explicit
+// per-item error bound rather than one bound shared across the whole
+// table.
+package spacesaving
+
+import "sort"
+
+// Entry is one tracked item, its estimated count, and the maximum amount
+// that estimate might overcount its true frequency by.
+type Entry struct {
+	Item  string
+	Count int
+	Error int
+}
+
+type counter struct {
+	count int
+	err   int
+}
+
+// Counter tracks exactly k candidate heavy hitters at a time.
+type Counter struct {
+	k      int
+	counts map[string]*counter
+}
+
+// NewCounter returns a Counter tracking the k highest-frequency items it
+// has seen so far. k must be at least 1.
+func NewCounter(k int) *Counter {
+	if k < 1 {
+		panic("spacesaving: NewCounter requires k >= 1")
+	}
+	return &Counter{k: k, counts: make(map[string]*counter, k)}
+}
+
+// Update processes one occurrence of item.
+func (c *Counter) Update(item string) {
+	if ctr, ok := c.counts[item]; ok {
+		ctr.count++
+		return
+	}
+	if len(c.counts) < c.k {
+		c.counts[item] = &counter{count: 1}
+		return
+	}
+
+	// Table is full: evict whichever tracked item currently has the
+	// smallest count, and take over its slot. The new item inherits the
+	// evicted item's count (an overestimate of its own true frequency)
+	// plus the evicted item's error bound, which records exactly how much
+	// that slot may have already overcounted.
+	var minItem string
+	var min *counter
+	for tracked, ctr := range c.counts {
+		if min == nil || ctr.count < min.count {
+			minItem, min = tracked, ctr
+		}
+	}
+	delete(c.counts, minItem)
+	c.counts[item] = &counter{count: min.count + 1, err: min.count}
+}
+
+// TopK returns every currently tracked item, sorted by estimated count
+// descending (ties broken by item for determinism).
+func (c *Counter) TopK() []Entry {
+	entries := make([]Entry, 0, len(c.counts))
+	for item, ctr := range c.counts {
+		entries = append(entries, Entry{Item: item, Count: ctr.count, Error: ctr.err})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Item < entries[j].Item
+	})
+	return entries
+}
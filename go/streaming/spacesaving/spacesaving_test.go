@@ -0,0 +1,102 @@
+package spacesaving
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/testutil"
+)
+
+// zipfianStream returns a deterministic stream of numItems item labels
+// whose frequencies roughly follow a Zipf distribution: rank r's weight is
+// proportional to 1/(r+1).
+func zipfianStream(r *rand.Rand, numRanks, numItems int) []string {
+	weights := make([]int, numRanks)
+	total := 0
+	for rank := 0; rank < numRanks; rank++ {
+		w := 1000 / (rank + 1)
+		weights[rank] = w
+		total += w
+	}
+
+	stream := make([]string, 0, numItems)
+	for len(stream) < numItems {
+		roll := r.Intn(total)
+		for rank, w := range weights {
+			if roll < w {
+				stream = append(stream, fmt.Sprintf("item%d", rank))
+				break
+			}
+			roll -= w
+		}
+	}
+	return stream
+}
+
+func exactCounts(stream []string) map[string]int {
+	counts := make(map[string]int)
+	for _, item := range stream {
+		counts[item]++
+	}
+	return counts
+}
+
+func TestUpdateAndErrorBound(t *testing.T) {
+	r := testutil.NewRand(13)
+	stream := zipfianStream(r, 20, 5000)
+	exact := exactCounts(stream)
+
+	c := NewCounter(10)
+	for _, item := range stream {
+		c.Update(item)
+	}
+
+	for _, e := range c.TopK() {
+		if e.Count < exact[e.Item] {
+			t.Errorf("item %q: estimate %d should never undercount exact %d", e.Item, e.Count, exact[e.Item])
+		}
+		if e.Count-e.Error > exact[e.Item] {
+			t.Errorf("item %q: estimate %d minus its own error bound %d should not exceed exact count %d",
+				e.Item, e.Count, e.Error, exact[e.Item])
+		}
+	}
+}
+
+func TestTopKFindsActualHeaviestItems(t *testing.T) {
+	r := testutil.NewRand(17)
+	stream := zipfianStream(r, 20, 8000)
+	exact := exactCounts(stream)
+
+	c := NewCounter(6)
+	for _, item := range stream {
+		c.Update(item)
+	}
+
+	var trueTopItem string
+	trueTopCount := -1
+	for item, count := range exact {
+		if count > trueTopCount {
+			trueTopItem, trueTopCount = item, count
+		}
+	}
+
+	found := false
+	for _, e := range c.TopK() {
+		if e.Item == trueTopItem {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("TopK() missing the true heaviest item %q (count %d)", trueTopItem, trueTopCount)
+	}
+}
+
+func TestNewCounterRejectsNonPositiveK(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewCounter(0) should panic")
+		}
+	}()
+	NewCounter(0)
+}
@@ -0,0 +1,140 @@
+// Package boyermoore implements the Boyer-Moore string search
+// algorithm with both the bad-character and good-suffix heuristics,
+// exposing the same FindAll/FindFirst/Count shape as strings/kmp so
+// callers can swap one matcher for the other. Boyer-Moore tends to
+// outperform KMP on large alphabets with few repeated characters, since
+// its heuristics let it skip past large stretches of the text without
+// examining every byte.
+package boyermoore
+
+// badCharTable maps each possible byte to the rightmost index it
+// occurs at in pattern, or -1 if it doesn't occur at all. On a
+// mismatch, the bad-character rule shifts the pattern so that
+// occurrence lines up with the mismatched text byte (or past it if
+// that would move backwards).
+func badCharTable(pattern string) [256]int {
+	var table [256]int
+	for i := range table {
+		table[i] = -1
+	}
+	for i := 0; i < len(pattern); i++ {
+		table[pattern[i]] = i
+	}
+	return table
+}
+
+// goodSuffixTable computes, for every possible mismatch position j in
+// pattern (0..len(pattern)), the shift the good-suffix rule recommends:
+// either reusing another occurrence of the matched suffix elsewhere in
+// the pattern, or aligning the widest prefix of pattern that is also a
+// suffix of the matched part. This is the standard two-pass
+// border-array construction.
+func goodSuffixTable(pattern string) []int {
+	m := len(pattern)
+	shift := make([]int, m+1)
+	border := make([]int, m+1)
+
+	i, j := m, m+1
+	border[i] = j
+	for i > 0 {
+		for j <= m && pattern[i-1] != pattern[j-1] {
+			if shift[j] == 0 {
+				shift[j] = j - i
+			}
+			j = border[j]
+		}
+		i--
+		j--
+		border[i] = j
+	}
+
+	j = border[0]
+	for i := 0; i <= m; i++ {
+		if shift[i] == 0 {
+			shift[i] = j
+		}
+		if i == j {
+			j = border[j]
+		}
+	}
+	return shift
+}
+
+// FindAll returns the starting index of every (possibly overlapping)
+// occurrence of pattern in text, in ascending order.
+func FindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+
+	badChar := badCharTable(pattern)
+	goodSuffix := goodSuffixTable(pattern)
+
+	var matches []int
+	s := 0
+	for s <= n-m {
+		j := m - 1
+		for j >= 0 && pattern[j] == text[s+j] {
+			j--
+		}
+		if j < 0 {
+			matches = append(matches, s)
+			s += goodSuffix[0]
+			continue
+		}
+
+		bcShift := j - badChar[text[s+j]]
+		gsShift := goodSuffix[j+1]
+		shift := bcShift
+		if gsShift > shift {
+			shift = gsShift
+		}
+		if shift < 1 {
+			shift = 1
+		}
+		s += shift
+	}
+	return matches
+}
+
+// FindFirst returns the starting index of the first occurrence of
+// pattern in text. It reports false if pattern doesn't occur in text.
+func FindFirst(text, pattern string) (int, bool) {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return 0, false
+	}
+
+	badChar := badCharTable(pattern)
+	goodSuffix := goodSuffixTable(pattern)
+
+	s := 0
+	for s <= n-m {
+		j := m - 1
+		for j >= 0 && pattern[j] == text[s+j] {
+			j--
+		}
+		if j < 0 {
+			return s, true
+		}
+
+		bcShift := j - badChar[text[s+j]]
+		gsShift := goodSuffix[j+1]
+		shift := bcShift
+		if gsShift > shift {
+			shift = gsShift
+		}
+		if shift < 1 {
+			shift = 1
+		}
+		s += shift
+	}
+	return 0, false
+}
+
+// Count returns the number of (possibly overlapping) occurrences of
+// pattern in text.
+func Count(text, pattern string) int {
+	return len(FindAll(text, pattern))
+}
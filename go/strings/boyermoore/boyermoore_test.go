@@ -0,0 +1,109 @@
+package boyermoore
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func bruteForceFindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	var matches []int
+	for i := 0; i+m <= n; i++ {
+		if text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		text, pattern string
+		want          []int
+	}{
+		{"abxabcabcaby", "abcaby", []int{6}},
+		{"abcdef", "xyz", nil},
+		{"aaaa", "aa", []int{0, 1, 2}},
+		{"hello", "hello", []int{0}},
+		{"ABAAABCD", "ABC", []int{4}},
+		{"GCATCGCAGAGAGTATACAGTACG", "GCAGAGAG", []int{5}},
+	}
+	for _, tt := range tests {
+		if got := FindAll(tt.text, tt.pattern); !slices.Equal(got, tt.want) {
+			t.Errorf("FindAll(%q, %q) = %v, want %v", tt.text, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestFindFirst(t *testing.T) {
+	idx, ok := FindFirst("abxabcabcaby", "abc")
+	if !ok || idx != 3 {
+		t.Errorf("FindFirst() = (%d, %v), want (3, true)", idx, ok)
+	}
+
+	if _, ok := FindFirst("abcdef", "xyz"); ok {
+		t.Errorf("FindFirst() reported a match for a pattern that isn't present")
+	}
+}
+
+func TestCount(t *testing.T) {
+	if got := Count("aaaa", "aa"); got != 3 {
+		t.Errorf("Count() = %d, want 3", got)
+	}
+	if got := Count("abc", "xyz"); got != 0 {
+		t.Errorf("Count() = %d, want 0", got)
+	}
+}
+
+func TestEmptyAndEdgeCases(t *testing.T) {
+	if got := FindAll("abc", ""); got != nil {
+		t.Errorf("FindAll with empty pattern = %v, want nil", got)
+	}
+	if got := FindAll("ab", "abc"); got != nil {
+		t.Errorf("FindAll with pattern longer than text = %v, want nil", got)
+	}
+	if got := FindAll("", ""); got != nil {
+		t.Errorf("FindAll(\"\", \"\") = %v, want nil", got)
+	}
+}
+
+func FuzzFindAll(f *testing.F) {
+	seeds := []struct {
+		text, pattern string
+	}{
+		{"", ""},
+		{"abc", ""},
+		{"", "abc"},
+		{"abcabcabc", "abc"},
+		{"aaaaaa", "aa"},
+		{"hello world", "world"},
+		{"mississippi", "issi"},
+	}
+	for _, s := range seeds {
+		f.Add(s.text, s.pattern)
+	}
+
+	f.Fuzz(func(t *testing.T, text, pattern string) {
+		got := FindAll(text, pattern)
+		want := bruteForceFindAll(text, pattern)
+		if !slices.Equal(got, want) {
+			t.Fatalf("FindAll(%q, %q) = %v, want %v (brute force)", text, pattern, got, want)
+		}
+
+		if pattern != "" {
+			wantFirst := strings.Index(text, pattern)
+			gotFirst, ok := FindFirst(text, pattern)
+			if wantFirst == -1 {
+				if ok {
+					t.Fatalf("FindFirst(%q, %q) reported a match, want none", text, pattern)
+				}
+			} else if !ok || gotFirst != wantFirst {
+				t.Fatalf("FindFirst(%q, %q) = (%d, %v), want (%d, true)", text, pattern, gotFirst, ok, wantFirst)
+			}
+		}
+	})
+}
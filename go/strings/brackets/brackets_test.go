@@ -0,0 +1,132 @@
+package brackets
+
+import "testing"
+
+func TestIsBalanced(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"()", true},
+		{"()[]{}", true},
+		{"(]", false},
+		{"([)]", false},
+		{"{[]}", true},
+		{"", true},
+		{"(a + [b * c])", true},
+		{"(a + [b * c)", false},
+		{"(", false},
+		{")", false},
+	}
+	for _, tt := range tests {
+		if got := IsBalanced(tt.s); got != tt.want {
+			t.Errorf("IsBalanced(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestMinInsertions(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"(((", 3},
+		{"()))", 2},
+		{"()", 0},
+		{")(", 2},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := MinInsertions(tt.s); got != tt.want {
+			t.Errorf("MinInsertions(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestMinRemovals(t *testing.T) {
+	tests := []struct {
+		s        string
+		want     int
+		possible []string
+	}{
+		{"lee(t(c)o)de)", 1, []string{"lee(t(c)o)de", "lee(t(co)de)", "lee(t(c)ode)"}},
+		{"a)b(c)d", 1, []string{"ab(c)d"}},
+		{"))((", 4, []string{""}},
+		{"(a(b(c)d)", 1, []string{"a(b(c)d)"}},
+		{"()", 0, []string{"()"}},
+	}
+	for _, tt := range tests {
+		removed, result := MinRemovals(tt.s)
+		if removed != tt.want {
+			t.Errorf("MinRemovals(%q) removed = %d, want %d", tt.s, removed, tt.want)
+		}
+		if !IsBalancedParensOnly(result) {
+			t.Errorf("MinRemovals(%q) result %q is not balanced", tt.s, result)
+		}
+		found := false
+		for _, p := range tt.possible {
+			if result == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("MinRemovals(%q) result = %q, want one of %v", tt.s, result, tt.possible)
+		}
+	}
+}
+
+// IsBalancedParensOnly is a test helper that checks '(' / ')' balance
+// while ignoring any other characters, used to sanity check MinRemovals
+// results independent of the exact string it picked.
+func IsBalancedParensOnly(s string) bool {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
+
+func TestLongestValidParentheses(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"(()", 2},
+		{")()())", 4},
+		{"", 0},
+		{"()(()", 2},
+		{"()()", 4},
+		{")(", 0},
+	}
+	for _, tt := range tests {
+		if got := LongestValidParentheses(tt.s); got != tt.want {
+			t.Errorf("LongestValidParentheses(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestScoreOfParentheses(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"()", 1},
+		{"(())", 2},
+		{"()()", 2},
+		{"(()(()))", 6},
+	}
+	for _, tt := range tests {
+		if got := ScoreOfParentheses(tt.s); got != tt.want {
+			t.Errorf("ScoreOfParentheses(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
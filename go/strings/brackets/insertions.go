@@ -0,0 +1,60 @@
+package brackets
+
+import "github.com/ghostmkg/dsa-code/go/structures/stack"
+
+// MinInsertions returns the minimum number of '(' or ')' characters that
+// must be inserted into s (containing only '(' and ')') to make it
+// valid. It scans left to right, tracking how many unmatched '(' are
+// open; any ')' with none open forces an insertion of a matching '('.
+func MinInsertions(s string) int {
+	inserted := 0
+	open := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			open++
+		case ')':
+			if open > 0 {
+				open--
+			} else {
+				inserted++
+			}
+		}
+	}
+	return inserted + open
+}
+
+// MinRemovals returns the minimum number of '(' or ')' characters that
+// must be removed from s (which may contain other characters alongside
+// '(' and ')') to make the brackets valid, along with the resulting
+// string. A first pass marks every ')' with no matching '(' for removal
+// using a stack of open-paren indices; any '(' left on the stack
+// afterwards is unmatched and also marked for removal.
+func MinRemovals(s string) (removed int, result string) {
+	var open stack.SliceStack[int]
+	remove := make(map[int]bool)
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			open.Push(i)
+		case ')':
+			if _, ok := open.Pop(); !ok {
+				remove[i] = true
+			}
+		}
+	}
+	for open.Len() > 0 {
+		idx, _ := open.Pop()
+		remove[idx] = true
+	}
+
+	buf := make([]byte, 0, len(s)-len(remove))
+	for i := 0; i < len(s); i++ {
+		if remove[i] {
+			continue
+		}
+		buf = append(buf, s[i])
+	}
+	return len(remove), string(buf)
+}
@@ -0,0 +1,32 @@
+package brackets
+
+import "github.com/ghostmkg/dsa-code/go/structures/stack"
+
+// LongestValidParentheses returns the length of the longest contiguous
+// substring of s (containing only '(' and ')') that is itself valid. It
+// keeps a stack of indices of "unmatched so far" characters, seeded with
+// -1 so the first valid run can measure its length against a sentinel
+// base; every matched ')' pops its partner and the current valid run
+// length is the distance back to whatever index is now on top.
+func LongestValidParentheses(s string) int {
+	var idx stack.SliceStack[int]
+	idx.Push(-1)
+	longest := 0
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			idx.Push(i)
+		case ')':
+			idx.Pop()
+			if top, ok := idx.Peek(); ok {
+				if run := i - top; run > longest {
+					longest = run
+				}
+			} else {
+				idx.Push(i)
+			}
+		}
+	}
+	return longest
+}
@@ -0,0 +1,32 @@
+package brackets
+
+import "github.com/ghostmkg/dsa-code/go/structures/stack"
+
+// ScoreOfParentheses returns the score of a balanced parentheses string
+// s under the rules "()" = 1, AB = A + B (concatenation), and (A) = 2 *
+// A (nesting). It keeps a stack of partial scores: each '(' pushes a
+// fresh 0 for the scope it opens, and each ')' pops that scope's score,
+// converts it to 2*score (or 1 if the scope was empty), and folds it
+// into the now-current top of the stack.
+func ScoreOfParentheses(s string) int {
+	var scores stack.SliceStack[int]
+	scores.Push(0)
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			scores.Push(0)
+		case ')':
+			inner, _ := scores.Pop()
+			score := 1
+			if inner > 0 {
+				score = 2 * inner
+			}
+			top, _ := scores.Pop()
+			scores.Push(top + score)
+		}
+	}
+
+	total, _ := scores.Pop()
+	return total
+}
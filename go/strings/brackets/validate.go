@@ -0,0 +1,35 @@
+// Package brackets provides a small family of parenthesis/bracket
+// utilities - balance validation across multiple bracket types, minimum
+// insertions/removals to balance a "()"-only string, the length of the
+// longest valid "()" run, and Leetcode's score-of-parentheses - all
+// built on top of structures/stack.
+package brackets
+
+import "github.com/ghostmkg/dsa-code/go/structures/stack"
+
+var closingToOpening = map[byte]byte{
+	')': '(',
+	']': '[',
+	'}': '{',
+}
+
+// IsBalanced reports whether s is balanced with respect to the three
+// bracket types "()", "[]" and "{}". Characters that aren't brackets are
+// ignored, so callers can pass whole expressions rather than pre-filtered
+// bracket-only strings.
+func IsBalanced(s string) bool {
+	var st stack.SliceStack[byte]
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '(', '[', '{':
+			st.Push(c)
+		case ')', ']', '}':
+			top, ok := st.Pop()
+			if !ok || top != closingToOpening[c] {
+				return false
+			}
+		}
+	}
+	return st.Len() == 0
+}
@@ -0,0 +1,139 @@
+// Package docsim is an applied module built on top of strings/suffixarray:
+// given two documents, it finds the substrings they share, with the
+// position of each occurrence in both documents.
+//
+// It works by building a single generalized suffix array over both
+// documents (concatenated with a sentinel byte between them), then
+// walking the sorted suffixes left to right and, at every point where
+// the current suffix's document differs from the most recently seen
+// suffix of the other document, reading off the longest common prefix
+// between that pair via structures/rangequery's sparse table — the
+// standard technique for the two-string longest-common-substring
+// problem, since the best cross-document pair is always realized by
+// suffixes adjacent in sorted order once same-document runs are
+// collapsed.
+package docsim
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ghostmkg/dsa-code/go/strings/suffixarray"
+	"github.com/ghostmkg/dsa-code/go/structures/rangequery"
+)
+
+// sentinel separates the two documents inside the combined text handed
+// to the generalized suffix array. It must not occur in either
+// document.
+const sentinel = '\x00'
+
+// Match is a substring shared by both documents, together with where it
+// starts in each.
+type Match struct {
+	Text string
+	APos int
+	BPos int
+	Len  int
+}
+
+// Compare finds every maximal common substring of a and b at least
+// minLen long (minLen is raised to 1 if lower), longest first. It
+// returns an error if either document contains the sentinel byte 0x00.
+func Compare(a, b string, minLen int) ([]Match, error) {
+	if minLen < 1 {
+		minLen = 1
+	}
+	if strings.ContainsRune(a, sentinel) || strings.ContainsRune(b, sentinel) {
+		return nil, fmt.Errorf("docsim: documents must not contain the sentinel byte 0x00")
+	}
+
+	boundary := len(a)
+	combined := a + string(sentinel) + b
+	sa := suffixarray.New(combined)
+	arr := sa.Array()
+	lcpArr := sa.LCP()
+	if len(arr) == 0 {
+		return nil, nil
+	}
+
+	docOf := make([]byte, len(arr))
+	posOf := make([]int, len(arr))
+	valid := make([]bool, len(arr))
+	for i, start := range arr {
+		switch {
+		case start < boundary:
+			docOf[i], posOf[i], valid[i] = 'A', start, true
+		case start == boundary:
+			valid[i] = false
+		default:
+			docOf[i], posOf[i], valid[i] = 'B', start-boundary-1, true
+		}
+	}
+
+	rmq := rangequery.NewSparseTable(lcpArr, func(x, y int) int {
+		if x < y {
+			return x
+		}
+		return y
+	})
+
+	var matches []Match
+	lastA, lastB := -1, -1
+	for i := range arr {
+		if !valid[i] {
+			continue
+		}
+		var prev int
+		if docOf[i] == 'A' {
+			prev, lastA = lastB, i
+		} else {
+			prev, lastB = lastA, i
+		}
+		if prev == -1 {
+			continue
+		}
+
+		length := rmq.Query(prev+1, i)
+		if length < minLen {
+			continue
+		}
+		aIdx, bIdx := i, prev
+		if docOf[i] != 'A' {
+			aIdx, bIdx = prev, i
+		}
+		matches = append(matches, Match{
+			Text: a[posOf[aIdx] : posOf[aIdx]+length],
+			APos: posOf[aIdx],
+			BPos: posOf[bIdx],
+			Len:  length,
+		})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Len != matches[j].Len {
+			return matches[i].Len > matches[j].Len
+		}
+		if matches[i].APos != matches[j].APos {
+			return matches[i].APos < matches[j].APos
+		}
+		return matches[i].BPos < matches[j].BPos
+	})
+	return matches, nil
+}
+
+// LongestCommon returns every occurrence of the longest substring (or
+// substrings, if more than one distinct substring ties for longest)
+// shared by a and b.
+func LongestCommon(a, b string) ([]Match, error) {
+	matches, err := Compare(a, b, 1)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+	best := matches[0].Len
+	end := 1
+	for end < len(matches) && matches[end].Len == best {
+		end++
+	}
+	return matches[:end], nil
+}
@@ -0,0 +1,85 @@
+package docsim
+
+import "testing"
+
+func TestLongestCommonFindsSharedPassage(t *testing.T) {
+	a := "the quick brown fox jumps over the lazy dog"
+	b := "a quick brown fox leaps over a sleepy dog"
+
+	matches, err := LongestCommon(a, b)
+	if err != nil {
+		t.Fatalf("LongestCommon: %v", err)
+	}
+	if len(matches) == 0 {
+		t.Fatal("LongestCommon returned no matches")
+	}
+	want := " quick brown fox "
+	if matches[0].Text != want {
+		t.Errorf("Text = %q, want %q", matches[0].Text, want)
+	}
+	if a[matches[0].APos:matches[0].APos+matches[0].Len] != matches[0].Text {
+		t.Errorf("APos %d does not point at the reported text in a", matches[0].APos)
+	}
+	if b[matches[0].BPos:matches[0].BPos+matches[0].Len] != matches[0].Text {
+		t.Errorf("BPos %d does not point at the reported text in b", matches[0].BPos)
+	}
+}
+
+func TestCompareFiltersByMinLength(t *testing.T) {
+	a := "abcdefgh"
+	b := "xxabcyyfghzz"
+
+	matches, err := Compare(a, b, 3)
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	for _, m := range matches {
+		if m.Len < 3 {
+			t.Errorf("match %+v shorter than requested minLen 3", m)
+		}
+	}
+
+	all, err := Compare(a, b, 1)
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	if len(all) < len(matches) {
+		t.Errorf("lowering minLen produced fewer matches: %d < %d", len(all), len(matches))
+	}
+}
+
+func TestCompareNoOverlap(t *testing.T) {
+	matches, err := Compare("aaaa", "bbbb", 1)
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("Compare(\"aaaa\", \"bbbb\") = %v, want no matches", matches)
+	}
+}
+
+func TestCompareRejectsSentinelByte(t *testing.T) {
+	if _, err := Compare("has\x00sentinel", "b", 1); err == nil {
+		t.Error("Compare did not reject a document containing the sentinel byte")
+	}
+}
+
+func TestCompareEmptyDocuments(t *testing.T) {
+	matches, err := Compare("", "", 1)
+	if err != nil {
+		t.Fatalf("Compare: %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("Compare(\"\", \"\") = %v, want no matches", matches)
+	}
+}
+
+func TestLongestCommonIdenticalDocuments(t *testing.T) {
+	matches, err := LongestCommon("banana", "banana")
+	if err != nil {
+		t.Fatalf("LongestCommon: %v", err)
+	}
+	if len(matches) == 0 || matches[0].Text != "banana" {
+		t.Errorf("LongestCommon(\"banana\", \"banana\") = %v, want a full match", matches)
+	}
+}
@@ -0,0 +1,201 @@
+// Package glob implements shell-style wildcard matching: `*` matches
+// any run of characters (including none), `?` matches exactly one
+// character, and a bracketed class like `[abc]` or `[a-z]` matches any
+// one character in the set, with `[^...]` negating it. A literal `]`,
+// `^`, or `-` can appear first in a class to match itself without being
+// read as special.
+//
+// Match and MatchRecursive both implement the same semantics — an
+// iterative two-pointer scan and a recursive-with-memoization scan,
+// respectively — so tests can cross-check one against the other.
+// Compile parses a pattern once into a Matcher for repeated matching
+// against many texts without re-parsing it each time.
+package glob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnterminatedClass is returned when a pattern opens a `[` character
+// class but never closes it with a matching `]`.
+var ErrUnterminatedClass = errors.New("glob: unterminated character class")
+
+type tokenKind int
+
+const (
+	tokLiteral tokenKind = iota
+	tokAny               // ?
+	tokStar              // *
+	tokClass             // [...]
+)
+
+type token struct {
+	kind   tokenKind
+	ch     rune
+	set    map[rune]bool
+	negate bool
+}
+
+func (t token) matches(r rune) bool {
+	switch t.kind {
+	case tokLiteral:
+		return t.ch == r
+	case tokAny:
+		return true
+	case tokClass:
+		return t.set[r] != t.negate
+	default:
+		return false
+	}
+}
+
+// compile parses pattern into a token sequence, collapsing consecutive
+// `*` tokens into one since they're equivalent.
+func compile(pattern string) ([]token, error) {
+	runes := []rune(pattern)
+	var tokens []token
+
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '*':
+			if len(tokens) == 0 || tokens[len(tokens)-1].kind != tokStar {
+				tokens = append(tokens, token{kind: tokStar})
+			}
+		case '?':
+			tokens = append(tokens, token{kind: tokAny})
+		case '[':
+			end := i + 1
+			negate := end < len(runes) && runes[end] == '^'
+			if negate {
+				end++
+			}
+			start := end
+			for end < len(runes) && !(runes[end] == ']' && end > start) {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("%w: %q", ErrUnterminatedClass, pattern)
+			}
+			set := make(map[rune]bool)
+			for j := start; j < end; j++ {
+				if j+2 < end && runes[j+1] == '-' {
+					for r := runes[j]; r <= runes[j+2]; r++ {
+						set[r] = true
+					}
+					j += 2
+				} else {
+					set[runes[j]] = true
+				}
+			}
+			tokens = append(tokens, token{kind: tokClass, set: set, negate: negate})
+			i = end
+		default:
+			tokens = append(tokens, token{kind: tokLiteral, ch: runes[i]})
+		}
+	}
+	return tokens, nil
+}
+
+// Matcher is a pattern compiled once for repeated matching against many
+// texts, avoiding re-parsing the pattern on every call.
+type Matcher struct {
+	tokens []token
+}
+
+// Compile parses pattern into a Matcher, or returns ErrUnterminatedClass
+// if pattern has an unclosed `[`.
+func Compile(pattern string) (*Matcher, error) {
+	tokens, err := compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &Matcher{tokens: tokens}, nil
+}
+
+// Match reports whether text matches m's compiled pattern.
+func (m *Matcher) Match(text string) bool {
+	return matchIterative(m.tokens, []rune(text))
+}
+
+// Match reports whether text matches pattern, using an iterative
+// two-pointer scan with backtracking on `*`. It returns false, rather
+// than an error, if pattern is malformed — use Compile if you need to
+// distinguish "no match" from "invalid pattern".
+func Match(pattern, text string) bool {
+	tokens, err := compile(pattern)
+	if err != nil {
+		return false
+	}
+	return matchIterative(tokens, []rune(text))
+}
+
+// MatchRecursive reports whether text matches pattern using a top-down
+// recursive scan memoized on (token index, text index), equivalent to
+// Match but structured for easy cross-checking against it in tests
+// rather than for performance.
+func MatchRecursive(pattern, text string) bool {
+	tokens, err := compile(pattern)
+	if err != nil {
+		return false
+	}
+	r := []rune(text)
+	memo := make(map[[2]int]bool)
+	return matchRecursive(tokens, r, 0, 0, memo)
+}
+
+func matchRecursive(tokens []token, text []rune, ti, si int, memo map[[2]int]bool) bool {
+	if ti == len(tokens) {
+		return si == len(text)
+	}
+
+	key := [2]int{ti, si}
+	if v, ok := memo[key]; ok {
+		return v
+	}
+
+	var result bool
+	if tokens[ti].kind == tokStar {
+		result = matchRecursive(tokens, text, ti+1, si, memo)
+		if !result && si < len(text) {
+			result = matchRecursive(tokens, text, ti, si+1, memo)
+		}
+	} else if si < len(text) && tokens[ti].matches(text[si]) {
+		result = matchRecursive(tokens, text, ti+1, si+1, memo)
+	}
+
+	memo[key] = result
+	return result
+}
+
+// matchIterative is the classic wildcard two-pointer scan: on a
+// mismatch it backtracks to the most recent `*` and tries consuming one
+// more text character under it, remembering that position (starPos,
+// starText) so later mismatches resume from there instead of the
+// pattern's start.
+func matchIterative(tokens []token, text []rune) bool {
+	ti, si := 0, 0
+	starPos, starText := -1, -1
+
+	for si < len(text) {
+		switch {
+		case ti < len(tokens) && tokens[ti].kind == tokStar:
+			starPos, starText = ti, si
+			ti++
+		case ti < len(tokens) && tokens[ti].matches(text[si]):
+			ti++
+			si++
+		case starPos != -1:
+			ti = starPos + 1
+			starText++
+			si = starText
+		default:
+			return false
+		}
+	}
+
+	for ti < len(tokens) && tokens[ti].kind == tokStar {
+		ti++
+	}
+	return ti == len(tokens)
+}
@@ -0,0 +1,100 @@
+package glob
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern, text string
+		want          bool
+	}{
+		{"*", "anything", true},
+		{"*", "", true},
+		{"?", "a", true},
+		{"?", "", false},
+		{"?", "ab", false},
+		{"a*c", "abc", true},
+		{"a*c", "ac", true},
+		{"a*c", "abbbbc", true},
+		{"a*c", "abd", false},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"*a*b*", "xxaxxbxx", true},
+		{"*a*b*", "xxbxxaxx", false},
+		{"[abc]", "a", true},
+		{"[abc]", "d", false},
+		{"[a-z]", "m", true},
+		{"[a-z]", "M", false},
+		{"[^abc]", "d", true},
+		{"[^abc]", "a", false},
+		{"[0-9]*", "42 apples", true},
+		{"[0-9]*", "apples", false},
+		{"***", "anything", true},
+		{"literal", "literal", true},
+		{"literal", "literals", false},
+	}
+
+	for _, c := range cases {
+		if got := Match(c.pattern, c.text); got != c.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", c.pattern, c.text, got, c.want)
+		}
+	}
+}
+
+func TestMatchRecursiveAgreesWithMatch(t *testing.T) {
+	cases := []struct {
+		pattern, text string
+	}{
+		{"*", "anything"},
+		{"a*c", "abbbbc"},
+		{"a*c", "abd"},
+		{"*a*b*", "xxaxxbxx"},
+		{"[a-z]*[0-9]", "hello42"},
+		{"[a-z]*[0-9]", "HELLO42"},
+		{"?a?", "bac"},
+		{"", ""},
+		{"", "x"},
+	}
+	for _, c := range cases {
+		want := Match(c.pattern, c.text)
+		if got := MatchRecursive(c.pattern, c.text); got != want {
+			t.Errorf("MatchRecursive(%q, %q) = %v, want %v (from Match)", c.pattern, c.text, got, want)
+		}
+	}
+}
+
+func TestUnterminatedClass(t *testing.T) {
+	if _, err := Compile("[abc"); err == nil {
+		t.Fatalf("Compile(%q) = nil error, want ErrUnterminatedClass", "[abc")
+	}
+	if Match("[abc", "a") {
+		t.Errorf("Match with unterminated class should report false, not match")
+	}
+}
+
+func TestMatcherReuse(t *testing.T) {
+	m, err := Compile("*.go")
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	tests := map[string]bool{
+		"main.go":  true,
+		"main.py":  false,
+		"":         false,
+		"x.go":     true,
+		"dir/x.go": true,
+	}
+	for text, want := range tests {
+		if got := m.Match(text); got != want {
+			t.Errorf("Matcher.Match(%q) = %v, want %v", text, got, want)
+		}
+	}
+}
+
+func TestLiteralBracketAndDashInClass(t *testing.T) {
+	if !Match("[]ab]", "]") {
+		t.Errorf(`Match("[]ab]", "]") = false, want true`)
+	}
+	if !Match("[a-]", "-") {
+		t.Errorf(`Match("[a-]", "-") = false, want true`)
+	}
+}
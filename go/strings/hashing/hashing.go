@@ -0,0 +1,64 @@
+// Package hashing implements a reusable polynomial rolling hash over
+// byte strings. Every hash is double-hashed — two independent
+// (base, modulus) pairs computed together and compared as a pair — so
+// that two different substrings colliding under both hashes at once is
+// astronomically less likely than colliding under either alone, which
+// matters here because, unlike strings/rabinkarp, callers of Table.Equal
+// have no cheap way to double-check a hash match with a direct byte
+// comparison (that's the whole point of getting an O(1) answer).
+//
+// Table builds a prefix-hash table over a fixed string once, in O(n),
+// and then answers the hash of any substring in O(1), which Equal and
+// Period build on for substring comparison and period-finding without
+// ever re-scanning the string they were built from.
+package hashing
+
+// Config holds the two independent polynomial-hash parameter sets a
+// double hash is computed under.
+type Config struct {
+	Base1, Modulus1 uint64
+	Base2, Modulus2 uint64
+}
+
+// DefaultConfig returns a fixed, reasonable Config: two different bases
+// and two different large prime moduli, chosen so their products never
+// overflow a uint64 during rolling-hash arithmetic.
+func DefaultConfig() Config {
+	return Config{
+		Base1: 131, Modulus1: 1_000_000_007,
+		Base2: 137, Modulus2: 998_244_353,
+	}
+}
+
+// Hash is a double polynomial hash value. Two Hashes are equal only if
+// both of their underlying single hashes match.
+type Hash struct {
+	H1, H2 uint64
+}
+
+// Power computes base^exp mod modulus via fast exponentiation. It's
+// exported because it's the one piece of rolling-hash arithmetic other
+// packages (e.g. strings/rabinkarp) that still maintain their own
+// simpler, single-hash rolling window have no reason to duplicate.
+func Power(base, exp, modulus uint64) uint64 {
+	result := uint64(1)
+	base %= modulus
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result * base % modulus
+		}
+		exp >>= 1
+		base = base * base % modulus
+	}
+	return result
+}
+
+// HashString computes the double hash of all of s under cfg.
+func HashString(s string, cfg Config) Hash {
+	var h1, h2 uint64
+	for i := 0; i < len(s); i++ {
+		h1 = (h1*cfg.Base1 + uint64(s[i])) % cfg.Modulus1
+		h2 = (h2*cfg.Base2 + uint64(s[i])) % cfg.Modulus2
+	}
+	return Hash{h1, h2}
+}
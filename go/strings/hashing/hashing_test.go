@@ -0,0 +1,108 @@
+package hashing
+
+import "testing"
+
+func TestPowerMatchesRepeatedMultiplication(t *testing.T) {
+	const modulus = 1_000_000_007
+	got := Power(131, 10, modulus)
+
+	want := uint64(1)
+	for i := 0; i < 10; i++ {
+		want = want * 131 % modulus
+	}
+	if got != want {
+		t.Errorf("Power(131, 10, modulus) = %d, want %d", got, want)
+	}
+}
+
+func TestHashStringDeterministic(t *testing.T) {
+	cfg := DefaultConfig()
+	if HashString("hello", cfg) != HashString("hello", cfg) {
+		t.Error("HashString is not deterministic for the same input and config")
+	}
+	if HashString("hello", cfg) == HashString("world", cfg) {
+		t.Error("HashString produced the same hash for different strings")
+	}
+}
+
+func bruteForceEqual(s string, i1, j1, i2, j2 int) bool {
+	return s[i1:j1] == s[i2:j2]
+}
+
+func TestTableHashMatchesHashString(t *testing.T) {
+	s := "abracadabra"
+	cfg := DefaultConfig()
+	table := NewTable(s, cfg)
+
+	for i := 0; i <= len(s); i++ {
+		for j := i; j <= len(s); j++ {
+			got := table.Hash(i, j)
+			want := HashString(s[i:j], cfg)
+			if got != want {
+				t.Errorf("Hash(%d, %d) = %+v, want %+v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestTableEqualAgainstBruteForce(t *testing.T) {
+	s := "abracadabra"
+	table := NewTable(s, DefaultConfig())
+
+	for i1 := 0; i1 <= len(s); i1++ {
+		for j1 := i1; j1 <= len(s); j1++ {
+			for i2 := 0; i2+(j1-i1) <= len(s); i2++ {
+				j2 := i2 + (j1 - i1)
+				got := table.Equal(i1, j1, i2, j2)
+				want := bruteForceEqual(s, i1, j1, i2, j2)
+				if got != want {
+					t.Errorf("Equal(%d,%d,%d,%d) = %v, want %v", i1, j1, i2, j2, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTableEqualPanicsOnMismatchedLengths(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Equal did not panic for ranges of different lengths")
+		}
+	}()
+	table := NewTable("abcdef", DefaultConfig())
+	table.Equal(0, 2, 0, 3)
+}
+
+func bruteForcePeriod(s string) int {
+	n := len(s)
+	for p := 1; p < n; p++ {
+		if s[:n-p] == s[p:] {
+			return p
+		}
+	}
+	return n
+}
+
+func TestPeriodAgainstBruteForce(t *testing.T) {
+	cases := []string{"abcabcabc", "aaaaa", "abcd", "ababab", "a", ""}
+	for _, s := range cases {
+		got := Period(s, DefaultConfig())
+		want := bruteForcePeriod(s)
+		if got != want {
+			t.Errorf("Period(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestRandomConfigDeterministicForSameSeed(t *testing.T) {
+	if RandomConfig(42) != RandomConfig(42) {
+		t.Error("RandomConfig(42) produced different Configs across calls")
+	}
+}
+
+func TestRandomConfigVariesByBases(t *testing.T) {
+	a, b := RandomConfig(1), RandomConfig(2)
+	if a.Base1 == b.Base1 && a.Base2 == b.Base2 && a.Modulus1 == b.Modulus1 && a.Modulus2 == b.Modulus2 {
+		t.Error("RandomConfig(1) and RandomConfig(2) produced identical Configs")
+	}
+}
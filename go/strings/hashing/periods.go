@@ -0,0 +1,19 @@
+package hashing
+
+// Period returns the length of the shortest period of s: the smallest p
+// such that s[i] == s[i+p] for every valid i. A string with no repeating
+// structure has period len(s). Period runs in O(len(s)) candidate checks,
+// each an O(1) substring comparison via a Table built over s.
+func Period(s string, cfg Config) int {
+	n := len(s)
+	if n == 0 {
+		return 0
+	}
+	t := NewTable(s, cfg)
+	for p := 1; p < n; p++ {
+		if t.Equal(0, n-p, p, n) {
+			return p
+		}
+	}
+	return n
+}
@@ -0,0 +1,45 @@
+package hashing
+
+import "math/rand"
+
+// largePrimes are candidate moduli for RandomConfig, all comfortably
+// below 2^32 so products of two moduli-sized values never overflow
+// uint64 during rolling-hash arithmetic.
+var largePrimes = []uint64{1_000_000_007, 1_000_000_009, 998_244_353, 999_999_937}
+
+// RandomConfig returns a Config with randomized bases and moduli, seeded
+// deterministically from seed. Use this (rather than the package-level
+// randomized default below) whenever a test or caller needs reproducible
+// behavior across runs.
+func RandomConfig(seed int64) Config {
+	rng := rand.New(rand.NewSource(seed))
+	return randomConfig(rng)
+}
+
+func randomConfig(rng *rand.Rand) Config {
+	m1 := largePrimes[rng.Intn(len(largePrimes))]
+	m2 := largePrimes[rng.Intn(len(largePrimes))]
+	for m2 == m1 {
+		m2 = largePrimes[rng.Intn(len(largePrimes))]
+	}
+	// Keep each base comfortably inside [256, 65536) and odd, so it
+	// behaves like a generic polynomial weight rather than degenerating
+	// toward small cycles.
+	base1 := uint64(rng.Intn(1<<15))*2 + 257
+	base2 := uint64(rng.Intn(1<<15))*2 + 257
+	return Config{Base1: base1, Modulus1: m1, Base2: base2, Modulus2: m2}
+}
+
+// defaultRandomConfig is seeded once at init time from a source with no
+// fixed seed, giving every process a different pair of hash parameter
+// sets without callers having to manage a seed themselves. It is NOT
+// deterministic across runs or processes — tests and anything else that
+// needs reproducible hashing should call RandomConfig(seed) instead.
+var defaultRandomConfig = randomConfig(rand.New(rand.NewSource(rand.Int63())))
+
+// DefaultRandomConfig returns the process-wide Config randomized at init
+// time. See the defaultRandomConfig doc comment for its determinism
+// caveat.
+func DefaultRandomConfig() Config {
+	return defaultRandomConfig
+}
@@ -0,0 +1,54 @@
+package hashing
+
+// Table is a prefix-hash table over a fixed string s, giving the double
+// hash of any substring of s in O(1) once built.
+type Table struct {
+	cfg              Config
+	prefix1, prefix2 []uint64 // prefix1[i] is HashString(s[:i], cfg).H1, etc.
+	pow1, pow2       []uint64 // pow1[i] is cfg.Base1^i mod cfg.Modulus1, etc.
+}
+
+// NewTable builds a Table over s under cfg in O(len(s)).
+func NewTable(s string, cfg Config) *Table {
+	n := len(s)
+	t := &Table{
+		cfg:     cfg,
+		prefix1: make([]uint64, n+1),
+		prefix2: make([]uint64, n+1),
+		pow1:    make([]uint64, n+1),
+		pow2:    make([]uint64, n+1),
+	}
+	t.pow1[0], t.pow2[0] = 1, 1
+	for i := 0; i < n; i++ {
+		t.prefix1[i+1] = (t.prefix1[i]*cfg.Base1 + uint64(s[i])) % cfg.Modulus1
+		t.prefix2[i+1] = (t.prefix2[i]*cfg.Base2 + uint64(s[i])) % cfg.Modulus2
+		t.pow1[i+1] = t.pow1[i] * cfg.Base1 % cfg.Modulus1
+		t.pow2[i+1] = t.pow2[i] * cfg.Base2 % cfg.Modulus2
+	}
+	return t
+}
+
+// Len returns the length of the string the table was built over.
+func (t *Table) Len() int {
+	return len(t.prefix1) - 1
+}
+
+// Hash returns the double hash of s[i:j] (the same substring bounds
+// Go's slicing uses) in O(1).
+func (t *Table) Hash(i, j int) Hash {
+	h1 := (t.prefix1[j] + t.cfg.Modulus1 - t.prefix1[i]*t.pow1[j-i]%t.cfg.Modulus1) % t.cfg.Modulus1
+	h2 := (t.prefix2[j] + t.cfg.Modulus2 - t.prefix2[i]*t.pow2[j-i]%t.cfg.Modulus2) % t.cfg.Modulus2
+	return Hash{h1, h2}
+}
+
+// Equal reports whether s[i1:j1] and s[i2:j2] are the same substring,
+// assuming no double-hash collision. The two ranges must have equal
+// length; Equal panics if they don't, since substrings of different
+// lengths can never be equal and comparing their hashes wouldn't mean
+// what a caller expects.
+func (t *Table) Equal(i1, j1, i2, j2 int) bool {
+	if j1-i1 != j2-i2 {
+		panic("hashing: Equal called with ranges of different lengths")
+	}
+	return t.Hash(i1, j1) == t.Hash(i2, j2)
+}
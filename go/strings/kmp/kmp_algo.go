@@ -0,0 +1,97 @@
+package kmp
+
+import (
+	"fmt"
+	"sync"
+)
+
+// lpsPool reuses the scratch LPS buffer FindAllAppend builds and discards
+// on every call, so repeated searches don't each allocate their own.
+var lpsPool = sync.Pool{
+	New: func() any { return make([]int, 0, 64) },
+}
+
+// buildLPSInto fills the LPS (Longest Prefix Suffix) array for pattern into
+// dst, reusing its backing array when it's already big enough, and returns
+// the (possibly reallocated) result.
+func buildLPSInto(dst []int, pattern string) []int {
+	m := len(pattern)
+	if cap(dst) < m {
+		dst = make([]int, m)
+	} else {
+		dst = dst[:m]
+	}
+	length := 0
+	i := 1
+
+	for i < m {
+		if pattern[i] == pattern[length] {
+			length++
+			dst[i] = length
+			i++
+		} else {
+			if length != 0 {
+				length = dst[length-1]
+			} else {
+				dst[i] = 0
+				i++
+			}
+		}
+	}
+	return dst
+}
+
+// buildLPS computes the LPS array for pattern in a freshly allocated slice.
+func buildLPS(pattern string) []int {
+	return buildLPSInto(make([]int, len(pattern)), pattern)
+}
+
+// FindAllAppend is FindAll but appends matches to dst instead of allocating
+// its own result slice, so callers in a hot loop can reuse one buffer
+// across calls (resetting it with dst[:0] between calls).
+func FindAllAppend(dst []int, text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return dst
+	}
+
+	lps := lpsPool.Get().([]int)
+	lps = buildLPSInto(lps, pattern)
+	defer func() {
+		lpsPool.Put(lps[:0])
+	}()
+
+	i, j := 0, 0 // i -> text, j -> pattern
+
+	for i < n {
+		if text[i] == pattern[j] {
+			i++
+			j++
+		}
+
+		if j == m {
+			dst = append(dst, i-j)
+			j = lps[j-1] // Continue searching
+		} else if i < n && text[i] != pattern[j] {
+			if j != 0 {
+				j = lps[j-1]
+			} else {
+				i++
+			}
+		}
+	}
+	return dst
+}
+
+// FindAll returns the starting index of every (possibly overlapping)
+// occurrence of pattern in text, in ascending order.
+func FindAll(text, pattern string) []int {
+	return FindAllAppend(nil, text, pattern)
+}
+
+// KMP search algorithm
+func KMPSearch(text, pattern string) {
+	for _, idx := range FindAll(text, pattern) {
+		fmt.Printf("Pattern found at index %d\n", idx)
+	}
+}
@@ -0,0 +1,65 @@
+package kmp
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureOutput redirects stdout for the duration of fn and returns what was
+// written to it, so print-based functions like KMPSearch can be asserted on.
+func captureOutput(t *testing.T, fn func()) string {
+	t.Helper()
+
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+
+	fn()
+
+	w.Close()
+	os.Stdout = orig
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("io.Copy: %v", err)
+	}
+	return buf.String()
+}
+
+func TestKMPSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		pattern string
+		want    []string
+	}{
+		{"pattern found once", "abxabcabcaby", "abcaby", []string{"6"}},
+		{"pattern not found", "abcdef", "xyz", nil},
+		{"pattern found multiple times", "aaaa", "aa", []string{"0", "1", "2"}},
+		{"pattern is whole text", "hello", "hello", []string{"0"}},
+		{"unicode text", "héllo wörld", "wörld", []string{"7"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureOutput(t, func() {
+				KMPSearch(tt.text, tt.pattern)
+			})
+
+			for _, idx := range tt.want {
+				if !strings.Contains(out, "index "+idx) {
+					t.Errorf("KMPSearch(%q, %q) output %q missing expected index %s", tt.text, tt.pattern, out, idx)
+				}
+			}
+			if len(tt.want) == 0 && out != "" {
+				t.Errorf("KMPSearch(%q, %q) output %q, want no match", tt.text, tt.pattern, out)
+			}
+		})
+	}
+}
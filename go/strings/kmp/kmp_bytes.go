@@ -0,0 +1,105 @@
+package kmp
+
+// buildLPSBytesInto is buildLPSInto generalized to a []byte pattern, so
+// SearchBytes and SearchFirstBytes never need a string conversion.
+func buildLPSBytesInto(dst []int, pattern []byte) []int {
+	m := len(pattern)
+	if cap(dst) < m {
+		dst = make([]int, m)
+	} else {
+		dst = dst[:m]
+	}
+	length := 0
+	i := 1
+
+	for i < m {
+		if pattern[i] == pattern[length] {
+			length++
+			dst[i] = length
+			i++
+		} else {
+			if length != 0 {
+				length = dst[length-1]
+			} else {
+				dst[i] = 0
+				i++
+			}
+		}
+	}
+	return dst
+}
+
+// SearchBytes is FindAllAppend for []byte: it returns the starting index
+// of every (possibly overlapping) occurrence of pattern in text, in
+// ascending order, appending to dst instead of allocating its own result
+// slice so a caller in a hot path (e.g. parsing network buffers) can
+// reuse one buffer across calls by resetting it with dst[:0]. Neither
+// text nor pattern is converted to string.
+func SearchBytes(dst []int, text, pattern []byte) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return dst
+	}
+
+	lps := lpsPool.Get().([]int)
+	lps = buildLPSBytesInto(lps, pattern)
+	defer func() {
+		lpsPool.Put(lps[:0])
+	}()
+
+	i, j := 0, 0 // i -> text, j -> pattern
+
+	for i < n {
+		if text[i] == pattern[j] {
+			i++
+			j++
+		}
+
+		if j == m {
+			dst = append(dst, i-j)
+			j = lps[j-1]
+		} else if i < n && text[i] != pattern[j] {
+			if j != 0 {
+				j = lps[j-1]
+			} else {
+				i++
+			}
+		}
+	}
+	return dst
+}
+
+// SearchFirstBytes returns the starting index of the first occurrence of
+// pattern in text, or -1 if it doesn't occur. Like SearchBytes, it never
+// converts its arguments to string.
+func SearchFirstBytes(text, pattern []byte) int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return -1
+	}
+
+	lps := lpsPool.Get().([]int)
+	lps = buildLPSBytesInto(lps, pattern)
+	defer func() {
+		lpsPool.Put(lps[:0])
+	}()
+
+	i, j := 0, 0
+	for i < n {
+		if text[i] == pattern[j] {
+			i++
+			j++
+		}
+
+		if j == m {
+			return i - j
+		} else if i < n && text[i] != pattern[j] {
+			if j != 0 {
+				j = lps[j-1]
+			} else {
+				i++
+			}
+		}
+	}
+	return -1
+}
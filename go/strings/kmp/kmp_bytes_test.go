@@ -0,0 +1,83 @@
+package kmp
+
+import "testing"
+
+func TestSearchBytes(t *testing.T) {
+	cases := []struct {
+		name          string
+		text, pattern string
+		want          []int
+	}{
+		{"basic", "abxabcabcaby", "abc", []int{3, 6}},
+		{"no match", "hello", "xyz", nil},
+		{"empty pattern", "hello", "", nil},
+		{"overlapping", "aaaa", "aa", []int{0, 1, 2}},
+		{"whole text", "abc", "abc", []int{0}},
+		{"empty text", "", "a", nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := SearchBytes(nil, []byte(c.text), []byte(c.pattern))
+			if !equalInts(got, c.want) {
+				t.Fatalf("SearchBytes(%q, %q) = %v, want %v", c.text, c.pattern, got, c.want)
+			}
+		})
+	}
+}
+
+func TestSearchBytesReusesBuffer(t *testing.T) {
+	buf := make([]int, 0, 4)
+	buf = SearchBytes(buf, []byte("abcabc"), []byte("abc"))
+	if !equalInts(buf, []int{0, 3}) {
+		t.Fatalf("first call = %v", buf)
+	}
+	buf = SearchBytes(buf[:0], []byte("xx"), []byte("x"))
+	if !equalInts(buf, []int{0, 1}) {
+		t.Fatalf("second call = %v", buf)
+	}
+}
+
+func TestSearchFirstBytes(t *testing.T) {
+	cases := []struct {
+		name          string
+		text, pattern string
+		want          int
+	}{
+		{"basic", "abxabcabcaby", "abc", 3},
+		{"no match", "hello", "xyz", -1},
+		{"empty pattern", "hello", "", -1},
+		{"match at start", "abc", "abc", 0},
+		{"empty text", "", "a", -1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := SearchFirstBytes([]byte(c.text), []byte(c.pattern))
+			if got != c.want {
+				t.Fatalf("SearchFirstBytes(%q, %q) = %d, want %d", c.text, c.pattern, got, c.want)
+			}
+		})
+	}
+}
+
+func TestSearchBytesMatchesFindAll(t *testing.T) {
+	text, pattern := "abcabcabcxyzabc", "abc"
+	want := FindAll(text, pattern)
+	got := SearchBytes(nil, []byte(text), []byte(pattern))
+	if !equalInts(got, want) {
+		t.Fatalf("SearchBytes = %v, FindAll = %v", got, want)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,10 @@
+package kmp
+
+func ExampleKMPSearch() {
+	text := "ABABDABACDABABCABAB"
+	pattern := "ABABCABAB"
+
+	KMPSearch(text, pattern)
+	// Output:
+	// Pattern found at index 10
+}
@@ -0,0 +1,59 @@
+package kmp
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// bruteForceFindAll is a deliberately naive reference implementation used
+// to check FindAll against, independent of the KMP algorithm itself.
+func bruteForceFindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	var matches []int
+	for i := 0; i+m <= n; i++ {
+		if text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func FuzzFindAll(f *testing.F) {
+	seeds := []struct {
+		text, pattern string
+	}{
+		{"", ""},
+		{"abc", ""},
+		{"", "abc"},
+		{"abcabcabc", "abc"},
+		{"aaaaaa", "aa"},
+		{"hello world", "world"},
+		{"mississippi", "issi"},
+	}
+	for _, s := range seeds {
+		f.Add(s.text, s.pattern)
+	}
+
+	f.Fuzz(func(t *testing.T, text, pattern string) {
+		got := FindAll(text, pattern)
+		want := bruteForceFindAll(text, pattern)
+		if !slices.Equal(got, want) {
+			t.Fatalf("FindAll(%q, %q) = %v, want %v (brute force)", text, pattern, got, want)
+		}
+
+		if pattern != "" {
+			wantFirst := strings.Index(text, pattern)
+			gotFirst := -1
+			if len(got) > 0 {
+				gotFirst = got[0]
+			}
+			if wantFirst != gotFirst {
+				t.Fatalf("FindAll(%q, %q) first match = %d, want %d (strings.Index)", text, pattern, gotFirst, wantFirst)
+			}
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package kmp
+
+import "strings"
+
+// ReplaceAll returns a copy of text with every non-overlapping occurrence of
+// pattern replaced by replacement, scanning left to right and skipping past
+// a replaced occurrence before looking for the next one (matching the
+// behavior of strings.ReplaceAll). It reuses FindAll's LPS-based matching,
+// so it runs in O(len(text)+len(pattern)) time plus the cost of building
+// the result.
+func ReplaceAll(text, pattern, replacement string) string {
+	return replace(text, pattern, replacement, -1, false)
+}
+
+// ReplaceAllOverlapping is ReplaceAll but does not skip past a replaced
+// occurrence, so a later occurrence that overlaps an earlier one is
+// replaced too. For example, replacing "aa" with "b" in "aaa" yields "bb"
+// (both the occurrence at index 0 and the one at index 1 are replaced,
+// their overlap collapsing into adjacent replacement text) rather than
+// ReplaceAll's "ba" (only the non-overlapping occurrence at index 0).
+func ReplaceAllOverlapping(text, pattern, replacement string) string {
+	return replace(text, pattern, replacement, -1, true)
+}
+
+// ReplaceN is ReplaceAll but stops after replacing at most n non-overlapping
+// occurrences. A negative n replaces every occurrence, same as ReplaceAll.
+func ReplaceN(text, pattern, replacement string, n int) string {
+	return replace(text, pattern, replacement, n, false)
+}
+
+// replace is the shared implementation behind ReplaceAll, ReplaceN, and
+// ReplaceAllOverlapping. It walks FindAll's matches once, skipping a match
+// that starts inside the span already covered by a prior replacement
+// unless overlapping is set, and stops early once n replacements have been
+// made (n < 0 means unbounded).
+func replace(text, pattern, replacement string, n int, overlapping bool) string {
+	if pattern == "" {
+		return text
+	}
+	matches := FindAll(text, pattern)
+	patternLen := len(pattern)
+
+	var b strings.Builder
+	b.Grow(len(text))
+	last := 0
+	count := 0
+	for _, m := range matches {
+		if n >= 0 && count >= n {
+			break
+		}
+		if m < last && !overlapping {
+			continue
+		}
+		if m >= last {
+			b.WriteString(text[last:m])
+		}
+		b.WriteString(replacement)
+		if end := m + patternLen; end > last {
+			last = end
+		}
+		count++
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
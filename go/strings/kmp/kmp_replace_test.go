@@ -0,0 +1,73 @@
+package kmp
+
+import "testing"
+
+func TestReplaceAll(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		pattern     string
+		replacement string
+		want        string
+	}{
+		{"basic", "the cat sat on the mat", "at", "og", "the cog sog on the mog"},
+		{"no match", "hello world", "xyz", "-", "hello world"},
+		{"empty pattern", "hello", "", "-", "hello"},
+		{"overlapping skipped", "aaaa", "aa", "b", "bb"},
+		{"overlapping occurrence not revisited", "aaa", "aa", "b", "ba"},
+		{"whole string", "aaa", "aaa", "b", "b"},
+		{"empty text", "", "a", "b", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReplaceAll(tt.text, tt.pattern, tt.replacement); got != tt.want {
+				t.Errorf("ReplaceAll(%q, %q, %q) = %q, want %q", tt.text, tt.pattern, tt.replacement, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceAllOverlapping(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		pattern     string
+		replacement string
+		want        string
+	}{
+		{"overlapping replaced", "aaa", "aa", "b", "bb"},
+		{"no overlap still works like ReplaceAll", "the cat sat", "at", "og", "the cog sog"},
+		{"no match", "hello", "xyz", "-", "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReplaceAllOverlapping(tt.text, tt.pattern, tt.replacement); got != tt.want {
+				t.Errorf("ReplaceAllOverlapping(%q, %q, %q) = %q, want %q", tt.text, tt.pattern, tt.replacement, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceN(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		pattern     string
+		replacement string
+		n           int
+		want        string
+	}{
+		{"replace first only", "aaaa", "a", "b", 1, "baaa"},
+		{"replace first two", "aaaa", "a", "b", 2, "bbaa"},
+		{"n larger than matches", "aaaa", "a", "b", 10, "bbbb"},
+		{"negative n replaces all", "aaaa", "a", "b", -1, "bbbb"},
+		{"zero n replaces nothing", "aaaa", "a", "b", 0, "aaaa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReplaceN(tt.text, tt.pattern, tt.replacement, tt.n); got != tt.want {
+				t.Errorf("ReplaceN(%q, %q, %q, %d) = %q, want %q", tt.text, tt.pattern, tt.replacement, tt.n, got, tt.want)
+			}
+		})
+	}
+}
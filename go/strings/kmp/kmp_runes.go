@@ -0,0 +1,94 @@
+package kmp
+
+import "unicode"
+
+// buildLPSRunes is buildLPS generalized to a []rune pattern, for search
+// over text where multi-byte UTF-8 characters must count as one
+// position rather than one byte per code point.
+func buildLPSRunes(pattern []rune) []int {
+	m := len(pattern)
+	lps := make([]int, m)
+	length := 0
+	i := 1
+
+	for i < m {
+		if pattern[i] == pattern[length] {
+			length++
+			lps[i] = length
+			i++
+		} else if length != 0 {
+			length = lps[length-1]
+		} else {
+			lps[i] = 0
+			i++
+		}
+	}
+	return lps
+}
+
+// FindAllRunes returns the starting rune index (not byte offset) of
+// every occurrence of pattern in text. Unlike FindAll, which indexes
+// bytes and can report an offset that splits a multi-byte UTF-8
+// character or miss a match whose pattern and text characters are
+// encoded with a different number of bytes apart, this decodes both
+// strings to []rune first so every index and comparison is in terms of
+// whole characters.
+func FindAllRunes(text, pattern string) []int {
+	return findAllRunes([]rune(text), []rune(pattern), false)
+}
+
+// FindAllRunesFold is FindAllRunes but compares characters under simple
+// Unicode case folding, so e.g. "Straße" and "STRASSE" are not
+// treated as a match (Go's case folding is per-rune, not the full
+// German ß->ss expansion) but "CAFÉ" and "café" are.
+func FindAllRunesFold(text, pattern string) []int {
+	return findAllRunes([]rune(text), []rune(pattern), true)
+}
+
+func findAllRunes(text, pattern []rune, fold bool) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+
+	p := pattern
+	if fold {
+		p = foldRunes(pattern)
+	}
+	lps := buildLPSRunes(p)
+
+	equal := func(a, b rune) bool {
+		if fold {
+			return unicode.ToLower(a) == b
+		}
+		return a == b
+	}
+
+	var matches []int
+	i, j := 0, 0
+	for i < n {
+		if equal(text[i], p[j]) {
+			i++
+			j++
+		}
+		if j == m {
+			matches = append(matches, i-j)
+			j = lps[j-1]
+		} else if i < n && !equal(text[i], p[j]) {
+			if j != 0 {
+				j = lps[j-1]
+			} else {
+				i++
+			}
+		}
+	}
+	return matches
+}
+
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = unicode.ToLower(r)
+	}
+	return folded
+}
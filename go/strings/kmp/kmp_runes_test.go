@@ -0,0 +1,57 @@
+package kmp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindAllRunesMultiByte(t *testing.T) {
+	text := "héllo wörld héllo"
+	pattern := "héllo"
+
+	got := FindAllRunes(text, pattern)
+	want := []int{0, 12}
+	if !slices.Equal(got, want) {
+		t.Errorf("FindAllRunes(%q, %q) = %v, want %v", text, pattern, got, want)
+	}
+}
+
+func TestFindAllRunesVsFindAllByteOffsetMismatch(t *testing.T) {
+	// "wörld" starts at byte offset 7 (ö is 2 bytes) but rune offset 6.
+	text := "héllo wörld"
+	pattern := "wörld"
+
+	byteMatches := FindAll(text, pattern)
+	runeMatches := FindAllRunes(text, pattern)
+
+	if !slices.Equal(byteMatches, []int{7}) {
+		t.Fatalf("FindAll(%q, %q) = %v, want [7]", text, pattern, byteMatches)
+	}
+	if !slices.Equal(runeMatches, []int{6}) {
+		t.Errorf("FindAllRunes(%q, %q) = %v, want [6]", text, pattern, runeMatches)
+	}
+}
+
+func TestFindAllRunesFold(t *testing.T) {
+	text := "The CAFÉ is nice, the café too"
+	pattern := "café"
+
+	got := FindAllRunesFold(text, pattern)
+	want := []int{4, 22}
+	if !slices.Equal(got, want) {
+		t.Errorf("FindAllRunesFold(%q, %q) = %v, want %v", text, pattern, got, want)
+	}
+
+	if got := FindAllRunes(text, pattern); !slices.Equal(got, []int{22}) {
+		t.Errorf("FindAllRunes(%q, %q) (case-sensitive) = %v, want only the exact-case match at 22", text, pattern, got)
+	}
+}
+
+func TestFindAllRunesEmptyOrTooLong(t *testing.T) {
+	if got := FindAllRunes("abc", ""); got != nil {
+		t.Errorf("FindAllRunes with empty pattern = %v, want nil", got)
+	}
+	if got := FindAllRunes("ab", "abc"); got != nil {
+		t.Errorf("FindAllRunes with pattern longer than text = %v, want nil", got)
+	}
+}
@@ -0,0 +1,90 @@
+package kmp
+
+import "io"
+
+// streamBufSize is the chunk size SearchReader and friends read at a
+// time; it only affects throughput, not correctness, since the KMP
+// match state (j below) carries across reads so matches spanning a
+// chunk boundary are never missed.
+const streamBufSize = 64 * 1024
+
+// SearchReaderFunc scans r for pattern without loading it into memory,
+// calling onMatch with the byte offset of every match as it's found.
+// onMatch should return false to stop the scan early; SearchReaderFunc
+// then returns immediately, before reading any more of r.
+//
+// This is the building block SearchReader and SearchReaderChan are
+// built on, for callers who want to react to matches as they stream by
+// rather than collect them all first.
+func SearchReaderFunc(r io.Reader, pattern string, onMatch func(offset int64) bool) error {
+	m := len(pattern)
+	if m == 0 {
+		return nil
+	}
+
+	lps := buildLPS(pattern)
+	buf := make([]byte, streamBufSize)
+	var offset int64
+	j := 0
+
+	for {
+		n, err := r.Read(buf)
+		for i := 0; i < n; i++ {
+			for j > 0 && buf[i] != pattern[j] {
+				j = lps[j-1]
+			}
+			if buf[i] == pattern[j] {
+				j++
+			}
+			if j == m {
+				if !onMatch(offset + int64(i) - int64(m) + 1) {
+					return nil
+				}
+				j = lps[j-1]
+			}
+		}
+		offset += int64(n)
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// SearchReader returns the byte offset of every (possibly overlapping)
+// occurrence of pattern in r, reading r in fixed-size chunks so it can
+// scan an arbitrarily large stream in bounded memory.
+func SearchReader(r io.Reader, pattern string) ([]int64, error) {
+	var matches []int64
+	err := SearchReaderFunc(r, pattern, func(offset int64) bool {
+		matches = append(matches, offset)
+		return true
+	})
+	return matches, err
+}
+
+// SearchReaderChan scans r for pattern on a background goroutine,
+// sending each match offset to the returned channel as it's found and
+// closing it when the scan ends. Any read error is sent to the second
+// channel, which is then closed; a nil error is still sent (and the
+// channel closed) on a clean end of stream, so callers can range over
+// matches and then check err without blocking.
+func SearchReaderChan(r io.Reader, pattern string) (<-chan int64, <-chan error) {
+	matches := make(chan int64)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(matches)
+		defer close(errc)
+		err := SearchReaderFunc(r, pattern, func(offset int64) bool {
+			matches <- offset
+			return true
+		})
+		errc <- err
+	}()
+
+	return matches, errc
+}
@@ -0,0 +1,135 @@
+package kmp
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestSearchReaderMatchesFindAll(t *testing.T) {
+	text := "ABABDABACDABABCABAB"
+	pattern := "ABABCABAB"
+
+	want := FindAll(text, pattern)
+	got, err := SearchReader(strings.NewReader(text), pattern)
+	if err != nil {
+		t.Fatalf("SearchReader() error = %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SearchReader() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != int64(v) {
+			t.Errorf("SearchReader()[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+// chunkReader forces Read to return at most n bytes at a time, so tests
+// can exercise matches that would otherwise be read in a single call
+// and never cross a buffer boundary.
+type chunkReader struct {
+	data []byte
+	n    int
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if len(c.data) == 0 {
+		return 0, io.EOF
+	}
+	n := c.n
+	if n > len(p) {
+		n = len(p)
+	}
+	if n > len(c.data) {
+		n = len(c.data)
+	}
+	copy(p, c.data[:n])
+	c.data = c.data[n:]
+	return n, nil
+}
+
+func TestSearchReaderAcrossChunkBoundaries(t *testing.T) {
+	text := "aaaaxxxabcabcxxxaaaaabcxxxabc"
+	pattern := "abc"
+	want := FindAll(text, pattern)
+
+	for chunkSize := 1; chunkSize <= len(text); chunkSize++ {
+		r := &chunkReader{data: []byte(text), n: chunkSize}
+		got, err := SearchReader(r, pattern)
+		if err != nil {
+			t.Fatalf("chunkSize=%d: SearchReader() error = %v", chunkSize, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("chunkSize=%d: SearchReader() = %v, want %v", chunkSize, got, want)
+		}
+		for i, v := range want {
+			if got[i] != int64(v) {
+				t.Errorf("chunkSize=%d: SearchReader()[%d] = %d, want %d", chunkSize, i, got[i], v)
+			}
+		}
+	}
+}
+
+func TestSearchReaderFuncStopsEarly(t *testing.T) {
+	text := "aaaaaaaaaa"
+	pattern := "aa"
+
+	var got []int64
+	err := SearchReaderFunc(strings.NewReader(text), pattern, func(offset int64) bool {
+		got = append(got, offset)
+		return len(got) < 2
+	})
+	if err != nil {
+		t.Fatalf("SearchReaderFunc() error = %v", err)
+	}
+	if want := []int64{0, 1}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("SearchReaderFunc() matches = %v, want %v", got, want)
+	}
+}
+
+func TestSearchReaderChan(t *testing.T) {
+	text := "ABABDABACDABABCABAB"
+	pattern := "ABABCABAB"
+	want := FindAll(text, pattern)
+
+	matches, errc := SearchReaderChan(strings.NewReader(text), pattern)
+	var got []int64
+	for m := range matches {
+		got = append(got, m)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("SearchReaderChan() error = %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("SearchReaderChan() = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != int64(v) {
+			t.Errorf("SearchReaderChan()[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+}
+
+type errReader struct{ err error }
+
+func (e errReader) Read([]byte) (int, error) { return 0, e.err }
+
+func TestSearchReaderPropagatesReadError(t *testing.T) {
+	wantErr := errors.New("boom")
+	_, err := SearchReader(errReader{err: wantErr}, "abc")
+	if !errors.Is(err, wantErr) {
+		t.Errorf("SearchReader() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestSearchReaderEmptyPattern(t *testing.T) {
+	got, err := SearchReader(strings.NewReader("abc"), "")
+	if err != nil {
+		t.Fatalf("SearchReader() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("SearchReader() with empty pattern = %v, want empty", got)
+	}
+}
@@ -0,0 +1,39 @@
+package kmp
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/visualize"
+)
+
+// BuildLPSTrace computes the same LPS (Longest Prefix Suffix) array as
+// buildLPS but records the table after every index is filled in, so the
+// construction can be replayed frame by frame with visualize.Tracer.Replay.
+func BuildLPSTrace(pattern string) (lps []int, trace *visualize.Tracer) {
+	m := len(pattern)
+	lps = make([]int, m)
+	trace = visualize.NewTracer()
+	length := 0
+	i := 1
+
+	if m > 0 {
+		trace.Capture("lps[0] = 0", visualize.ArrayASCII(lps, 0))
+	}
+
+	for i < m {
+		if pattern[i] == pattern[length] {
+			length++
+			lps[i] = length
+			i++
+		} else {
+			if length != 0 {
+				length = lps[length-1]
+			} else {
+				lps[i] = 0
+				i++
+			}
+		}
+		trace.Capture(fmt.Sprintf("i=%d length=%d", i, length), visualize.ArrayASCII(lps, i-1))
+	}
+	return lps, trace
+}
@@ -0,0 +1,27 @@
+package kmp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuildLPSTrace(t *testing.T) {
+	lps, trace := BuildLPSTrace("aabaaab")
+
+	if want := []int{0, 1, 0, 1, 2, 2, 3}; !slices.Equal(lps, want) {
+		t.Errorf("BuildLPSTrace() lps = %v, want %v", lps, want)
+	}
+	if len(trace.Frames()) == 0 {
+		t.Errorf("BuildLPSTrace() captured no frames")
+	}
+}
+
+func TestBuildLPSTraceEmptyPattern(t *testing.T) {
+	lps, trace := BuildLPSTrace("")
+	if len(lps) != 0 {
+		t.Errorf("BuildLPSTrace(\"\") lps = %v, want empty", lps)
+	}
+	if len(trace.Frames()) != 0 {
+		t.Errorf("BuildLPSTrace(\"\") captured %d frames, want 0", len(trace.Frames()))
+	}
+}
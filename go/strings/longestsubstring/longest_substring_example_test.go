@@ -0,0 +1,8 @@
+package longestsubstring
+
+import "fmt"
+
+func ExampleLengthOfLongestSubstring() {
+	fmt.Println("Longest substring length:", LengthOfLongestSubstring("abcabcbb"))
+	// Output: Longest substring length: 3
+}
@@ -0,0 +1,27 @@
+package longestsubstring
+
+import "testing"
+
+func TestLengthOfLongestSubstring(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"empty", "", 0},
+		{"single character", "a", 1},
+		{"all unique", "abcdef", 6},
+		{"all duplicates", "aaaa", 1},
+		{"classic case", "abcabcbb", 3},
+		{"repeat at end", "pwwkew", 3},
+		{"unicode", "héllo", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LengthOfLongestSubstring(tt.in); got != tt.want {
+				t.Errorf("LengthOfLongestSubstring(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package manacher implements Manacher's algorithm: computing the
+// radius of the longest palindrome centered at every position of a
+// string (both odd- and even-length centers) in a single O(n) pass each,
+// which LongestPalindrome, CountPalindromicSubstrings, and
+// PalindromeIndex.IsPalindromeRange are all built on top of.
+package manacher
+
+// radii returns, for every index i of s:
+//   - odd[i]: the number of characters oddRadius[i] extends to each side
+//     of i such that s[i-oddRadius[i]+1 : i+oddRadius[i]] is a palindrome
+//     (so the longest odd-length palindrome centered at i has length
+//     2*odd[i]-1).
+//   - even[i]: the radius of the longest even-length palindrome whose
+//     right half starts at i, i.e. s[i-even[i] : i+even[i]] is a
+//     palindrome of length 2*even[i] (even[i] == 0 means no even
+//     palindrome is centered between i-1 and i).
+//
+// Both arrays are filled using the standard trick of reusing the
+// already-computed radius of the mirror position within the current
+// rightmost-known palindrome, which is what keeps the whole computation
+// to O(n) instead of the O(n^2) naive "expand around every center".
+func radii(s string) (odd, even []int) {
+	n := len(s)
+	odd = make([]int, n)
+	even = make([]int, n)
+
+	l, r := 0, -1
+	for i := 0; i < n; i++ {
+		k := 1
+		if i <= r {
+			k = min(odd[l+r-i], r-i+1)
+		}
+		for i-k >= 0 && i+k < n && s[i-k] == s[i+k] {
+			k++
+		}
+		odd[i] = k
+		if i+k-1 > r {
+			l, r = i-k+1, i+k-1
+		}
+	}
+
+	l, r = 0, -1
+	for i := 0; i < n; i++ {
+		k := 0
+		if i <= r {
+			k = min(even[l+r-i+1], r-i+1)
+		}
+		for i-k-1 >= 0 && i+k < n && s[i-k-1] == s[i+k] {
+			k++
+		}
+		even[i] = k
+		if i+k-1 > r {
+			l, r = i-k, i+k-1
+		}
+	}
+
+	return odd, even
+}
+
+// LongestPalindrome returns a longest palindromic substring of s.
+func LongestPalindrome(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	odd, even := radii(s)
+
+	bestLen, bestStart := 1, 0
+	for i, k := range odd {
+		if length := 2*k - 1; length > bestLen {
+			bestLen, bestStart = length, i-k+1
+		}
+	}
+	for i, k := range even {
+		if length := 2 * k; length > bestLen {
+			bestLen, bestStart = length, i-k
+		}
+	}
+	return s[bestStart : bestStart+bestLen]
+}
+
+// CountPalindromicSubstrings returns the number of palindromic
+// substrings of s, counting two substrings as distinct whenever their
+// positions differ even if their contents are the same. Since odd[i]
+// counts every odd-length palindrome centered at i (one per radius from
+// 1 up to odd[i]), and likewise for even[i], the total is just their sum.
+func CountPalindromicSubstrings(s string) int {
+	odd, even := radii(s)
+	count := 0
+	for i := range s {
+		count += odd[i] + even[i]
+	}
+	return count
+}
+
+// PalindromeIndex answers repeated IsPalindromeRange queries against a
+// fixed string in O(1) each, after an O(n) build.
+type PalindromeIndex struct {
+	odd, even []int
+}
+
+// NewPalindromeIndex builds a PalindromeIndex over s.
+func NewPalindromeIndex(s string) *PalindromeIndex {
+	odd, even := radii(s)
+	return &PalindromeIndex{odd: odd, even: even}
+}
+
+// IsPalindromeRange reports whether s[l:r+1] (inclusive of both l and r)
+// is a palindrome.
+func (p *PalindromeIndex) IsPalindromeRange(l, r int) bool {
+	if l < 0 || r >= len(p.odd) || l > r {
+		return false
+	}
+	length := r - l + 1
+	if length%2 == 1 {
+		center := (l + r) / 2
+		return p.odd[center] >= length/2+1
+	}
+	right := (l + r + 1) / 2
+	return p.even[right] >= length/2
+}
@@ -0,0 +1,86 @@
+package manacher
+
+import "testing"
+
+func isPalindrome(s string) bool {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		if s[i] != s[j] {
+			return false
+		}
+	}
+	return true
+}
+
+func bruteForceLongestPalindrome(s string) string {
+	best := ""
+	for i := 0; i < len(s); i++ {
+		for j := i; j < len(s); j++ {
+			if sub := s[i : j+1]; isPalindrome(sub) && len(sub) > len(best) {
+				best = sub
+			}
+		}
+	}
+	return best
+}
+
+func bruteForceCountPalindromicSubstrings(s string) int {
+	count := 0
+	for i := 0; i < len(s); i++ {
+		for j := i; j < len(s); j++ {
+			if isPalindrome(s[i : j+1]) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func TestLongestPalindrome(t *testing.T) {
+	tests := []string{"babad", "cbbd", "a", "", "forgeeksskeegfor", "aaaaaa", "abcde"}
+	for _, s := range tests {
+		got := LongestPalindrome(s)
+		want := bruteForceLongestPalindrome(s)
+		if len(got) != len(want) {
+			t.Errorf("LongestPalindrome(%q) = %q (len %d), want a palindrome of len %d", s, got, len(got), len(want))
+		}
+		if !isPalindrome(got) {
+			t.Errorf("LongestPalindrome(%q) = %q, which is not a palindrome", s, got)
+		}
+	}
+}
+
+func TestCountPalindromicSubstrings(t *testing.T) {
+	tests := []string{"abc", "aaa", "aba", "", "a", "abba", "racecar"}
+	for _, s := range tests {
+		if got, want := CountPalindromicSubstrings(s), bruteForceCountPalindromicSubstrings(s); got != want {
+			t.Errorf("CountPalindromicSubstrings(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestIsPalindromeRange(t *testing.T) {
+	s := "abacaba"
+	idx := NewPalindromeIndex(s)
+	for l := 0; l < len(s); l++ {
+		for r := l; r < len(s); r++ {
+			got := idx.IsPalindromeRange(l, r)
+			want := isPalindrome(s[l : r+1])
+			if got != want {
+				t.Errorf("IsPalindromeRange(%d, %d) on %q = %v, want %v", l, r, s, got, want)
+			}
+		}
+	}
+}
+
+func TestIsPalindromeRangeOutOfBounds(t *testing.T) {
+	idx := NewPalindromeIndex("abc")
+	if idx.IsPalindromeRange(-1, 1) {
+		t.Error("IsPalindromeRange(-1, 1) = true, want false")
+	}
+	if idx.IsPalindromeRange(0, 5) {
+		t.Error("IsPalindromeRange(0, 5) = true, want false")
+	}
+	if idx.IsPalindromeRange(2, 1) {
+		t.Error("IsPalindromeRange(2, 1) = true, want false")
+	}
+}
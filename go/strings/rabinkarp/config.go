@@ -0,0 +1,39 @@
+package rabinkarp
+
+import "math/rand"
+
+// Config holds the parameters of the polynomial rolling hash: Base is the
+// radix characters are weighted by, and Modulus bounds the hash so it
+// fits in a fixed-width accumulator instead of growing with the input.
+type Config struct {
+	Base    uint64
+	Modulus uint64
+}
+
+// largePrimes are candidate moduli for RandomConfig, all comfortably
+// below 2^32 so products of two moduli-sized values never overflow
+// uint64 during rolling-hash arithmetic.
+var largePrimes = []uint64{1_000_000_007, 1_000_000_009, 998_244_353, 999_999_937}
+
+// DefaultConfig returns the Config used by FindAll and FindAllPatterns:
+// base 256 (one weight per possible byte value) and a fixed large prime
+// modulus.
+func DefaultConfig() Config {
+	return Config{Base: 256, Modulus: largePrimes[0]}
+}
+
+// RandomConfig returns a Config with a randomized base and modulus,
+// seeded deterministically from seed. Search algorithms that hash their
+// input with a fixed, public base/modulus can be defeated by an
+// adversary who crafts a text with many hash collisions against the
+// pattern; picking the parameters at random per run removes that
+// attacker's ability to precompute such a text in advance.
+func RandomConfig(seed int64) Config {
+	rng := rand.New(rand.NewSource(seed))
+	modulus := largePrimes[rng.Intn(len(largePrimes))]
+	// Keep the base comfortably inside [256, 65536) and odd, so it
+	// behaves like a generic polynomial weight rather than degenerating
+	// toward small cycles.
+	base := uint64(rng.Intn(1<<15))*2 + 257
+	return Config{Base: base, Modulus: modulus}
+}
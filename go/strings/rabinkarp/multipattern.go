@@ -0,0 +1,52 @@
+package rabinkarp
+
+import "github.com/ghostmkg/dsa-code/go/strings/hashing"
+
+// FindAllPatterns searches text for every occurrence of every pattern in
+// patterns using DefaultConfig, returning one position slice per pattern
+// (results[i] holds the match positions of patterns[i]).
+func FindAllPatterns(text string, patterns []string) [][]int {
+	return FindAllPatternsConfig(text, patterns, DefaultConfig())
+}
+
+// FindAllPatternsConfig is FindAllPatterns with an explicit rolling-hash
+// Config. Patterns are grouped by length so that all patterns sharing a
+// length are found in a single rolling-hash pass over text: at each
+// position only the (typically small) set of same-length patterns whose
+// hash matches the window's hash needs a direct comparison.
+func FindAllPatternsConfig(text string, patterns []string, cfg Config) [][]int {
+	results := make([][]int, len(patterns))
+
+	byLength := make(map[int][]int)
+	for i, p := range patterns {
+		if len(p) == 0 || len(p) > len(text) {
+			continue
+		}
+		byLength[len(p)] = append(byLength[len(p)], i)
+	}
+
+	for m, idxs := range byLength {
+		candidatesByHash := make(map[uint64][]int, len(idxs))
+		for _, idx := range idxs {
+			h := hash(patterns[idx], cfg)
+			candidatesByHash[h] = append(candidatesByHash[h], idx)
+		}
+
+		leadWeight := hashing.Power(cfg.Base, uint64(m-1), cfg.Modulus)
+		textHash := hash(text[:m], cfg)
+		n := len(text)
+
+		for i := 0; ; i++ {
+			for _, idx := range candidatesByHash[textHash] {
+				if text[i:i+m] == patterns[idx] {
+					results[idx] = append(results[idx], i)
+				}
+			}
+			if i+m >= n {
+				break
+			}
+			textHash = rollHash(textHash, text[i], text[i+m], leadWeight, cfg)
+		}
+	}
+	return results
+}
@@ -0,0 +1,58 @@
+// Package rabinkarp implements the Rabin-Karp string search algorithm:
+// a polynomial rolling hash identifies candidate alignments in O(1) per
+// shift, and every candidate is verified with a direct byte comparison
+// so hash collisions can never produce a false match. The hash's
+// base/modulus are configurable via Config, including a randomized
+// variant that resists adversarial inputs built against a known, fixed
+// hash.
+package rabinkarp
+
+import "github.com/ghostmkg/dsa-code/go/strings/hashing"
+
+// hash computes the polynomial rolling hash of s under cfg.
+func hash(s string, cfg Config) uint64 {
+	var h uint64
+	for i := 0; i < len(s); i++ {
+		h = (h*cfg.Base + uint64(s[i])) % cfg.Modulus
+	}
+	return h
+}
+
+// rollHash advances a window's hash by dropping leading (the byte
+// leaving the window) and appending trailing (the byte entering it).
+// leadWeight is base^(m-1) mod modulus, the weight of the leading byte.
+func rollHash(h uint64, leading, trailing byte, leadWeight uint64, cfg Config) uint64 {
+	h = (h + cfg.Modulus - uint64(leading)*leadWeight%cfg.Modulus) % cfg.Modulus
+	h = (h*cfg.Base + uint64(trailing)) % cfg.Modulus
+	return h
+}
+
+// FindAll returns the starting index of every (possibly overlapping)
+// occurrence of pattern in text, in ascending order, using DefaultConfig.
+func FindAll(text, pattern string) []int {
+	return FindAllConfig(text, pattern, DefaultConfig())
+}
+
+// FindAllConfig is FindAll with an explicit rolling-hash Config.
+func FindAllConfig(text, pattern string, cfg Config) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+
+	patternHash := hash(pattern, cfg)
+	leadWeight := hashing.Power(cfg.Base, uint64(m-1), cfg.Modulus)
+	textHash := hash(text[:m], cfg)
+
+	var matches []int
+	for i := 0; ; i++ {
+		if textHash == patternHash && text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+		if i+m >= n {
+			break
+		}
+		textHash = rollHash(textHash, text[i], text[i+m], leadWeight, cfg)
+	}
+	return matches
+}
@@ -0,0 +1,113 @@
+package rabinkarp
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func bruteForceFindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	var matches []int
+	for i := 0; i+m <= n; i++ {
+		if text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		text, pattern string
+		want          []int
+	}{
+		{"abxabcabcaby", "abcaby", []int{6}},
+		{"abcdef", "xyz", nil},
+		{"aaaa", "aa", []int{0, 1, 2}},
+		{"hello", "hello", []int{0}},
+		{"GCATCGCAGAGAGTATACAGTACG", "GCAGAGAG", []int{5}},
+	}
+	for _, tt := range tests {
+		if got := FindAll(tt.text, tt.pattern); !slices.Equal(got, tt.want) {
+			t.Errorf("FindAll(%q, %q) = %v, want %v", tt.text, tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestFindAllEmptyAndEdgeCases(t *testing.T) {
+	if got := FindAll("abc", ""); got != nil {
+		t.Errorf("FindAll with empty pattern = %v, want nil", got)
+	}
+	if got := FindAll("ab", "abc"); got != nil {
+		t.Errorf("FindAll with pattern longer than text = %v, want nil", got)
+	}
+}
+
+func TestFindAllConfigRandomAgreesWithBruteForce(t *testing.T) {
+	text := strings.Repeat("abcabd", 200) + "needle" + strings.Repeat("xy", 100)
+	pattern := "needle"
+	want := bruteForceFindAll(text, pattern)
+
+	for seed := int64(0); seed < 10; seed++ {
+		cfg := RandomConfig(seed)
+		if got := FindAllConfig(text, pattern, cfg); !slices.Equal(got, want) {
+			t.Fatalf("seed %d: FindAllConfig = %v, want %v", seed, got, want)
+		}
+	}
+}
+
+func TestFindAllMatchesBruteForceFuzzLike(t *testing.T) {
+	texts := []string{"", "a", "aaaaaaaaaa", "mississippi", "the quick brown fox jumps over the lazy dog"}
+	patterns := []string{"", "a", "aa", "ssi", "fox", "zzz", "the"}
+
+	for _, text := range texts {
+		for _, pattern := range patterns {
+			got := FindAllConfig(text, pattern, DefaultConfig())
+			want := bruteForceFindAll(text, pattern)
+			if !slices.Equal(got, want) {
+				t.Errorf("FindAllConfig(%q, %q) = %v, want %v", text, pattern, got, want)
+			}
+		}
+	}
+}
+
+func TestFindAllPatterns(t *testing.T) {
+	text := "she sells seashells by the seashore"
+	patterns := []string{"sea", "shell", "sells", "xyz", "he"}
+
+	got := FindAllPatterns(text, patterns)
+	for i, p := range patterns {
+		if wantPositions := bruteForceFindAll(text, p); !slices.Equal(got[i], wantPositions) {
+			t.Errorf("FindAllPatterns: pattern %q = %v, want %v", p, got[i], wantPositions)
+		}
+	}
+}
+
+func TestFindAllPatternsSharedHash(t *testing.T) {
+	// Two different patterns of the same length, searched together,
+	// should not cross-contaminate each other's results even though
+	// they share a rolling-hash pass.
+	text := "catdogcatbatcat"
+	patterns := []string{"cat", "dog", "bat", "rat"}
+
+	got := FindAllPatterns(text, patterns)
+	for i, p := range patterns {
+		want := bruteForceFindAll(text, p)
+		if !slices.Equal(got[i], want) {
+			t.Errorf("pattern %q = %v, want %v", p, got[i], want)
+		}
+	}
+}
+
+func TestFindAllPatternsEmptyAndOversized(t *testing.T) {
+	text := "short"
+	patterns := []string{"", "this pattern is way too long for the text"}
+	got := FindAllPatterns(text, patterns)
+	if got[0] != nil || got[1] != nil {
+		t.Errorf("FindAllPatterns with empty/oversized patterns = %v, want all nil", got)
+	}
+}
@@ -0,0 +1,82 @@
+package regex
+
+type opcode int
+
+const (
+	opChar  opcode = iota // match a literal byte, then fall through to pc+1
+	opClass               // match a byte against a class, then fall through to pc+1
+	opJmp                 // unconditionally continue at x
+	opSplit               // continue at both x and y (for alternation and star)
+	opMatch               // accept
+)
+
+type inst struct {
+	op     opcode
+	c      byte
+	set    map[byte]bool
+	negate bool
+	x, y   int
+}
+
+// compileNode lowers an AST node to bytecode appended onto prog,
+// returning the program counter of the node's first instruction. Nodes
+// that need to jump past code compiled after them (star, alternation)
+// work because Go evaluates the recursive calls before computing the
+// jump target, so by the time a jmp/split is patched, every instruction
+// it needs to point at already has a known, final index.
+func compileNode(n node, prog *[]inst) int {
+	switch v := n.(type) {
+	case *charNode:
+		start := len(*prog)
+		*prog = append(*prog, inst{op: opChar, c: v.c})
+		return start
+
+	case *classNode:
+		start := len(*prog)
+		*prog = append(*prog, inst{op: opClass, set: v.set, negate: v.negate})
+		return start
+
+	case *concatNode:
+		if len(v.parts) == 0 {
+			start := len(*prog)
+			*prog = append(*prog, inst{op: opJmp, x: len(*prog) + 1})
+			return start
+		}
+		start := -1
+		for _, part := range v.parts {
+			s := compileNode(part, prog)
+			if start == -1 {
+				start = s
+			}
+		}
+		return start
+
+	case *starNode:
+		splitPC := len(*prog)
+		*prog = append(*prog, inst{op: opSplit})
+		bodyStart := compileNode(v.sub, prog)
+		*prog = append(*prog, inst{op: opJmp, x: splitPC})
+		after := len(*prog)
+		(*prog)[splitPC].x = bodyStart
+		(*prog)[splitPC].y = after
+		return splitPC
+
+	case *altNode:
+		if len(v.options) == 1 {
+			return compileNode(v.options[0], prog)
+		}
+		splitPC := len(*prog)
+		*prog = append(*prog, inst{op: opSplit})
+		firstStart := compileNode(v.options[0], prog)
+		jmpPC := len(*prog)
+		*prog = append(*prog, inst{op: opJmp})
+		restStart := compileNode(&altNode{options: v.options[1:]}, prog)
+		end := len(*prog)
+		(*prog)[splitPC].x, (*prog)[splitPC].y = firstStart, restStart
+		(*prog)[jmpPC].x = end
+		return splitPC
+
+	default:
+		panic("regex: unknown AST node type")
+	}
+}
@@ -0,0 +1,183 @@
+package regex
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnbalancedParens is returned when a pattern has a `(` with no
+// matching `)`, or a stray `)` with no matching `(`.
+var ErrUnbalancedParens = errors.New("regex: unbalanced parentheses")
+
+// ErrUnterminatedClass is returned when a pattern opens a `[` character
+// class but never closes it with a matching `]`.
+var ErrUnterminatedClass = errors.New("regex: unterminated character class")
+
+// ErrTrailingBackslash is returned when a pattern ends in `\` with no
+// character left to escape.
+var ErrTrailingBackslash = errors.New("regex: trailing backslash")
+
+// node is an AST node for the subset of regular expressions this
+// package supports: concatenation, alternation (`|`), Kleene star
+// (`*`), literal characters (optionally backslash-escaped), and
+// character classes (`[abc]`, `[a-z]`, `[^...]`).
+type node interface{}
+
+type concatNode struct{ parts []node }
+type altNode struct{ options []node }
+type starNode struct{ sub node }
+type charNode struct{ c byte }
+type classNode struct {
+	set    map[byte]bool
+	negate bool
+}
+
+type parser struct {
+	pattern string
+	pos     int
+}
+
+func parse(pattern string) (node, error) {
+	p := &parser{pattern: pattern}
+	n, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.pattern) {
+		return nil, fmt.Errorf("%w: unexpected %q at position %d", ErrUnbalancedParens, p.pattern[p.pos], p.pos)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() (byte, bool) {
+	if p.pos >= len(p.pattern) {
+		return 0, false
+	}
+	return p.pattern[p.pos], true
+}
+
+func (p *parser) parseAlt() (node, error) {
+	first, err := p.parseConcat()
+	if err != nil {
+		return nil, err
+	}
+	options := []node{first}
+	for {
+		c, ok := p.peek()
+		if !ok || c != '|' {
+			break
+		}
+		p.pos++
+		next, err := p.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, next)
+	}
+	if len(options) == 1 {
+		return options[0], nil
+	}
+	return &altNode{options: options}, nil
+}
+
+func (p *parser) parseConcat() (node, error) {
+	var parts []node
+	for {
+		c, ok := p.peek()
+		if !ok || c == '|' || c == ')' {
+			break
+		}
+		n, err := p.parseStar()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, n)
+	}
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+	return &concatNode{parts: parts}, nil
+}
+
+func (p *parser) parseStar() (node, error) {
+	atom, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		c, ok := p.peek()
+		if !ok || c != '*' {
+			break
+		}
+		p.pos++
+		atom = &starNode{sub: atom}
+	}
+	return atom, nil
+}
+
+func (p *parser) parseAtom() (node, error) {
+	c, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an atom at position %d", ErrUnbalancedParens, p.pos)
+	}
+
+	switch c {
+	case '(':
+		p.pos++
+		n, err := p.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+		close, ok := p.peek()
+		if !ok || close != ')' {
+			return nil, ErrUnbalancedParens
+		}
+		p.pos++
+		return n, nil
+	case '[':
+		return p.parseClass()
+	case '\\':
+		p.pos++
+		ch, ok := p.peek()
+		if !ok {
+			return nil, ErrTrailingBackslash
+		}
+		p.pos++
+		return &charNode{c: ch}, nil
+	default:
+		p.pos++
+		return &charNode{c: c}, nil
+	}
+}
+
+func (p *parser) parseClass() (node, error) {
+	p.pos++ // consume '['
+	negate := false
+	if c, ok := p.peek(); ok && c == '^' {
+		negate = true
+		p.pos++
+	}
+
+	set := make(map[byte]bool)
+	start := p.pos
+	for {
+		c, ok := p.peek()
+		if !ok {
+			return nil, ErrUnterminatedClass
+		}
+		if c == ']' && p.pos > start {
+			p.pos++
+			break
+		}
+		if p.pos+2 < len(p.pattern) && p.pattern[p.pos+1] == '-' && p.pattern[p.pos+2] != ']' {
+			for r := c; r <= p.pattern[p.pos+2]; r++ {
+				set[r] = true
+			}
+			p.pos += 3
+			continue
+		}
+		set[c] = true
+		p.pos++
+	}
+	return &classNode{set: set, negate: negate}, nil
+}
@@ -0,0 +1,105 @@
+// Package regex is a small regular expression engine built on a
+// Thompson NFA, as an educational alternative to Go's own regexp (which
+// is itself a Thompson-NFA engine, just a far more complete one). It
+// supports literal characters (backslash-escaped to match a character
+// that would otherwise be special), concatenation, alternation (`|`),
+// Kleene star (`*`), grouping (`(...)`), and character classes
+// (`[abc]`, `[a-z]`, `[^...]`) — notably not `.`, `+`, `?`, or anchors,
+// which a real engine would have and this one doesn't.
+//
+// A pattern compiles to a small bytecode program (opChar/opClass,
+// opJmp, opSplit, opMatch) that MatchString and FindAllStringIndex
+// simulate without backtracking, following every possible thread of
+// execution in lockstep one input byte at a time — the same technique
+// described in Russ Cox's "Regular Expression Matching Can Be Simple
+// And Fast". MatchString runs in O(n*m) where n is len(text) and m is
+// the compiled program size; FindAllStringIndex tries every starting
+// offset so it costs O(n^2*m) in the worst case.
+package regex
+
+// Regexp is a compiled pattern, ready to match against any number of
+// strings without re-parsing it each time.
+type Regexp struct {
+	prog []inst
+}
+
+// Compile parses pattern and builds a Regexp, or returns a non-nil
+// error (ErrUnbalancedParens, ErrUnterminatedClass, or
+// ErrTrailingBackslash) if pattern is malformed.
+func Compile(pattern string) (*Regexp, error) {
+	ast, err := parse(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var prog []inst
+	compileNode(ast, &prog)
+	prog = append(prog, inst{op: opMatch})
+	return &Regexp{prog: prog}, nil
+}
+
+// MatchString reports whether s, in its entirety, matches re — the
+// same semantics as wrapping the pattern in `^...$` in a more featureful
+// engine.
+func (re *Regexp) MatchString(s string) bool {
+	length, ok := findMatchAt(re.prog, 0, s)
+	return ok && length == len(s)
+}
+
+// FindStringIndex returns a two-element slice holding the start and end
+// byte offsets of the leftmost match of re in s — and among matches
+// starting at that same leftmost position, the longest one — or nil if
+// re does not match anywhere in s.
+func (re *Regexp) FindStringIndex(s string) []int {
+	for start := 0; start <= len(s); start++ {
+		if length, ok := findMatchAt(re.prog, start, s); ok {
+			return []int{start, start + length}
+		}
+	}
+	return nil
+}
+
+// FindAllStringIndex returns the start/end byte offsets of every
+// non-overlapping match of re in s, scanning left to right and resuming
+// just after each match (or one byte past an empty match, so it can't
+// loop forever).
+func (re *Regexp) FindAllStringIndex(s string) [][]int {
+	var spans [][]int
+	pos := 0
+	for pos <= len(s) {
+		idx := re.FindStringIndex(s[pos:])
+		if idx == nil {
+			break
+		}
+		start, end := pos+idx[0], pos+idx[1]
+		spans = append(spans, []int{start, end})
+		if end == start {
+			pos = end + 1
+		} else {
+			pos = end
+		}
+	}
+	return spans
+}
+
+// Match compiles pattern and reports whether text matches it in its
+// entirety. It's a convenience wrapper around Compile and MatchString
+// for one-off matches; compile the pattern once with Compile if you'll
+// reuse it.
+func Match(pattern, text string) (bool, error) {
+	re, err := Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+	return re.MatchString(text), nil
+}
+
+// FindAll compiles pattern and returns every non-overlapping match's
+// [start, end) byte offsets in text. It's a convenience wrapper around
+// Compile and FindAllStringIndex for one-off searches.
+func FindAll(pattern, text string) ([][]int, error) {
+	re, err := Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.FindAllStringIndex(text), nil
+}
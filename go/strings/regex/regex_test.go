@@ -0,0 +1,106 @@
+package regex
+
+import "testing"
+
+func TestMatchString(t *testing.T) {
+	cases := []struct {
+		pattern, text string
+		want          bool
+	}{
+		{"abc", "abc", true},
+		{"abc", "abcd", false},
+		{"a|b", "a", true},
+		{"a|b", "b", true},
+		{"a|b", "c", false},
+		{"a*", "", true},
+		{"a*", "aaaa", true},
+		{"a*", "aaab", false},
+		{"(ab)*", "ababab", true},
+		{"(ab)*", "aba", false},
+		{"a(b|c)*d", "acbbcd", true},
+		{"a(b|c)*d", "aed", false},
+		{"[abc]", "b", true},
+		{"[abc]", "d", false},
+		{"[a-z]*", "hello", true},
+		{"[a-z]*", "Hello", false},
+		{"[^abc]", "d", true},
+		{"[^abc]", "a", false},
+		{"a\\*b", "a*b", true},
+		{"a\\*b", "ab", false},
+	}
+	for _, c := range cases {
+		re, err := Compile(c.pattern)
+		if err != nil {
+			t.Fatalf("Compile(%q): %v", c.pattern, err)
+		}
+		if got := re.MatchString(c.text); got != c.want {
+			t.Errorf("MatchString(%q) against %q = %v, want %v", c.text, c.pattern, got, c.want)
+		}
+	}
+}
+
+func TestFindStringIndex(t *testing.T) {
+	re, err := Compile("a(b|c)*")
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	got := re.FindStringIndex("xxabccbyy")
+	want := []int{2, 7}
+	if got == nil || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("FindStringIndex = %v, want %v", got, want)
+	}
+}
+
+func TestFindStringIndexNoMatch(t *testing.T) {
+	re, err := Compile("xyz")
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if got := re.FindStringIndex("abcdef"); got != nil {
+		t.Errorf("FindStringIndex = %v, want nil", got)
+	}
+}
+
+func TestFindAllStringIndex(t *testing.T) {
+	re, err := Compile("[0-9]*")
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	spans := re.FindAllStringIndex("ab12cd345ef")
+	want := [][]int{{0, 0}, {1, 1}, {2, 4}, {4, 4}, {5, 5}, {6, 9}, {9, 9}, {10, 10}, {11, 11}}
+	if len(spans) != len(want) {
+		t.Fatalf("FindAllStringIndex = %v, want %v", spans, want)
+	}
+	for i := range want {
+		if spans[i][0] != want[i][0] || spans[i][1] != want[i][1] {
+			t.Errorf("span %d = %v, want %v", i, spans[i], want[i])
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	cases := []string{"(abc", "abc)", "[abc", "abc\\"}
+	for _, pattern := range cases {
+		if _, err := Compile(pattern); err == nil {
+			t.Errorf("Compile(%q) = nil error, want one", pattern)
+		}
+	}
+}
+
+func TestMatchAndFindAllHelpers(t *testing.T) {
+	ok, err := Match("(a|b)*c", "abababc")
+	if err != nil {
+		t.Fatalf("Match: %v", err)
+	}
+	if !ok {
+		t.Errorf("Match(\"(a|b)*c\", \"abababc\") = false, want true")
+	}
+
+	spans, err := FindAll("a*", "baaab")
+	if err != nil {
+		t.Fatalf("FindAll: %v", err)
+	}
+	if len(spans) == 0 {
+		t.Errorf("FindAll found no matches, want at least one")
+	}
+}
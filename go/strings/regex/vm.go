@@ -0,0 +1,87 @@
+package regex
+
+// addThread follows every epsilon transition (opJmp, opSplit) reachable
+// from pc without consuming input, appending each opChar/opClass/opMatch
+// instruction it bottoms out at to list. seen prevents revisiting a pc
+// within the same step, which both avoids infinite loops on a `*` whose
+// body can match empty and bounds a single step's work by the program
+// size.
+func addThread(prog []inst, list []int, pc int, seen map[int]bool) []int {
+	if seen[pc] {
+		return list
+	}
+	seen[pc] = true
+
+	switch prog[pc].op {
+	case opJmp:
+		return addThread(prog, list, prog[pc].x, seen)
+	case opSplit:
+		list = addThread(prog, list, prog[pc].x, seen)
+		list = addThread(prog, list, prog[pc].y, seen)
+		return list
+	default: // opChar, opClass, opMatch
+		return append(list, pc)
+	}
+}
+
+func classMatches(in inst, c byte) bool {
+	return in.set[c] != in.negate
+}
+
+func containsMatch(prog []inst, list []int) bool {
+	for _, pc := range list {
+		if prog[pc].op == opMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// step advances every thread in clist by one input byte c, returning
+// the epsilon-closed set of threads alive afterward. Each step does at
+// most one pass over clist (bounded by the program size), so matching a
+// text of length n against a program of m instructions costs O(n*m) —
+// the "simulate an NFA without backtracking" property Thompson
+// construction is built for.
+func step(prog []inst, clist []int, c byte) []int {
+	var nlist []int
+	seen := make(map[int]bool)
+	for _, pc := range clist {
+		in := prog[pc]
+		switch in.op {
+		case opChar:
+			if in.c == c {
+				nlist = addThread(prog, nlist, pc+1, seen)
+			}
+		case opClass:
+			if classMatches(in, c) {
+				nlist = addThread(prog, nlist, pc+1, seen)
+			}
+		}
+	}
+	return nlist
+}
+
+// findMatchAt returns the length of the longest match of prog starting
+// exactly at s[start:], and whether any match was found at all. It
+// matches POSIX-style longest-match semantics rather than Perl-style
+// leftmost-first backtracking: among every way the pattern could match
+// starting here, it reports the longest one.
+func findMatchAt(prog []inst, start int, s string) (int, bool) {
+	clist := addThread(prog, nil, 0, make(map[int]bool))
+	matchLen, found := -1, false
+	if containsMatch(prog, clist) {
+		matchLen, found = 0, true
+	}
+
+	for i := start; i < len(s); i++ {
+		if len(clist) == 0 {
+			break
+		}
+		clist = step(prog, clist, s[i])
+		if containsMatch(prog, clist) {
+			matchLen, found = i+1-start, true
+		}
+	}
+	return matchLen, found
+}
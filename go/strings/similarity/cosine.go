@@ -0,0 +1,47 @@
+package similarity
+
+import "math"
+
+// NGramCosine computes cosine similarity between the n-gram frequency
+// vectors of two strings: the cosine of the angle between their n-gram
+// count vectors, which is 1 for identical n-gram distributions and 0
+// for strings sharing no n-grams at all.
+type NGramCosine struct {
+	// N is the n-gram length. The zero value uses 2 (bigrams).
+	N int
+}
+
+// Similarity implements Metric.
+func (m NGramCosine) Similarity(a, b string) float64 {
+	n := m.N
+	if n <= 0 {
+		n = 2
+	}
+	va, vb := ngramCounts(a, n), ngramCounts(b, n)
+	if len(va) == 0 && len(vb) == 0 {
+		if a == b {
+			return 1
+		}
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for gram, ca := range va {
+		normA += float64(ca * ca)
+		if cb, ok := vb[gram]; ok {
+			dot += float64(ca * cb)
+		}
+	}
+	for _, cb := range vb {
+		normB += float64(cb * cb)
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// CosineSimilarity returns the bigram cosine similarity of a and b.
+func CosineSimilarity(a, b string) float64 {
+	return NGramCosine{N: 2}.Similarity(a, b)
+}
@@ -0,0 +1,45 @@
+package similarity
+
+// Dice computes the Sørensen–Dice coefficient between the n-gram
+// multisets of two strings: twice the size of their n-gram intersection
+// divided by the combined size of both multisets.
+type Dice struct {
+	// N is the n-gram length. The zero value uses 2 (bigrams).
+	N int
+}
+
+// Similarity implements Metric.
+func (m Dice) Similarity(a, b string) float64 {
+	n := m.N
+	if n <= 0 {
+		n = 2
+	}
+	va, vb := ngramCounts(a, n), ngramCounts(b, n)
+
+	totalA, totalB := 0, 0
+	for _, c := range va {
+		totalA += c
+	}
+	for _, c := range vb {
+		totalB += c
+	}
+	if totalA+totalB == 0 {
+		if a == b {
+			return 1
+		}
+		return 0
+	}
+
+	overlap := 0
+	for gram, ca := range va {
+		if cb, ok := vb[gram]; ok {
+			overlap += min(ca, cb)
+		}
+	}
+	return 2 * float64(overlap) / float64(totalA+totalB)
+}
+
+// DiceCoefficient returns the bigram Sørensen–Dice coefficient of a and b.
+func DiceCoefficient(a, b string) float64 {
+	return Dice{N: 2}.Similarity(a, b)
+}
@@ -0,0 +1,101 @@
+package similarity
+
+// Jaro computes the Jaro similarity: a function of the number of
+// matching characters within a bounded window and the number of
+// transpositions among them.
+type Jaro struct{}
+
+// Similarity implements Metric.
+func (Jaro) Similarity(a, b string) float64 {
+	return JaroSimilarity(a, b)
+}
+
+// JaroSimilarity returns the Jaro similarity of a and b in [0, 1].
+func JaroSimilarity(a, b string) float64 {
+	la, lb := len(a), len(b)
+	if la == 0 && lb == 0 {
+		return 1
+	}
+	if la == 0 || lb == 0 {
+		return 0
+	}
+
+	matchDistance := max(la, lb)/2 - 1
+	if matchDistance < 0 {
+		matchDistance = 0
+	}
+
+	aMatched := make([]bool, la)
+	bMatched := make([]bool, lb)
+	matches := 0
+	for i := 0; i < la; i++ {
+		start := max(0, i-matchDistance)
+		end := min(lb-1, i+matchDistance)
+		for j := start; j <= end; j++ {
+			if bMatched[j] || a[i] != b[j] {
+				continue
+			}
+			aMatched[i] = true
+			bMatched[j] = true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := 0; i < la; i++ {
+		if !aMatched[i] {
+			continue
+		}
+		for !bMatched[k] {
+			k++
+		}
+		if a[i] != b[k] {
+			transpositions++
+		}
+		k++
+	}
+	transpositions /= 2
+
+	m := float64(matches)
+	return (m/float64(la) + m/float64(lb) + (m-float64(transpositions))/m) / 3
+}
+
+// JaroWinkler computes the Jaro-Winkler similarity: the Jaro similarity
+// boosted for strings that share a common prefix, on the grounds that
+// typos are less likely near the start of a word than the end.
+type JaroWinkler struct {
+	// PrefixScale weights how much of a boost the shared prefix gives,
+	// per matching prefix character. The zero value uses the standard
+	// 0.1; PrefixScale*min(prefix length, 4) must stay below 1 or the
+	// result can exceed 1.
+	PrefixScale float64
+}
+
+// Similarity implements Metric.
+func (w JaroWinkler) Similarity(a, b string) float64 {
+	scale := w.PrefixScale
+	if scale <= 0 {
+		scale = 0.1
+	}
+
+	jaro := JaroSimilarity(a, b)
+
+	const maxPrefix = 4
+	prefix := 0
+	for prefix < maxPrefix && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*scale*(1-jaro)
+}
+
+// JaroWinklerSimilarity returns the Jaro-Winkler similarity of a and b
+// using the standard prefix scale of 0.1.
+func JaroWinklerSimilarity(a, b string) float64 {
+	return JaroWinkler{}.Similarity(a, b)
+}
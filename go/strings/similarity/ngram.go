@@ -0,0 +1,20 @@
+package similarity
+
+// ngramCounts returns the multiset of every contiguous n-byte substring
+// of s, as occurrence counts. Strings shorter than n contribute their
+// single whole self as one "gram" rather than an empty set, so very
+// short strings still compare as partially similar to each other.
+func ngramCounts(s string, n int) map[string]int {
+	counts := make(map[string]int)
+	if len(s) == 0 {
+		return counts
+	}
+	if len(s) < n {
+		counts[s]++
+		return counts
+	}
+	for i := 0; i+n <= len(s); i++ {
+		counts[s[i:i+n]]++
+	}
+	return counts
+}
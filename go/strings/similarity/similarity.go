@@ -0,0 +1,13 @@
+// Package similarity implements normalized string similarity metrics —
+// Jaro, Jaro-Winkler, n-gram cosine similarity, and the Sørensen–Dice
+// coefficient — each returning a float64 in [0, 1] behind the common
+// Metric interface, for fuzzy-matching use cases (typo-tolerant lookups,
+// record linkage, deduplication) that exact matching like strings/kmp
+// or strings/rabinkarp can't serve.
+package similarity
+
+// Metric computes a normalized similarity score between 0 (completely
+// dissimilar) and 1 (identical) for two strings.
+type Metric interface {
+	Similarity(a, b string) float64
+}
@@ -0,0 +1,102 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-4
+}
+
+func TestJaroSimilarity(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want float64
+	}{
+		{"", "", 1},
+		{"", "abc", 0},
+		{"MARTHA", "MARHTA", 0.9444},
+		{"DIXON", "DICKSONX", 0.7667},
+		{"same", "same", 1},
+	}
+	for _, c := range cases {
+		if got := JaroSimilarity(c.a, c.b); !almostEqual(got, c.want) {
+			t.Errorf("JaroSimilarity(%q, %q) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestJaroSimilarityIsSymmetric(t *testing.T) {
+	pairs := [][2]string{{"MARTHA", "MARHTA"}, {"hello", "world"}, {"kitten", "sitting"}}
+	for _, p := range pairs {
+		if got, want := JaroSimilarity(p[0], p[1]), JaroSimilarity(p[1], p[0]); !almostEqual(got, want) {
+			t.Errorf("JaroSimilarity(%q, %q) = %v != JaroSimilarity(%q, %q) = %v", p[0], p[1], got, p[1], p[0], want)
+		}
+	}
+}
+
+func TestJaroWinklerBoostsSharedPrefix(t *testing.T) {
+	jaro := JaroSimilarity("DIXON", "DICKSONX")
+	winkler := JaroWinklerSimilarity("DIXON", "DICKSONX")
+	if winkler <= jaro {
+		t.Errorf("JaroWinklerSimilarity(%.4f) did not boost over JaroSimilarity(%.4f) for a shared prefix", winkler, jaro)
+	}
+}
+
+func TestJaroWinklerKnownValue(t *testing.T) {
+	if got, want := JaroWinklerSimilarity("MARTHA", "MARHTA"), 0.9611; !almostEqual(got, want) {
+		t.Errorf("JaroWinklerSimilarity(MARTHA, MARHTA) = %v, want %v", got, want)
+	}
+}
+
+func TestCosineSimilarityIdentical(t *testing.T) {
+	if got := CosineSimilarity("hello world", "hello world"); !almostEqual(got, 1) {
+		t.Errorf("CosineSimilarity of identical strings = %v, want 1", got)
+	}
+}
+
+func TestCosineSimilarityNoOverlap(t *testing.T) {
+	if got := CosineSimilarity("aaaa", "zzzz"); got != 0 {
+		t.Errorf("CosineSimilarity(aaaa, zzzz) = %v, want 0", got)
+	}
+}
+
+func TestCosineSimilarityPartialOverlap(t *testing.T) {
+	got := CosineSimilarity("night", "nacht")
+	if got <= 0 || got >= 1 {
+		t.Errorf("CosineSimilarity(night, nacht) = %v, want in (0, 1)", got)
+	}
+}
+
+func TestDiceCoefficientIdentical(t *testing.T) {
+	if got := DiceCoefficient("night", "night"); !almostEqual(got, 1) {
+		t.Errorf("DiceCoefficient of identical strings = %v, want 1", got)
+	}
+}
+
+func TestDiceCoefficientKnownValue(t *testing.T) {
+	// bigrams("night") = {ni, ig, gh, ht}; bigrams("nacht") = {na, ac, ch, ht}
+	// intersection = {ht}, so dice = 2*1/(4+4) = 0.25
+	if got, want := DiceCoefficient("night", "nacht"), 0.25; !almostEqual(got, want) {
+		t.Errorf("DiceCoefficient(night, nacht) = %v, want %v", got, want)
+	}
+}
+
+func TestDiceCoefficientEmptyInputs(t *testing.T) {
+	if got := DiceCoefficient("", ""); got != 1 {
+		t.Errorf("DiceCoefficient(\"\", \"\") = %v, want 1", got)
+	}
+	if got := DiceCoefficient("", "abc"); got != 0 {
+		t.Errorf("DiceCoefficient(\"\", \"abc\") = %v, want 0", got)
+	}
+}
+
+func TestMetricInterfaceSatisfaction(t *testing.T) {
+	metrics := []Metric{Jaro{}, JaroWinkler{}, NGramCosine{}, Dice{}}
+	for _, m := range metrics {
+		if got := m.Similarity("abc", "abc"); !almostEqual(got, 1) {
+			t.Errorf("%T.Similarity(abc, abc) = %v, want 1", m, got)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package stringmetrics
+
+// OpKind identifies one edit operation in an Alignment.
+type OpKind int
+
+const (
+	// OpMatch means the characters at this position are already equal.
+	OpMatch OpKind = iota
+	// OpSubstitute replaces a character from a with one from b.
+	OpSubstitute
+	// OpInsert inserts a character from b with no corresponding character in a.
+	OpInsert
+	// OpDelete deletes a character from a with no corresponding character in b.
+	OpDelete
+)
+
+func (k OpKind) String() string {
+	switch k {
+	case OpMatch:
+		return "match"
+	case OpSubstitute:
+		return "substitute"
+	case OpInsert:
+		return "insert"
+	case OpDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Op is one edit operation in an alignment between two strings. A and B
+// hold the byte involved on each side, using 0 for the side an
+// OpInsert/OpDelete has nothing to contribute on.
+type Op struct {
+	Kind OpKind
+	A, B byte
+}
+
+// Align returns the sequence of edit operations transforming a into b
+// at minimum total cost under costs, alongside that cost, by building
+// Levenshtein's DP table and tracing a minimum-cost path back from
+// table[len(a)][len(b)] to table[0][0]. Where more than one operation
+// achieves the minimum cost at a cell, it prefers a match, then a
+// substitution, then a deletion, then an insertion.
+func Align(a, b string, costs Costs) ([]Op, int) {
+	table := levenshteinTable(a, b, costs)
+	total := table[len(a)][len(b)]
+
+	var ops []Op
+	i, j := len(a), len(b)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && a[i-1] == b[j-1] && table[i][j] == table[i-1][j-1]:
+			ops = append(ops, Op{Kind: OpMatch, A: a[i-1], B: b[j-1]})
+			i--
+			j--
+		case i > 0 && j > 0 && table[i][j] == table[i-1][j-1]+costs.Substitute:
+			ops = append(ops, Op{Kind: OpSubstitute, A: a[i-1], B: b[j-1]})
+			i--
+			j--
+		case i > 0 && table[i][j] == table[i-1][j]+costs.Delete:
+			ops = append(ops, Op{Kind: OpDelete, A: a[i-1]})
+			i--
+		default:
+			ops = append(ops, Op{Kind: OpInsert, B: b[j-1]})
+			j--
+		}
+	}
+
+	for l, r := 0, len(ops)-1; l < r; l, r = l+1, r-1 {
+		ops[l], ops[r] = ops[r], ops[l]
+	}
+	return ops, total
+}
@@ -0,0 +1,39 @@
+package stringmetrics
+
+// DamerauLevenshtein returns the minimum total cost, under costs, of
+// turning a into b using single-character insertions, deletions,
+// substitutions, and transpositions of two adjacent characters (each
+// charged the same as a substitution, matching the classic "optimal
+// string alignment" restriction that a substring may not be edited more
+// than once).
+func DamerauLevenshtein(a, b string, costs Costs) int {
+	n, m := len(a), len(b)
+	table := make([][]int, n+1)
+	for i := range table {
+		table[i] = make([]int, m+1)
+	}
+	for i := 1; i <= n; i++ {
+		table[i][0] = i * costs.Delete
+	}
+	for j := 1; j <= m; j++ {
+		table[0][j] = j * costs.Insert
+	}
+
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m; j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1]
+			} else {
+				table[i][j] = min3(
+					table[i-1][j]+costs.Delete,
+					table[i][j-1]+costs.Insert,
+					table[i-1][j-1]+costs.Substitute,
+				)
+			}
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				table[i][j] = min(table[i][j], table[i-2][j-2]+costs.Substitute)
+			}
+		}
+	}
+	return table[n][m]
+}
@@ -0,0 +1,27 @@
+package stringmetrics
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLengthMismatch is returned by Hamming when its two inputs have
+// different lengths, since Hamming distance is only defined between
+// equal-length strings.
+var ErrLengthMismatch = errors.New("stringmetrics: inputs have different lengths")
+
+// Hamming returns the number of positions at which a and b differ,
+// each charged costs.Substitute, or an error wrapping ErrLengthMismatch
+// if len(a) != len(b).
+func Hamming(a, b string, costs Costs) (int, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("%w: %d vs %d", ErrLengthMismatch, len(a), len(b))
+	}
+	cost := 0
+	for i := range a {
+		if a[i] != b[i] {
+			cost += costs.Substitute
+		}
+	}
+	return cost, nil
+}
@@ -0,0 +1,81 @@
+package stringmetrics
+
+// Levenshtein returns the minimum total cost, under costs, of turning a
+// into b using single-character insertions, deletions, and
+// substitutions. It builds the full (len(a)+1)x(len(b)+1) DP table, so
+// Align can reuse the same table to recover the operations; use
+// LevenshteinLinearSpace instead if you only need the distance and a or
+// b is too long to afford that table.
+func Levenshtein(a, b string, costs Costs) int {
+	table := levenshteinTable(a, b, costs)
+	return table[len(a)][len(b)]
+}
+
+// levenshteinTable builds the full DP table for a and b under costs,
+// where table[i][j] is the distance between a[:i] and b[:j].
+func levenshteinTable(a, b string, costs Costs) [][]int {
+	n, m := len(a), len(b)
+	table := make([][]int, n+1)
+	for i := range table {
+		table[i] = make([]int, m+1)
+	}
+	for i := 1; i <= n; i++ {
+		table[i][0] = i * costs.Delete
+	}
+	for j := 1; j <= m; j++ {
+		table[0][j] = j * costs.Insert
+	}
+
+	for i := 1; i <= n; i++ {
+		for j := 1; j <= m; j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1]
+				continue
+			}
+			table[i][j] = min3(
+				table[i-1][j]+costs.Delete,
+				table[i][j-1]+costs.Insert,
+				table[i-1][j-1]+costs.Substitute,
+			)
+		}
+	}
+	return table
+}
+
+// LevenshteinLinearSpace computes the same distance as Levenshtein but
+// keeps only two rows of the DP table alive at once, trading the
+// ability to call Align (which needs the full table) for O(min(n,m))
+// space instead of O(n*m) — useful when a and b are too long to build
+// the full table for.
+func LevenshteinLinearSpace(a, b string, costs Costs) int {
+	if len(a) < len(b) {
+		// distance(a, b, Insert, Delete) == distance(b, a, Delete, Insert):
+		// swapping which string is "a" swaps which operation grows it.
+		a, b = b, a
+		costs.Insert, costs.Delete = costs.Delete, costs.Insert
+	}
+	n, m := len(a), len(b)
+
+	prev := make([]int, m+1)
+	for j := 0; j <= m; j++ {
+		prev[j] = j * costs.Insert
+	}
+
+	curr := make([]int, m+1)
+	for i := 1; i <= n; i++ {
+		curr[0] = i * costs.Delete
+		for j := 1; j <= m; j++ {
+			if a[i-1] == b[j-1] {
+				curr[j] = prev[j-1]
+				continue
+			}
+			curr[j] = min3(
+				prev[j]+costs.Delete,
+				curr[j-1]+costs.Insert,
+				prev[j-1]+costs.Substitute,
+			)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[m]
+}
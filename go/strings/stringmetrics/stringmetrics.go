@@ -0,0 +1,26 @@
+// Package stringmetrics implements edit-distance algorithms over byte
+// strings: Levenshtein distance (insert/delete/substitute), Damerau-
+// Levenshtein distance (Levenshtein plus adjacent transpositions), and
+// Hamming distance (substitutions only, equal-length inputs only) — all
+// with caller-configurable per-operation costs, plus Align for
+// recovering the actual edit operations behind a Levenshtein distance
+// and LevenshteinLinearSpace for computing just the distance in
+// O(min(n,m)) space when the full O(n*m) DP table would be too big to
+// keep around.
+package stringmetrics
+
+// Costs weights each edit operation. The zero value is invalid for
+// computing a distance (every operation would be free); use
+// DefaultCosts for the classic unit-cost Levenshtein/Damerau-Levenshtein
+// distance.
+type Costs struct {
+	Insert, Delete, Substitute int
+}
+
+// DefaultCosts charges 1 for every operation, giving the classic
+// Levenshtein/Damerau-Levenshtein distance.
+var DefaultCosts = Costs{Insert: 1, Delete: 1, Substitute: 1}
+
+func min3(a, b, c int) int {
+	return min(a, min(b, c))
+}
@@ -0,0 +1,154 @@
+package stringmetrics
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"same", "same", 0},
+	}
+	for _, c := range cases {
+		if got := Levenshtein(c.a, c.b, DefaultCosts); got != c.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestLevenshteinConfigurableCosts(t *testing.T) {
+	// Deletion-only transform: "abc" -> "a" needs two deletions.
+	costs := Costs{Insert: 100, Delete: 1, Substitute: 100}
+	if got := Levenshtein("abc", "a", costs); got != 2 {
+		t.Errorf("Levenshtein with cheap deletes = %d, want 2", got)
+	}
+}
+
+func TestLevenshteinLinearSpaceMatchesLevenshtein(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	alphabet := "abc"
+	randString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return string(b)
+	}
+
+	for trial := 0; trial < 50; trial++ {
+		a, b := randString(rng.Intn(10)), randString(rng.Intn(10))
+		want := Levenshtein(a, b, DefaultCosts)
+		if got := LevenshteinLinearSpace(a, b, DefaultCosts); got != want {
+			t.Fatalf("LevenshteinLinearSpace(%q, %q) = %d, want %d (Levenshtein)", a, b, got, want)
+		}
+	}
+}
+
+func TestLevenshteinLinearSpaceAsymmetricCosts(t *testing.T) {
+	costs := Costs{Insert: 5, Delete: 2, Substitute: 3}
+	a, b := "abcdef", "ab"
+	want := Levenshtein(a, b, costs)
+	if got := LevenshteinLinearSpace(a, b, costs); got != want {
+		t.Errorf("LevenshteinLinearSpace(%q, %q) = %d, want %d", a, b, got, want)
+	}
+	// Also check the case that forces the internal swap (len(a) < len(b)).
+	want2 := Levenshtein(b, a, costs)
+	if got := LevenshteinLinearSpace(b, a, costs); got != want2 {
+		t.Errorf("LevenshteinLinearSpace(%q, %q) = %d, want %d", b, a, got, want2)
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"ab", "ba", 1},         // single transposition
+		{"abc", "acb", 1},       // single transposition
+		{"abcdef", "badcfe", 3}, // three disjoint transpositions
+		{"kitten", "sitting", 3},
+	}
+	for _, c := range cases {
+		if got := DamerauLevenshtein(c.a, c.b, DefaultCosts); got != c.want {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestDamerauNeverExceedsLevenshtein(t *testing.T) {
+	cases := [][2]string{{"ab", "ba"}, {"abcdef", "badcfe"}, {"hello", "world"}}
+	for _, c := range cases {
+		lev := Levenshtein(c[0], c[1], DefaultCosts)
+		dam := DamerauLevenshtein(c[0], c[1], DefaultCosts)
+		if dam > lev {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d exceeds Levenshtein = %d", c[0], c[1], dam, lev)
+		}
+	}
+}
+
+func TestHamming(t *testing.T) {
+	got, err := Hamming("karolin", "kathrin", DefaultCosts)
+	if err != nil {
+		t.Fatalf("Hamming: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("Hamming(karolin, kathrin) = %d, want 3", got)
+	}
+}
+
+func TestHammingLengthMismatch(t *testing.T) {
+	_, err := Hamming("abc", "ab", DefaultCosts)
+	if err == nil {
+		t.Fatalf("Hamming with mismatched lengths: got nil error")
+	}
+}
+
+func opsApply(a string, ops []Op) string {
+	var b []byte
+	for _, op := range ops {
+		switch op.Kind {
+		case OpMatch, OpSubstitute, OpInsert:
+			b = append(b, op.B)
+		case OpDelete:
+			// contributes nothing to b
+		}
+	}
+	return string(b)
+}
+
+func TestAlign(t *testing.T) {
+	cases := []struct{ a, b string }{
+		{"kitten", "sitting"},
+		{"", "abc"},
+		{"abc", ""},
+		{"flaw", "lawn"},
+		{"same", "same"},
+	}
+	for _, c := range cases {
+		ops, cost := Align(c.a, c.b, DefaultCosts)
+		if want := Levenshtein(c.a, c.b, DefaultCosts); cost != want {
+			t.Errorf("Align(%q, %q) cost = %d, want %d", c.a, c.b, cost, want)
+		}
+		if got := opsApply(c.a, ops); got != c.b {
+			t.Errorf("Align(%q, %q) ops reconstruct %q, want %q", c.a, c.b, got, c.b)
+		}
+
+		consumedA := 0
+		for _, op := range ops {
+			if op.Kind != OpInsert {
+				consumedA++
+			}
+		}
+		if consumedA != len(c.a) {
+			t.Errorf("Align(%q, %q) ops consume %d bytes of a, want %d", c.a, c.b, consumedA, len(c.a))
+		}
+	}
+}
@@ -1,10 +1,12 @@
+package stringops
+
 // Given two non-negative integers num1 and num2 represented as strings, return the product of num1 and num2, also represented as a string.
 // Example 1:
 
 // Input: num1 = "2", num2 = "3"
 // Output: "6"
 
-func multiply(num1 string, num2 string) string {
+func Multiply(num1 string, num2 string) string {
 	if num1 == "0" || num2 == "0" {
 		return "0"
 	}
@@ -30,4 +32,4 @@ func multiply(num1 string, num2 string) string {
 		ans = append(ans, byte('0'+arr[i]))
 	}
 	return string(ans)
-}
\ No newline at end of file
+}
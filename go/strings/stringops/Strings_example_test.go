@@ -0,0 +1,8 @@
+package stringops
+
+import "fmt"
+
+func ExampleMultiply() {
+	fmt.Println(Multiply("2", "3"))
+	// Output: 6
+}
@@ -0,0 +1,26 @@
+package stringops
+
+import "testing"
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		name       string
+		num1, num2 string
+		want       string
+	}{
+		{"zero first operand", "0", "123", "0"},
+		{"zero second operand", "123", "0", "0"},
+		{"single digits", "2", "3", "6"},
+		{"carrying", "99", "99", "9801"},
+		{"larger numbers", "123", "456", "56088"},
+		{"one", "1", "999999", "999999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Multiply(tt.num1, tt.num2); got != tt.want {
+				t.Errorf("Multiply(%q, %q) = %q, want %q", tt.num1, tt.num2, got, tt.want)
+			}
+		})
+	}
+}
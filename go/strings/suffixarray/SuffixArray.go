@@ -1,7 +1,6 @@
-package main
+package suffixarray
 
 import (
-	"fmt"
 	"sort"
 )
 
@@ -11,7 +10,7 @@ type suffix struct {
 }
 
 // buildSuffixArray builds a suffix array for string s
-func buildSuffixArray(s string) []int {
+func BuildSuffixArray(s string) []int {
 	n := len(s)
 	suffixes := make([]suffix, n)
 
@@ -76,11 +75,3 @@ func buildSuffixArray(s string) []int {
 	}
 	return suffixArr
 }
-
-func main() {
-	text := "banana"
-	suffixArr := buildSuffixArray(text)
-
-	fmt.Println("Text:", text)
-	fmt.Println("Suffix Array:", suffixArr)
-}
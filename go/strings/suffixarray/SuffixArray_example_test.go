@@ -0,0 +1,12 @@
+package suffixarray
+
+import "fmt"
+
+func ExampleBuildSuffixArray() {
+	text := "banana"
+	fmt.Println("Text:", text)
+	fmt.Println("Suffix Array:", BuildSuffixArray(text))
+	// Output:
+	// Text: banana
+	// Suffix Array: [5 3 1 0 4 2]
+}
@@ -0,0 +1,118 @@
+package suffixarray
+
+import "strings"
+
+// SuffixArray bundles a string's suffix array with its Kasai LCP array,
+// so substring queries don't have to rebuild either on every call.
+type SuffixArray struct {
+	text string
+	sa   []int
+	lcp  []int
+}
+
+// New builds a SuffixArray for s: the O(n log n) prefix-doubling suffix
+// array from BuildSuffixArray, plus its LCP array via Kasai's algorithm.
+func New(s string) *SuffixArray {
+	sa := BuildSuffixArray(s)
+	return &SuffixArray{text: s, sa: sa, lcp: kasaiLCP(s, sa)}
+}
+
+// Array returns the underlying suffix array: Array()[i] is the starting
+// index of the i-th suffix in lexicographic order.
+func (s *SuffixArray) Array() []int {
+	return s.sa
+}
+
+// LCP returns the Kasai LCP array: LCP()[i] is the length of the longest
+// common prefix between the suffixes at Array()[i-1] and Array()[i].
+// LCP()[0] is always 0, since there's no preceding suffix to compare
+// against.
+func (s *SuffixArray) LCP() []int {
+	return s.lcp
+}
+
+// kasaiLCP computes the LCP array for s given its suffix array sa, in
+// O(n): the key observation is that the LCP of two suffixes never drops
+// by more than one step as the comparison point i advances to i+1, so
+// the running match length h only ever needs to be extended, not
+// recomputed from scratch.
+func kasaiLCP(s string, sa []int) []int {
+	n := len(s)
+	lcp := make([]int, n)
+	if n == 0 {
+		return lcp
+	}
+
+	rankOf := make([]int, n)
+	for rank, suffix := range sa {
+		rankOf[suffix] = rank
+	}
+
+	h := 0
+	for i := 0; i < n; i++ {
+		if rankOf[i] == 0 {
+			h = 0
+			continue
+		}
+		j := sa[rankOf[i]-1]
+		for i+h < n && j+h < n && s[i+h] == s[j+h] {
+			h++
+		}
+		lcp[rankOf[i]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return lcp
+}
+
+// Contains reports whether substr occurs anywhere in the indexed text.
+// It binary-searches the suffix array for the first suffix that could
+// start with substr, in O(len(substr) * log n).
+func (s *SuffixArray) Contains(substr string) bool {
+	if substr == "" {
+		return true
+	}
+	lo, hi := 0, len(s.sa)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if s.text[s.sa[mid]:] < substr {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo < len(s.sa) && strings.HasPrefix(s.text[s.sa[lo]:], substr)
+}
+
+// CountDistinctSubstrings returns the number of distinct (non-empty)
+// substrings of the indexed text. Each suffix sa[i] contributes
+// len(text)-sa[i] substrings (one per prefix of that suffix), of which
+// the first lcp[i] are duplicates already counted by the previous
+// suffix in sorted order.
+func (s *SuffixArray) CountDistinctSubstrings() int {
+	total := 0
+	for i, start := range s.sa {
+		total += len(s.text) - start - s.lcp[i]
+	}
+	return total
+}
+
+// LongestRepeatedSubstring returns a longest substring of the indexed
+// text that occurs at least twice (with overlapping occurrences
+// counted), or "" if no substring repeats. Since adjacent suffixes in
+// the suffix array share the longest prefixes, this is just the suffix
+// at the position of the maximum LCP value, truncated to that length.
+func (s *SuffixArray) LongestRepeatedSubstring() string {
+	best, bestAt := 0, -1
+	for i, l := range s.lcp {
+		if l > best {
+			best, bestAt = l, i
+		}
+	}
+	if bestAt == -1 {
+		return ""
+	}
+	start := s.sa[bestAt]
+	return s.text[start : start+best]
+}
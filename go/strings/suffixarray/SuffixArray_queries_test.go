@@ -0,0 +1,84 @@
+package suffixarray
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func bruteForceCountDistinctSubstrings(s string) int {
+	seen := make(map[string]struct{})
+	for i := 0; i < len(s); i++ {
+		for j := i + 1; j <= len(s); j++ {
+			seen[s[i:j]] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
+func TestSuffixArrayLCP(t *testing.T) {
+	sa := New("banana")
+	if got, want := sa.Array(), []int{5, 3, 1, 0, 4, 2}; !slices.Equal(got, want) {
+		t.Fatalf("Array() = %v, want %v", got, want)
+	}
+	// Suffixes in order: a, ana, anana, banana, na, nana.
+	if got, want := sa.LCP(), []int{0, 1, 3, 0, 0, 2}; !slices.Equal(got, want) {
+		t.Errorf("LCP() = %v, want %v", got, want)
+	}
+}
+
+func TestContains(t *testing.T) {
+	sa := New("banana")
+	tests := []struct {
+		substr string
+		want   bool
+	}{
+		{"ana", true},
+		{"ban", true},
+		{"nana", true},
+		{"banana", true},
+		{"", true},
+		{"xyz", false},
+		{"bananaz", false},
+	}
+	for _, tt := range tests {
+		if got := sa.Contains(tt.substr); got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.substr, got, tt.want)
+		}
+	}
+}
+
+func TestCountDistinctSubstrings(t *testing.T) {
+	tests := []string{"banana", "aaaa", "abcd", "a", ""}
+	for _, s := range tests {
+		sa := New(s)
+		if got, want := sa.CountDistinctSubstrings(), bruteForceCountDistinctSubstrings(s); got != want {
+			t.Errorf("CountDistinctSubstrings(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestLongestRepeatedSubstring(t *testing.T) {
+	sa := New("banana")
+	got := sa.LongestRepeatedSubstring()
+	if got != "ana" {
+		t.Errorf("LongestRepeatedSubstring() = %q, want %q", got, "ana")
+	}
+
+	noRepeat := New("abcdef")
+	if got := noRepeat.LongestRepeatedSubstring(); got != "" {
+		t.Errorf("LongestRepeatedSubstring() on a repeat-free string = %q, want \"\"", got)
+	}
+}
+
+func TestLongestRepeatedSubstringIsActuallyRepeated(t *testing.T) {
+	text := "the quick brown fox the quick fox jumps"
+	sa := New(text)
+	got := sa.LongestRepeatedSubstring()
+	if got == "" {
+		t.Fatal("expected a non-empty repeated substring")
+	}
+	if strings.Count(text, got) < 2 {
+		t.Errorf("%q does not actually repeat in %q", got, text)
+	}
+}
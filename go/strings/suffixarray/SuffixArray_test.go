@@ -0,0 +1,28 @@
+package suffixarray
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuildSuffixArray(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []int
+	}{
+		{"single character", "a", []int{0}},
+		{"all same character", "aaaa", []int{3, 2, 1, 0}},
+		{"banana", "banana", []int{5, 3, 1, 0, 4, 2}},
+		{"no repeats", "abcd", []int{0, 1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildSuffixArray(tt.in)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("BuildSuffixArray(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package suffixautomaton
+
+import (
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/strings/kmp"
+)
+
+// LongestCommonSubstrings returns a longest string that occurs as a
+// substring of every string in strs, together with every position at
+// which it occurs in each one (strs[i]'s positions are positions[i]). If
+// no non-empty common substring exists (including when strs is empty),
+// it returns "" and positions[i] is nil for every i.
+//
+// It builds a suffix automaton over strs[0], then walks every other
+// string through that automaton the same way LongestCommonSubstring
+// does for a single pair, recording for each automaton state the best
+// match length achieved and propagating it down the suffix-link tree so
+// that a state's match length also bounds every suffix of its
+// substrings. The automaton state whose match length, minimized over
+// all of strs, is largest identifies the longest common substring; its
+// positions in each string are then found with the kmp package (the
+// automaton only needs to name the substring, not enumerate every
+// occurrence).
+func LongestCommonSubstrings(strs []string) (string, [][]int) {
+	positions := make([][]int, len(strs))
+	if len(strs) == 0 {
+		return "", positions
+	}
+
+	sa := New(strs[0])
+	n := len(sa.states)
+	order := statesByDecreasingLength(sa)
+
+	combined := make([]int, n)
+	for v, st := range sa.states {
+		combined[v] = st.length
+	}
+	for _, t := range strs[1:] {
+		best := matchLengths(sa, t, order)
+		for v, m := range best {
+			if m < combined[v] {
+				combined[v] = m
+			}
+		}
+	}
+
+	bestState, bestLen := 0, 0
+	for v := 1; v < n; v++ {
+		if combined[v] > bestLen {
+			bestLen, bestState = combined[v], v
+		}
+	}
+	if bestLen == 0 {
+		return "", positions
+	}
+
+	end := endPosOf(sa, bestState, order)
+	substr := strs[0][end-bestLen : end]
+	for i, s := range strs {
+		positions[i] = kmp.FindAll(s, substr)
+	}
+	return substr, positions
+}
+
+// statesByDecreasingLength returns every automaton state index ordered by
+// decreasing state length, the order ensureCounts and matchLengths both
+// rely on to finish processing a state before propagating into its
+// (shorter) suffix-link parent.
+func statesByDecreasingLength(sa *SuffixAutomaton) []int {
+	order := make([]int, len(sa.states))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return sa.states[order[a]].length > sa.states[order[b]].length
+	})
+	return order
+}
+
+// matchLengths walks t through sa the way LongestCommonSubstring does,
+// recording at each automaton state the longest match to t ending there,
+// then propagates every state's best match down its suffix link so a
+// state's shorter suffixes (its link-tree ancestors) are credited with
+// at least as much of the match as their own length allows.
+func matchLengths(sa *SuffixAutomaton, t string, order []int) []int {
+	best := make([]int, len(sa.states))
+	state, length := 0, 0
+	for i := 0; i < len(t); i++ {
+		c := t[i]
+		for state != 0 {
+			if _, ok := sa.states[state].next[c]; ok {
+				break
+			}
+			state = sa.states[state].link
+			length = sa.states[state].length
+		}
+		if next, ok := sa.states[state].next[c]; ok {
+			state = next
+			length++
+		}
+		if length > best[state] {
+			best[state] = length
+		}
+	}
+
+	for _, v := range order {
+		link := sa.states[v].link
+		if link < 0 {
+			continue
+		}
+		if m := min(best[v], sa.states[link].length); m > best[link] {
+			best[link] = m
+		}
+	}
+	return best
+}
+
+// endPosOf returns an index p such that strs[0][p-length(state):p] is an
+// actual occurrence of state's substring class in the automaton's own
+// text. It replays the text through the automaton from the root, which
+// retraces exactly the sequence of states Extend produced while building
+// it, giving every non-clone state a genuine end position directly; a
+// clone state has no occurrence of its own, so it borrows one from any
+// state it transitions to; following out-transitions from the root always
+// reaches a genuine occurrence there, and since the transitioned-to
+// substring is longer, its occurrence position is also a valid end
+// position for the clone's shorter one.
+func endPosOf(sa *SuffixAutomaton, target int, order []int) int {
+	endPos := make([]int, len(sa.states))
+	for i := range endPos {
+		endPos[i] = -1
+	}
+
+	cur := 0
+	for i := 0; i < len(sa.text); i++ {
+		cur = sa.states[cur].next[sa.text[i]]
+		endPos[cur] = i + 1
+	}
+
+	for _, v := range order {
+		if endPos[v] != -1 {
+			continue
+		}
+		for _, w := range sa.states[v].next {
+			endPos[v] = endPos[w]
+			break
+		}
+	}
+	return endPos[target]
+}
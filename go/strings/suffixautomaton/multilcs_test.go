@@ -0,0 +1,127 @@
+package suffixautomaton
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func bruteForceLongestCommonSubstrings(strs []string) string {
+	if len(strs) == 0 {
+		return ""
+	}
+	best := ""
+	s := strs[0]
+	for i := 0; i < len(s); i++ {
+		for j := i + 1; j <= len(s); j++ {
+			cand := s[i:j]
+			if len(cand) <= len(best) {
+				continue
+			}
+			ok := true
+			for _, other := range strs[1:] {
+				if !strings.Contains(other, cand) {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				best = cand
+			}
+		}
+	}
+	return best
+}
+
+func TestLongestCommonSubstringsThreeStrings(t *testing.T) {
+	strs := []string{"abcdxyz", "xyzabcd", "zzzabcdpqr"}
+	got, positions := LongestCommonSubstrings(strs)
+
+	want := bruteForceLongestCommonSubstrings(strs)
+	if len(got) != len(want) {
+		t.Fatalf("LongestCommonSubstrings length = %d (%q), want length %d (%q)", len(got), got, len(want), want)
+	}
+	for i, s := range strs {
+		if !strings.Contains(s, got) {
+			t.Errorf("result %q does not occur in strs[%d] = %q", got, i, s)
+		}
+		if len(positions[i]) == 0 {
+			t.Errorf("positions[%d] is empty for result %q in %q", i, got, s)
+		}
+		for _, p := range positions[i] {
+			if s[p:p+len(got)] != got {
+				t.Errorf("strs[%d][%d:%d] = %q, want %q", i, p, p+len(got), s[p:p+len(got)], got)
+			}
+		}
+	}
+}
+
+func TestLongestCommonSubstringsNoCommonSubstring(t *testing.T) {
+	got, positions := LongestCommonSubstrings([]string{"abc", "xyz"})
+	if got != "" {
+		t.Errorf("LongestCommonSubstrings = %q, want empty", got)
+	}
+	for i, p := range positions {
+		if len(p) != 0 {
+			t.Errorf("positions[%d] = %v, want empty", i, p)
+		}
+	}
+}
+
+func TestLongestCommonSubstringsSingleString(t *testing.T) {
+	got, positions := LongestCommonSubstrings([]string{"banana"})
+	if got != "banana" {
+		t.Errorf("LongestCommonSubstrings([\"banana\"]) = %q, want %q", got, "banana")
+	}
+	if len(positions) != 1 || len(positions[0]) != 1 || positions[0][0] != 0 {
+		t.Errorf("positions = %v, want [[0]]", positions)
+	}
+}
+
+func TestLongestCommonSubstringsEmptyInput(t *testing.T) {
+	got, positions := LongestCommonSubstrings(nil)
+	if got != "" {
+		t.Errorf("LongestCommonSubstrings(nil) = %q, want empty", got)
+	}
+	if len(positions) != 0 {
+		t.Errorf("positions = %v, want empty", positions)
+	}
+}
+
+func TestLongestCommonSubstringsAllOccurrencesReported(t *testing.T) {
+	strs := []string{"aabaa", "baabaab"}
+	got, positions := LongestCommonSubstrings(strs)
+	if got != "aabaa" {
+		t.Fatalf("LongestCommonSubstrings = %q, want %q", got, "aabaa")
+	}
+	for i, s := range strs {
+		want := bruteForcePositions(s, got)
+		sort.Ints(positions[i])
+		sort.Ints(want)
+		if !equalIntSlices(positions[i], want) {
+			t.Errorf("positions[%d] = %v, want %v", i, positions[i], want)
+		}
+	}
+}
+
+func bruteForcePositions(s, substr string) []int {
+	var out []int
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if s[i:i+len(substr)] == substr {
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+func equalIntSlices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
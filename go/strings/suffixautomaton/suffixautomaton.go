@@ -0,0 +1,219 @@
+// Package suffixautomaton implements a suffix automaton (the DAWG of all
+// suffixes of a string), built online in O(n) amortized states and
+// transitions, and used here to answer substring queries.
+package suffixautomaton
+
+import "sort"
+
+// state is one node of the automaton. Each state represents an
+// equivalence class of substrings that all occur at exactly the same set
+// of end positions in the text ("endpos"); length is the length of the
+// longest member of that class.
+//
+//   - next maps a byte to the state reached by appending it.
+//   - link is the state's suffix link: the state for the longest proper
+//     suffix of this state's substrings whose endpos set strictly
+//     contains this state's endpos set. Suffix links form a tree rooted
+//     at state 0 (the initial state, representing the empty string),
+//     mirroring the suffix-link tree of a suffix tree.
+//   - clone marks a state created by splitting an existing state during
+//     construction, rather than one created to represent a brand-new
+//     character appended to the automaton. Clones never contribute their
+//     own occurrence (endpos stays derived entirely from their
+//     children), which CountOccurrences relies on.
+type state struct {
+	length int
+	link   int
+	next   map[byte]int
+	clone  bool
+}
+
+// SuffixAutomaton is a suffix automaton over a fixed text, supporting
+// substring containment, occurrence counting, distinct-substring
+// counting, and longest-common-substring queries.
+type SuffixAutomaton struct {
+	states []state
+	last   int
+	text   string
+
+	cnt     []int
+	cntDone bool
+}
+
+// New builds the suffix automaton of s, extending it one character at a
+// time.
+func New(s string) *SuffixAutomaton {
+	sa := &SuffixAutomaton{
+		states: []state{{length: 0, link: -1, next: make(map[byte]int)}},
+		last:   0,
+		text:   s,
+	}
+	for i := 0; i < len(s); i++ {
+		sa.Extend(s[i])
+	}
+	return sa
+}
+
+// Extend grows the automaton online by appending c to the text it
+// represents. This is the standard incremental construction: it adds at
+// most one new state for c itself, plus at most one clone state, so the
+// whole automaton is built in O(n) states for a text of length n.
+func (sa *SuffixAutomaton) Extend(c byte) {
+	curLen := sa.states[sa.last].length + 1
+	cur := len(sa.states)
+	sa.states = append(sa.states, state{length: curLen, next: make(map[byte]int)})
+
+	p := sa.last
+	for p != -1 {
+		if _, ok := sa.states[p].next[c]; ok {
+			break
+		}
+		sa.states[p].next[c] = cur
+		p = sa.states[p].link
+	}
+
+	switch {
+	case p == -1:
+		sa.states[cur].link = 0
+	default:
+		q := sa.states[p].next[c]
+		if sa.states[p].length+1 == sa.states[q].length {
+			sa.states[cur].link = q
+		} else {
+			clone := len(sa.states)
+			sa.states = append(sa.states, state{
+				length: sa.states[p].length + 1,
+				link:   sa.states[q].link,
+				next:   copyTransitions(sa.states[q].next),
+				clone:  true,
+			})
+			for p != -1 && sa.states[p].next[c] == q {
+				sa.states[p].next[c] = clone
+				p = sa.states[p].link
+			}
+			sa.states[q].link = clone
+			sa.states[cur].link = clone
+		}
+	}
+
+	sa.last = cur
+	sa.cntDone = false
+}
+
+func copyTransitions(next map[byte]int) map[byte]int {
+	c := make(map[byte]int, len(next))
+	for k, v := range next {
+		c[k] = v
+	}
+	return c
+}
+
+// Contains reports whether substr occurs anywhere in the automaton's
+// text, by walking transitions from the initial state.
+func (sa *SuffixAutomaton) Contains(substr string) bool {
+	_, ok := sa.walk(substr)
+	return ok
+}
+
+// CountOccurrences returns the number of (possibly overlapping) times
+// substr occurs in the automaton's text.
+func (sa *SuffixAutomaton) CountOccurrences(substr string) int {
+	state, ok := sa.walk(substr)
+	if !ok {
+		return 0
+	}
+	sa.ensureCounts()
+	return sa.cnt[state]
+}
+
+// walk follows substr's transitions from the initial state, returning the
+// state reached and whether every character had a transition.
+func (sa *SuffixAutomaton) walk(substr string) (int, bool) {
+	cur := 0
+	for i := 0; i < len(substr); i++ {
+		next, ok := sa.states[cur].next[substr[i]]
+		if !ok {
+			return 0, false
+		}
+		cur = next
+	}
+	return cur, true
+}
+
+// ensureCounts computes, for every state, the size of its endpos set
+// (the number of occurrences of that state's substrings). A state that
+// wasn't created by cloning contributes exactly one occurrence (the
+// position where it was appended); every state's count also flows up to
+// its suffix-link parent, since endpos(link[v]) is a superset of
+// endpos(v). Processing states in decreasing order of length guarantees
+// each state's count is finalized before it's added to its parent's.
+func (sa *SuffixAutomaton) ensureCounts() {
+	if sa.cntDone {
+		return
+	}
+	n := len(sa.states)
+	cnt := make([]int, n)
+	for i := 1; i < n; i++ {
+		if !sa.states[i].clone {
+			cnt[i] = 1
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return sa.states[order[a]].length > sa.states[order[b]].length
+	})
+
+	for _, v := range order {
+		if link := sa.states[v].link; link >= 0 {
+			cnt[link] += cnt[v]
+		}
+	}
+	sa.cnt = cnt
+	sa.cntDone = true
+}
+
+// CountDistinctSubstrings returns the number of distinct (non-empty)
+// substrings of the automaton's text. Each state other than the root
+// contributes length(state)-length(link(state)) distinct substrings: the
+// ones obtained by extending its suffix-link parent's longest substring
+// up to its own length.
+func (sa *SuffixAutomaton) CountDistinctSubstrings() int {
+	total := 0
+	for i := 1; i < len(sa.states); i++ {
+		total += sa.states[i].length - sa.states[sa.states[i].link].length
+	}
+	return total
+}
+
+// LongestCommonSubstring returns a longest string that occurs as a
+// substring of both the automaton's text and other. It walks other
+// through the automaton one character at a time, following suffix links
+// to shrink the current match whenever a transition is missing, which
+// finds the best match ending at every position of other in a single
+// O(len(other)) pass.
+func (sa *SuffixAutomaton) LongestCommonSubstring(other string) string {
+	state, length := 0, 0
+	bestLen, bestEnd := 0, 0
+	for i := 0; i < len(other); i++ {
+		c := other[i]
+		for state != 0 {
+			if _, ok := sa.states[state].next[c]; ok {
+				break
+			}
+			state = sa.states[state].link
+			length = sa.states[state].length
+		}
+		if next, ok := sa.states[state].next[c]; ok {
+			state = next
+			length++
+		}
+		if length > bestLen {
+			bestLen, bestEnd = length, i+1
+		}
+	}
+	return other[bestEnd-bestLen : bestEnd]
+}
@@ -0,0 +1,115 @@
+package suffixautomaton
+
+import (
+	"strings"
+	"testing"
+)
+
+func bruteForceCountDistinctSubstrings(s string) int {
+	seen := make(map[string]struct{})
+	for i := 0; i < len(s); i++ {
+		for j := i + 1; j <= len(s); j++ {
+			seen[s[i:j]] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
+func bruteForceCountOccurrences(text, substr string) int {
+	if substr == "" {
+		return 0
+	}
+	count := 0
+	for i := 0; i+len(substr) <= len(text); i++ {
+		if text[i:i+len(substr)] == substr {
+			count++
+		}
+	}
+	return count
+}
+
+func TestContains(t *testing.T) {
+	sa := New("banana")
+	tests := []struct {
+		substr string
+		want   bool
+	}{
+		{"ana", true},
+		{"ban", true},
+		{"nana", true},
+		{"banana", true},
+		{"", true},
+		{"xyz", false},
+		{"bananaz", false},
+	}
+	for _, tt := range tests {
+		if got := sa.Contains(tt.substr); got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.substr, got, tt.want)
+		}
+	}
+}
+
+func TestCountOccurrences(t *testing.T) {
+	texts := []string{"banana", "aaaa", "abcabcabc", "mississippi"}
+	substrs := []string{"a", "an", "ana", "aa", "bc", "ssi", "z"}
+	for _, text := range texts {
+		sa := New(text)
+		for _, sub := range substrs {
+			got := sa.CountOccurrences(sub)
+			want := bruteForceCountOccurrences(text, sub)
+			if got != want {
+				t.Errorf("CountOccurrences(%q) on text %q = %d, want %d", sub, text, got, want)
+			}
+		}
+	}
+}
+
+func TestCountDistinctSubstrings(t *testing.T) {
+	tests := []string{"banana", "aaaa", "abcd", "a", "", "mississippi"}
+	for _, s := range tests {
+		sa := New(s)
+		if got, want := sa.CountDistinctSubstrings(), bruteForceCountDistinctSubstrings(s); got != want {
+			t.Errorf("CountDistinctSubstrings(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestLongestCommonSubstring(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		wantLen int
+	}{
+		{"abcdef", "zzcdefzz", 4},
+		{"banana", "ananas", 5},
+		{"abc", "xyz", 0},
+		{"", "abc", 0},
+		{"abc", "", 0},
+	}
+	for _, tt := range tests {
+		sa := New(tt.a)
+		got := sa.LongestCommonSubstring(tt.b)
+		if len(got) != tt.wantLen {
+			t.Errorf("LongestCommonSubstring(%q, %q) = %q (len %d), want len %d", tt.a, tt.b, got, len(got), tt.wantLen)
+		}
+		if got != "" {
+			if !strings.Contains(tt.a, got) {
+				t.Errorf("%q is not a substring of %q", got, tt.a)
+			}
+			if !strings.Contains(tt.b, got) {
+				t.Errorf("%q is not a substring of %q", got, tt.b)
+			}
+		}
+	}
+}
+
+func TestExtendOnlineMatchesWholeTextConstruction(t *testing.T) {
+	text := "abracadabra"
+	sa := New("")
+	for i := 0; i < len(text); i++ {
+		sa.Extend(text[i])
+	}
+	want := New(text)
+	if got, wantCount := sa.CountDistinctSubstrings(), want.CountDistinctSubstrings(); got != wantCount {
+		t.Errorf("incremental Extend gave CountDistinctSubstrings = %d, want %d", got, wantCount)
+	}
+}
@@ -0,0 +1,29 @@
+package zalgorithm
+
+// SmallestPeriod returns the smallest p >= 1 such that s[i] == s[i+p]
+// for every valid i, i.e. s is a prefix of infinite repetition of
+// s[:p]. It uses the standard Z-array trick: p is the smallest i such
+// that i + Z[i] == len(s), since that means the suffix starting at i
+// matches the prefix all the way to the end of s. If no such i exists,
+// s has no repetition and its only period is its own length.
+func SmallestPeriod(s string) int {
+	n := len(s)
+	if n == 0 {
+		return 0
+	}
+
+	z := ZArray(s)
+	for i := 1; i < n; i++ {
+		if i+z[i] == n {
+			return i
+		}
+	}
+	return n
+}
+
+// IsPeriodic reports whether s has a period strictly shorter than its
+// own length, i.e. whether some prefix of s, repeated, produces a
+// string s is a prefix of.
+func IsPeriodic(s string) bool {
+	return SmallestPeriod(s) < len(s)
+}
@@ -0,0 +1,40 @@
+package zalgorithm
+
+// LongestCommonPrefix returns the length of the longest common prefix of
+// a and b, computed via the Z-array of their concatenation instead of a
+// direct character-by-character scan.
+//
+// It assumes a and b don't contain the NUL byte, used internally as a
+// separator.
+func LongestCommonPrefix(a, b string) int {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	combined := a + "\x00" + b
+	z := ZArray(combined)
+	lcp := z[len(a)+1]
+	if lcp > len(a) {
+		lcp = len(a)
+	}
+	return lcp
+}
+
+// LongestSuffixPrefixOverlap returns the length of the longest suffix of
+// a that is also a prefix of b, the classic building block for
+// stitching two strings together (e.g. KMP's own failure function, or
+// merging overlapping reads). It checks every candidate overlap length
+// from longest to shortest via LongestCommonPrefix and returns the first
+// one that matches in full.
+func LongestSuffixPrefixOverlap(a, b string) int {
+	max := len(a)
+	if len(b) < max {
+		max = len(b)
+	}
+	for length := max; length > 0; length-- {
+		if LongestCommonPrefix(a[len(a)-length:], b) >= length {
+			return length
+		}
+	}
+	return 0
+}
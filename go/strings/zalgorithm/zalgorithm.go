@@ -0,0 +1,64 @@
+// Package zalgorithm implements the Z-algorithm: for a string s, Z[i] is
+// the length of the longest common prefix of s and the suffix of s
+// starting at i, computed for every i in O(n). Pattern search, period
+// detection, and prefix-matching utilities in this package are all
+// small wrappers around that one array, mirroring how strings/kmp
+// builds everything on top of its LPS array.
+package zalgorithm
+
+// ZArray computes the Z-array of s in O(len(s)) using the standard
+// two-pointer window [l, r) of the furthest-reaching Z-box found so far.
+// Z[0] is left as 0, since the prefix-vs-itself comparison it would
+// describe isn't meaningful.
+func ZArray(s string) []int {
+	n := len(s)
+	z := make([]int, n)
+	if n == 0 {
+		return z
+	}
+
+	l, r := 0, 0
+	for i := 1; i < n; i++ {
+		if i < r {
+			if rem := r - i; rem < z[i-l] {
+				z[i] = rem
+			} else {
+				z[i] = z[i-l]
+			}
+		}
+		for i+z[i] < n && s[z[i]] == s[i+z[i]] {
+			z[i]++
+		}
+		if i+z[i] > r {
+			l, r = i, i+z[i]
+		}
+	}
+	return z
+}
+
+// FindAllAppend is FindAll but appends matches to dst instead of
+// allocating its own result slice.
+//
+// It assumes pattern and text don't contain the NUL byte, which is used
+// internally as a separator between them.
+func FindAllAppend(dst []int, text, pattern string) []int {
+	m, n := len(pattern), len(text)
+	if m == 0 || m > n {
+		return dst
+	}
+
+	combined := pattern + "\x00" + text
+	z := ZArray(combined)
+	for i := m + 1; i < len(combined); i++ {
+		if z[i] >= m {
+			dst = append(dst, i-m-1)
+		}
+	}
+	return dst
+}
+
+// FindAll returns the starting index of every (possibly overlapping)
+// occurrence of pattern in text, in ascending order.
+func FindAll(text, pattern string) []int {
+	return FindAllAppend(nil, text, pattern)
+}
@@ -0,0 +1,155 @@
+package zalgorithm
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func bruteForceLCP(a, b string) int {
+	n := min(len(a), len(b))
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+func bruteForceZArray(s string) []int {
+	n := len(s)
+	z := make([]int, n)
+	for i := 1; i < n; i++ {
+		z[i] = bruteForceLCP(s, s[i:])
+	}
+	return z
+}
+
+func bruteForceFindAll(text, pattern string) []int {
+	n, m := len(text), len(pattern)
+	if m == 0 || m > n {
+		return nil
+	}
+	var matches []int
+	for i := 0; i+m <= n; i++ {
+		if text[i:i+m] == pattern {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+func TestZArray(t *testing.T) {
+	tests := []string{
+		"aabxaabxcaabxaabxay",
+		"aaaaaa",
+		"abcabcabc",
+		"a",
+		"",
+		"abcdef",
+	}
+	for _, s := range tests {
+		if got, want := ZArray(s), bruteForceZArray(s); !slices.Equal(got, want) {
+			t.Errorf("ZArray(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		text, pattern string
+	}{
+		{"abxabcabcaby", "abcaby"},
+		{"abcdef", "xyz"},
+		{"aaaa", "aa"},
+		{"mississippi", "issi"},
+		{"hello", "hello"},
+	}
+	for _, tt := range tests {
+		got := FindAll(tt.text, tt.pattern)
+		want := bruteForceFindAll(tt.text, tt.pattern)
+		if !slices.Equal(got, want) {
+			t.Errorf("FindAll(%q, %q) = %v, want %v", tt.text, tt.pattern, got, want)
+		}
+	}
+}
+
+func TestFindAllEmptyAndOversized(t *testing.T) {
+	if got := FindAll("abc", ""); got != nil {
+		t.Errorf("FindAll with empty pattern = %v, want nil", got)
+	}
+	if got := FindAll("ab", "abc"); got != nil {
+		t.Errorf("FindAll with pattern longer than text = %v, want nil", got)
+	}
+}
+
+func TestSmallestPeriod(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"abcabcabc", 3},
+		{"aaaa", 1},
+		{"abcdef", 6},
+		{"abab", 2},
+		{"a", 1},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := SmallestPeriod(tt.s); got != tt.want {
+			t.Errorf("SmallestPeriod(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestIsPeriodic(t *testing.T) {
+	if !IsPeriodic("abcabcabc") {
+		t.Error("IsPeriodic(\"abcabcabc\") = false, want true")
+	}
+	if IsPeriodic("abcdef") {
+		t.Error("IsPeriodic(\"abcdef\") = true, want false")
+	}
+}
+
+func TestLongestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"abcdef", "abcxyz"},
+		{"abc", "abc"},
+		{"abc", "xyz"},
+		{"", "abc"},
+		{"abc", ""},
+		{"ab", "abcdef"},
+	}
+	for _, tt := range tests {
+		got := LongestCommonPrefix(tt.a, tt.b)
+		want := bruteForceLCP(tt.a, tt.b)
+		if got != want {
+			t.Errorf("LongestCommonPrefix(%q, %q) = %d, want %d", tt.a, tt.b, got, want)
+		}
+	}
+}
+
+func TestLongestSuffixPrefixOverlap(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"hello wor", "world", 3},
+		{"abcdef", "defghi", 3},
+		{"abc", "xyz", 0},
+		{"abc", "abc", 3},
+	}
+	for _, tt := range tests {
+		got := LongestSuffixPrefixOverlap(tt.a, tt.b)
+		if got != tt.want {
+			t.Errorf("LongestSuffixPrefixOverlap(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got > 0 {
+			overlap := tt.a[len(tt.a)-got:]
+			if !strings.HasPrefix(tt.b, overlap) {
+				t.Errorf("overlap %q is not a prefix of %q", overlap, tt.b)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Package ahocorasick implements the Aho-Corasick string-matching
+// automaton: build it once from many patterns, then find every
+// occurrence of every pattern in a text in a single pass, in
+// O(len(text) + matches) regardless of how many patterns there are —
+// the dictionary-matching complement to the single-pattern KMP matcher
+// in strings/kmp.
+package ahocorasick
+
+// Match is one occurrence of a pattern in the searched text.
+type Match struct {
+	PatternID int
+	Start     int
+	End       int
+}
+
+type node struct {
+	children map[byte]int
+	fail     int
+	output   []int // indices into Matcher.patterns ending at this node
+}
+
+// Matcher is a built Aho-Corasick automaton over a fixed set of
+// patterns.
+type Matcher struct {
+	nodes    []node
+	patterns []string
+}
+
+// New builds a Matcher over patterns, indexed by their position in
+// patterns (so a match's PatternID can be used to look the pattern back
+// up). Empty patterns are ignored, since they'd otherwise "match"
+// everywhere.
+func New(patterns []string) *Matcher {
+	m := &Matcher{
+		nodes:    []node{{children: make(map[byte]int)}}, // root
+		patterns: patterns,
+	}
+	for id, p := range patterns {
+		if p != "" {
+			m.insert(p, id)
+		}
+	}
+	m.buildFailLinks()
+	return m
+}
+
+func (m *Matcher) insert(pattern string, id int) {
+	cur := 0
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		next, ok := m.nodes[cur].children[c]
+		if !ok {
+			m.nodes = append(m.nodes, node{children: make(map[byte]int)})
+			next = len(m.nodes) - 1
+			m.nodes[cur].children[c] = next
+		}
+		cur = next
+	}
+	m.nodes[cur].output = append(m.nodes[cur].output, id)
+}
+
+// buildFailLinks runs a BFS over the trie to compute each node's fail
+// link (the longest proper suffix of its path that is also a path from
+// the root) and merges in the output of every node reachable by
+// following fail links, so a match ending at a node also reports any
+// shorter pattern that ends there too.
+func (m *Matcher) buildFailLinks() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].children {
+		m.nodes[child].fail = 0
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for c, child := range m.nodes[cur].children {
+			fail := m.nodes[cur].fail
+			for fail != 0 {
+				if _, ok := m.nodes[fail].children[c]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].children[c]; ok && next != child {
+				m.nodes[child].fail = next
+			} else {
+				m.nodes[child].fail = 0
+			}
+			m.nodes[child].output = append(m.nodes[child].output, m.nodes[m.nodes[child].fail].output...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Search returns every match of every pattern in text, in the order
+// their occurrences end.
+func (m *Matcher) Search(text string) []Match {
+	var matches []Match
+	cur := 0
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		for cur != 0 {
+			if _, ok := m.nodes[cur].children[c]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if next, ok := m.nodes[cur].children[c]; ok {
+			cur = next
+		}
+		for _, id := range m.nodes[cur].output {
+			matches = append(matches, Match{
+				PatternID: id,
+				Start:     i - len(m.patterns[id]) + 1,
+				End:       i + 1,
+			})
+		}
+	}
+	return matches
+}
@@ -0,0 +1,85 @@
+package ahocorasick
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortedMatches(m []Match) []Match {
+	out := append([]Match{}, m...)
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Start != out[j].Start {
+			return out[i].Start < out[j].Start
+		}
+		return out[i].PatternID < out[j].PatternID
+	})
+	return out
+}
+
+func TestSearchClassicExample(t *testing.T) {
+	patterns := []string{"he", "she", "his", "hers"}
+	m := New(patterns)
+
+	got := sortedMatches(m.Search("ushers"))
+	want := []Match{
+		{PatternID: 1, Start: 1, End: 4}, // "she" at 1..4
+		{PatternID: 0, Start: 2, End: 4}, // "he" at 2..4
+		{PatternID: 3, Start: 2, End: 6}, // "hers" at 2..6
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchOverlappingAndNested(t *testing.T) {
+	patterns := []string{"a", "ab", "bc", "abc"}
+	m := New(patterns)
+
+	got := sortedMatches(m.Search("abc"))
+	want := []Match{
+		{PatternID: 0, Start: 0, End: 1}, // "a"
+		{PatternID: 1, Start: 0, End: 2}, // "ab"
+		{PatternID: 3, Start: 0, End: 3}, // "abc"
+		{PatternID: 2, Start: 1, End: 3}, // "bc"
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchNoMatches(t *testing.T) {
+	m := New([]string{"xyz"})
+	if got := m.Search("abcdef"); len(got) != 0 {
+		t.Errorf("Search() = %v, want no matches", got)
+	}
+}
+
+func TestSearchRepeatedPattern(t *testing.T) {
+	m := New([]string{"aa"})
+	got := sortedMatches(m.Search("aaaa"))
+	want := []Match{
+		{PatternID: 0, Start: 0, End: 2},
+		{PatternID: 0, Start: 1, End: 3},
+		{PatternID: 0, Start: 2, End: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchEmptyPatternIgnored(t *testing.T) {
+	m := New([]string{"", "abc"})
+	got := sortedMatches(m.Search("xabcx"))
+	want := []Match{{PatternID: 1, Start: 1, End: 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchEmptyText(t *testing.T) {
+	m := New([]string{"abc"})
+	if got := m.Search(""); len(got) != 0 {
+		t.Errorf("Search(\"\") = %v, want no matches", got)
+	}
+}
@@ -0,0 +1,54 @@
+// Package backtrack provides a reusable choose/explore/unchoose
+// backtracking engine, plus three classic applications built on top of
+// it: N-Queens, a Sudoku solver, and permutation/combination generation.
+package backtrack
+
+// Solver runs a generic backtracking search over a mutable state S using
+// moves of type M. The state is mutated in place via Choose/Unchoose
+// rather than copied at each step, matching how the classic
+// choose/explore/unchoose pattern is normally written.
+type Solver[S any, M any] struct {
+	// Moves returns the candidate moves available from the current
+	// state. It's only called on states for which IsGoal is false.
+	Moves func(state S) []M
+
+	// Choose applies move m to state, moving one level deeper into the
+	// search.
+	Choose func(state S, m M)
+
+	// Unchoose undoes move m, restoring state to what it was before
+	// the matching Choose call. It must be the exact inverse of Choose.
+	Unchoose func(state S, m M)
+
+	// IsGoal reports whether state is a complete solution.
+	IsGoal func(state S) bool
+
+	// Prune, if set, reports whether move m should be skipped without
+	// recursing into it — the engine's pruning hook. A nil Prune
+	// explores every move IsGoal hasn't already ruled out.
+	Prune func(state S, m M) bool
+}
+
+// Solve runs the search from state, calling onSolution for every
+// complete solution found. onSolution returns true to stop the search
+// immediately (e.g. "first solution only") or false to keep enumerating.
+// Solve itself returns true iff the search was stopped early this way.
+func (s *Solver[S, M]) Solve(state S, onSolution func(state S) bool) bool {
+	if s.IsGoal(state) {
+		return onSolution(state)
+	}
+	for _, m := range s.Moves(state) {
+		if s.Prune != nil && s.Prune(state, m) {
+			continue
+		}
+		s.Choose(state, m)
+		if s.Solve(state, onSolution) {
+			// A solution was found beneath this choice and the search
+			// is stopping: leave state as-is (part of the solution)
+			// instead of undoing it on the way back up.
+			return true
+		}
+		s.Unchoose(state, m)
+	}
+	return false
+}
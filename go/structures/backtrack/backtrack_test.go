@@ -0,0 +1,142 @@
+package backtrack
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCountNQueens(t *testing.T) {
+	cases := map[int]int{1: 1, 2: 0, 3: 0, 4: 2, 5: 10, 6: 4, 8: 92}
+	for n, want := range cases {
+		if got := CountNQueens(n); got != want {
+			t.Errorf("CountNQueens(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestSolveNQueensSolutionsAreValid(t *testing.T) {
+	solutions := SolveNQueens(6)
+	if len(solutions) != 4 {
+		t.Fatalf("SolveNQueens(6) returned %d solutions, want 4", len(solutions))
+	}
+	for _, sol := range solutions {
+		if !nqueensSafe(sol[:len(sol)-1], sol[len(sol)-1]) {
+			t.Errorf("solution %v has an attacking pair", sol)
+		}
+	}
+}
+
+func TestSolveSudoku(t *testing.T) {
+	board := [9][9]int{
+		{5, 3, 0, 0, 7, 0, 0, 0, 0},
+		{6, 0, 0, 1, 9, 5, 0, 0, 0},
+		{0, 9, 8, 0, 0, 0, 0, 6, 0},
+		{8, 0, 0, 0, 6, 0, 0, 0, 3},
+		{4, 0, 0, 8, 0, 3, 0, 0, 1},
+		{7, 0, 0, 0, 2, 0, 0, 0, 6},
+		{0, 6, 0, 0, 0, 0, 2, 8, 0},
+		{0, 0, 0, 4, 1, 9, 0, 0, 5},
+		{0, 0, 0, 0, 8, 0, 0, 7, 9},
+	}
+	if !SolveSudoku(&board) {
+		t.Fatal("SolveSudoku() failed to solve a solvable board")
+	}
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if board[r][c] == 0 || !sudokuValidIgnoringSelf(&board, r, c) {
+				t.Errorf("cell (%d,%d)=%d violates sudoku constraints", r, c, board[r][c])
+			}
+		}
+	}
+}
+
+// sudokuValidIgnoringSelf checks that the digit already placed at (r, c)
+// doesn't conflict with any other cell in its row, column, or box.
+func sudokuValidIgnoringSelf(board *[9][9]int, r, c int) bool {
+	digit := board[r][c]
+	board[r][c] = 0
+	ok := sudokuValid(board, r, c, digit)
+	board[r][c] = digit
+	return ok
+}
+
+func TestSolveSudokuUnsolvableLeavesBoardUnchanged(t *testing.T) {
+	// A fully solved board with exactly one cell cleared, and the digit
+	// that belongs there duplicated elsewhere in its column — so that
+	// one cell has zero valid candidates and the search dead-ends
+	// immediately instead of backtracking over a mostly-empty board.
+	board := [9][9]int{
+		{5, 3, 4, 6, 7, 8, 9, 1, 2},
+		{6, 7, 2, 1, 9, 5, 3, 4, 8},
+		{1, 9, 8, 3, 4, 2, 5, 6, 7},
+		{8, 5, 9, 7, 6, 1, 4, 2, 3},
+		{4, 2, 6, 8, 5, 3, 7, 9, 9}, // was 1; now duplicates the 9 (8,8) needs
+		{7, 1, 3, 9, 2, 4, 8, 5, 6},
+		{9, 6, 1, 5, 3, 7, 2, 8, 4},
+		{2, 8, 7, 4, 1, 9, 6, 3, 5},
+		{3, 4, 5, 2, 8, 6, 1, 7, 0}, // cleared: only missing digit is 9, now blocked
+	}
+	original := board
+
+	if SolveSudoku(&board) {
+		t.Fatal("SolveSudoku() should fail on an unsolvable board")
+	}
+	if board != original {
+		t.Errorf("board was mutated despite search failing: got %v, want %v", board, original)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	got := Permutations([]int{1, 2, 3})
+	if len(got) != 6 {
+		t.Fatalf("Permutations() returned %d results, want 6", len(got))
+	}
+
+	seen := map[string]bool{}
+	for _, p := range got {
+		key := sortedKey(p)
+		if key != "1 2 3" {
+			t.Errorf("permutation %v isn't a permutation of [1 2 3]", p)
+		}
+		permKey := permString(p)
+		if seen[permKey] {
+			t.Errorf("duplicate permutation %v", p)
+		}
+		seen[permKey] = true
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	got := Combinations([]int{1, 2, 3, 4}, 2)
+	want := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Combinations([1 2 3 4], 2) = %v, want %v", got, want)
+	}
+}
+
+func TestCombinationsEdgeCases(t *testing.T) {
+	if got := Combinations([]int{1, 2, 3}, 0); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("Combinations(_, 0) = %v, want one empty combination", got)
+	}
+	if got := Combinations([]int{1, 2, 3}, 4); got != nil {
+		t.Errorf("Combinations(_, k>len(items)) = %v, want nil", got)
+	}
+}
+
+func sortedKey(xs []int) string {
+	cp := append([]int{}, xs...)
+	sort.Ints(cp)
+	return permString(cp)
+}
+
+func permString(xs []int) string {
+	s := ""
+	for i, x := range xs {
+		if i > 0 {
+			s += " "
+		}
+		s += string(rune('0' + x))
+	}
+	return s
+}
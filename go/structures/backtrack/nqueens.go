@@ -0,0 +1,65 @@
+package backtrack
+
+// nqueensState is the board-in-progress: queens[r] is the column of the
+// queen placed in row r, for the rows placed so far.
+type nqueensState struct {
+	n      int
+	queens []int
+}
+
+func nqueensSafe(queens []int, col int) bool {
+	row := len(queens)
+	for r, c := range queens {
+		if c == col || row-r == col-c || row-r == c-col {
+			return false
+		}
+	}
+	return true
+}
+
+func nqueensSolver(n int) *Solver[*nqueensState, int] {
+	return &Solver[*nqueensState, int]{
+		Moves: func(s *nqueensState) []int {
+			cols := make([]int, s.n)
+			for i := range cols {
+				cols[i] = i
+			}
+			return cols
+		},
+		Choose:   func(s *nqueensState, col int) { s.queens = append(s.queens, col) },
+		Unchoose: func(s *nqueensState, col int) { s.queens = s.queens[:len(s.queens)-1] },
+		IsGoal:   func(s *nqueensState) bool { return len(s.queens) == s.n },
+		Prune:    func(s *nqueensState, col int) bool { return !nqueensSafe(s.queens, col) },
+	}
+}
+
+// SolveNQueens returns every placement of n mutually non-attacking queens
+// on an n x n board. Each solution is a slice of length n where
+// solution[row] is the column of the queen in that row.
+func SolveNQueens(n int) [][]int {
+	if n <= 0 {
+		return nil
+	}
+	var solutions [][]int
+	solver := nqueensSolver(n)
+	solver.Solve(&nqueensState{n: n}, func(s *nqueensState) bool {
+		solutions = append(solutions, append([]int{}, s.queens...))
+		return false
+	})
+	return solutions
+}
+
+// CountNQueens returns the number of solutions to the n-queens problem,
+// without materializing them.
+func CountNQueens(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	count := 0
+	solver := nqueensSolver(n)
+	solver.Solve(&nqueensState{n: n}, func(s *nqueensState) bool {
+		count++
+		return false
+	})
+	return count
+}
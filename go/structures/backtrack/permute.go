@@ -0,0 +1,90 @@
+package backtrack
+
+// permuteState tracks the permutation built so far and which source
+// indices have already been used.
+type permuteState[T any] struct {
+	items []T
+	used  []bool
+	perm  []T
+}
+
+// Permutations returns every permutation of items.
+func Permutations[T any](items []T) [][]T {
+	var out [][]T
+	solver := &Solver[*permuteState[T], int]{
+		Moves: func(s *permuteState[T]) []int {
+			idxs := make([]int, 0, len(s.items))
+			for i, used := range s.used {
+				if !used {
+					idxs = append(idxs, i)
+				}
+			}
+			return idxs
+		},
+		Choose: func(s *permuteState[T], i int) {
+			s.used[i] = true
+			s.perm = append(s.perm, s.items[i])
+		},
+		Unchoose: func(s *permuteState[T], i int) {
+			s.used[i] = false
+			s.perm = s.perm[:len(s.perm)-1]
+		},
+		IsGoal: func(s *permuteState[T]) bool { return len(s.perm) == len(s.items) },
+	}
+	solver.Solve(&permuteState[T]{items: items, used: make([]bool, len(items))}, func(s *permuteState[T]) bool {
+		out = append(out, append([]T{}, s.perm...))
+		return false
+	})
+	return out
+}
+
+// combineState tracks the combination built so far by the indices
+// chosen into items; the next eligible start index is derived from the
+// last chosen index rather than stored separately, so Unchoose doesn't
+// need to know what the start was before its matching Choose.
+type combineState[T any] struct {
+	items   []T
+	k       int
+	indices []int
+}
+
+func (s *combineState[T]) start() int {
+	if len(s.indices) == 0 {
+		return 0
+	}
+	return s.indices[len(s.indices)-1] + 1
+}
+
+// Combinations returns every k-element combination of items, in the
+// order their indices appear in items.
+func Combinations[T any](items []T, k int) [][]T {
+	if k < 0 || k > len(items) {
+		return nil
+	}
+	var out [][]T
+	solver := &Solver[*combineState[T], int]{
+		Moves: func(s *combineState[T]) []int {
+			idxs := make([]int, 0, len(s.items)-s.start())
+			for i := s.start(); i < len(s.items); i++ {
+				idxs = append(idxs, i)
+			}
+			return idxs
+		},
+		Choose: func(s *combineState[T], i int) {
+			s.indices = append(s.indices, i)
+		},
+		Unchoose: func(s *combineState[T], i int) {
+			s.indices = s.indices[:len(s.indices)-1]
+		},
+		IsGoal: func(s *combineState[T]) bool { return len(s.indices) == s.k },
+	}
+	solver.Solve(&combineState[T]{items: items, k: k}, func(s *combineState[T]) bool {
+		combo := make([]T, len(s.indices))
+		for j, idx := range s.indices {
+			combo[j] = s.items[idx]
+		}
+		out = append(out, combo)
+		return false
+	})
+	return out
+}
@@ -0,0 +1,67 @@
+package backtrack
+
+// sudokuMove places digit at (row, col).
+type sudokuMove struct {
+	row, col, digit int
+}
+
+func sudokuFindEmpty(board *[9][9]int) (row, col int, found bool) {
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if board[r][c] == 0 {
+				return r, c, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+func sudokuValid(board *[9][9]int, row, col, digit int) bool {
+	for i := 0; i < 9; i++ {
+		if board[row][i] == digit || board[i][col] == digit {
+			return false
+		}
+	}
+	boxRow, boxCol := (row/3)*3, (col/3)*3
+	for r := boxRow; r < boxRow+3; r++ {
+		for c := boxCol; c < boxCol+3; c++ {
+			if board[r][c] == digit {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func sudokuSolver() *Solver[*[9][9]int, sudokuMove] {
+	return &Solver[*[9][9]int, sudokuMove]{
+		Moves: func(board *[9][9]int) []sudokuMove {
+			row, col, found := sudokuFindEmpty(board)
+			if !found {
+				return nil
+			}
+			moves := make([]sudokuMove, 0, 9)
+			for digit := 1; digit <= 9; digit++ {
+				if sudokuValid(board, row, col, digit) {
+					moves = append(moves, sudokuMove{row, col, digit})
+				}
+			}
+			return moves
+		},
+		Choose:   func(board *[9][9]int, m sudokuMove) { board[m.row][m.col] = m.digit },
+		Unchoose: func(board *[9][9]int, m sudokuMove) { board[m.row][m.col] = 0 },
+		IsGoal: func(board *[9][9]int) bool {
+			_, _, found := sudokuFindEmpty(board)
+			return !found
+		},
+	}
+}
+
+// SolveSudoku attempts to solve board (0 marks an empty cell) in place
+// via constraint propagation through the candidate-digit filtering in
+// its move generator, falling back to search wherever more than one
+// digit remains valid for a cell. It reports whether a solution was
+// found; board is left solved on success and unchanged on failure.
+func SolveSudoku(board *[9][9]int) bool {
+	return sudokuSolver().Solve(board, func(*[9][9]int) bool { return true })
+}
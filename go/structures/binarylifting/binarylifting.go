@@ -0,0 +1,91 @@
+// Package binarylifting provides a generic BinaryLifting[T] that
+// precomputes 2^k-step jumps for an arbitrary successor function
+// f: T -> T over a finite domain, answering "apply f k times" in
+// O(log k) and "how far until some predicate first holds" in O(log n) —
+// the building block the LCA and functional-graph modules lift nodes
+// with.
+package binarylifting
+
+// BinaryLifting precomputes jump[k][i] = the domain element reached by
+// applying f exactly 2^k times starting from domain element i, for
+// every k up to log2(len(domain)). T must be comparable so elements can
+// be looked up by value.
+type BinaryLifting[T comparable] struct {
+	elems []T
+	index map[T]int
+	jump  [][]int
+	maxK  int
+}
+
+// New builds a BinaryLifting over domain using f. f must map every
+// element of domain back into domain (a closed successor function).
+func New[T comparable](domain []T, f func(T) T) *BinaryLifting[T] {
+	n := len(domain)
+	bl := &BinaryLifting[T]{
+		elems: append([]T{}, domain...),
+		index: make(map[T]int, n),
+	}
+	for i, v := range domain {
+		bl.index[v] = i
+	}
+
+	// maxK covers every bit of an int64 k, not just log2(n): although
+	// every jump stays within the domain, KthApply must support step
+	// counts far larger than the domain size (e.g. a cyclic f), so the
+	// table needs enough levels to decompose any k, not just one
+	// bounded by n.
+	const maxK = 62
+	bl.maxK = maxK
+
+	bl.jump = make([][]int, maxK+1)
+	bl.jump[0] = make([]int, n)
+	for i, v := range domain {
+		bl.jump[0][i] = bl.index[f(v)]
+	}
+	for k := 1; k <= maxK; k++ {
+		bl.jump[k] = make([]int, n)
+		for i := 0; i < n; i++ {
+			bl.jump[k][i] = bl.jump[k-1][bl.jump[k-1][i]]
+		}
+	}
+	return bl
+}
+
+// KthApply returns the result of applying f to start k times, in
+// O(log k) by combining precomputed power-of-two jumps.
+func (bl *BinaryLifting[T]) KthApply(start T, k int64) T {
+	i := bl.index[start]
+	for bit := 0; k > 0 && bit <= bl.maxK; bit++ {
+		if k&1 == 1 {
+			i = bl.jump[bit][i]
+		}
+		k >>= 1
+	}
+	return bl.elems[i]
+}
+
+// FindFirst finds the smallest k >= 0 such that pred holds for
+// applying f to start k times, assuming pred is monotonic along that
+// orbit (once true for some k, true for every larger k too — the same
+// assumption binary-lifted ancestor search over a tree depends on). It
+// reports false if pred never becomes true within len(domain) steps.
+func (bl *BinaryLifting[T]) FindFirst(start T, pred func(T) bool) (result T, steps int64, found bool) {
+	if pred(start) {
+		return start, 0, true
+	}
+	i := bl.index[start]
+	steps = 0
+	for k := bl.maxK; k >= 0; k-- {
+		next := bl.jump[k][i]
+		if !pred(bl.elems[next]) {
+			i = next
+			steps += 1 << k
+		}
+	}
+	final := bl.jump[0][i]
+	if pred(bl.elems[final]) {
+		return bl.elems[final], steps + 1, true
+	}
+	var zero T
+	return zero, 0, false
+}
@@ -0,0 +1,86 @@
+package binarylifting
+
+import "testing"
+
+func TestKthApplyOnCycle(t *testing.T) {
+	// A 5-cycle: 0 -> 1 -> 2 -> 3 -> 4 -> 0.
+	domain := []int{0, 1, 2, 3, 4}
+	f := func(v int) int { return (v + 1) % 5 }
+	bl := New(domain, f)
+
+	cases := []struct {
+		start int
+		k     int64
+		want  int
+	}{
+		{0, 0, 0},
+		{0, 1, 1},
+		{0, 5, 0},
+		{2, 7, 4},
+		{4, 100, 4},
+	}
+	for _, c := range cases {
+		if got := bl.KthApply(c.start, c.k); got != c.want {
+			t.Errorf("KthApply(%d, %d) = %d, want %d", c.start, c.k, got, c.want)
+		}
+	}
+}
+
+func TestKthApplyOnChain(t *testing.T) {
+	// A chain that functions as its own "parent" successor, like
+	// climbing ancestors in a tree: 5 -> 4 -> 3 -> 2 -> 1 -> 0 -> 0.
+	domain := []int{0, 1, 2, 3, 4, 5}
+	f := func(v int) int {
+		if v == 0 {
+			return 0
+		}
+		return v - 1
+	}
+	bl := New(domain, f)
+
+	if got := bl.KthApply(5, 3); got != 2 {
+		t.Errorf("KthApply(5, 3) = %d, want 2", got)
+	}
+	if got := bl.KthApply(5, 10); got != 0 {
+		t.Errorf("KthApply(5, 10) = %d, want 0", got)
+	}
+}
+
+func TestFindFirstAlreadyTrue(t *testing.T) {
+	domain := []int{0, 1, 2, 3}
+	bl := New(domain, func(v int) int { return v })
+
+	result, steps, found := bl.FindFirst(2, func(v int) bool { return v == 2 })
+	if !found || result != 2 || steps != 0 {
+		t.Errorf("FindFirst() = (%d, %d, %v), want (2, 0, true)", result, steps, found)
+	}
+}
+
+func TestFindFirstReachable(t *testing.T) {
+	// Chain 5 -> 4 -> 3 -> 2 -> 1 -> 0 -> 0; find the first ancestor
+	// with value <= 2, a monotonic predicate along this orbit.
+	domain := []int{0, 1, 2, 3, 4, 5}
+	f := func(v int) int {
+		if v == 0 {
+			return 0
+		}
+		return v - 1
+	}
+	bl := New(domain, f)
+
+	result, steps, found := bl.FindFirst(5, func(v int) bool { return v <= 2 })
+	if !found || result != 2 || steps != 3 {
+		t.Errorf("FindFirst() = (%d, %d, %v), want (2, 3, true)", result, steps, found)
+	}
+}
+
+func TestFindFirstUnreachable(t *testing.T) {
+	domain := []int{0, 1, 2, 3, 4}
+	f := func(v int) int { return (v + 1) % 5 }
+	bl := New(domain, f)
+
+	_, _, found := bl.FindFirst(0, func(v int) bool { return v == 99 })
+	if found {
+		t.Errorf("FindFirst() should report not found for an unreachable predicate")
+	}
+}
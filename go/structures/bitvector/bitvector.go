@@ -0,0 +1,141 @@
+// Package bitvector implements a compact bitvector with O(1) Rank and
+// O(log n) Select, the building block later modules (wavelet trees,
+// FM-indexes) lean on instead of scanning raw words. Rank is answered via
+// a two-level index: superblocks of 4096 bits store an absolute running
+// count, and each 64-bit word within a superblock stores a count
+// relative to its superblock's start, so the auxiliary index costs a
+// small fraction of the original n bits rather than a full O(n) table of
+// 64-bit counters.
+package bitvector
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// blockWords is the number of 64-bit words per superblock (4096 bits).
+const blockWords = 64
+
+// BitVector is an immutable, indexed sequence of bits.
+type BitVector struct {
+	words []uint64
+	n     int
+
+	// wordRank[w] is the number of set bits in words[superblockStart:w],
+	// i.e. the count relative to the start of w's own superblock.
+	wordRank []uint32
+	// superRank[s] is the absolute number of set bits before superblock
+	// s, i.e. in words[:s*blockWords].
+	superRank []uint64
+	totalOnes uint64
+}
+
+// New builds a BitVector from bits, where bits[i] is true if position i
+// should be set. It builds the rank/select index eagerly, since the
+// structure is immutable afterwards.
+func New(bits []bool) *BitVector {
+	n := len(bits)
+	words := make([]uint64, (n+63)/64)
+	for i, b := range bits {
+		if b {
+			words[i/64] |= 1 << uint(i%64)
+		}
+	}
+	bv := &BitVector{words: words, n: n}
+	bv.buildIndex()
+	return bv
+}
+
+// buildIndex computes wordRank and superRank from words.
+func (bv *BitVector) buildIndex() {
+	numWords := len(bv.words)
+	numSuper := (numWords + blockWords - 1) / blockWords
+	bv.wordRank = make([]uint32, numWords)
+	bv.superRank = make([]uint64, numSuper)
+
+	var total uint64
+	for w := 0; w < numWords; w++ {
+		if w%blockWords == 0 {
+			bv.superRank[w/blockWords] = total
+		}
+		bv.wordRank[w] = uint32(total - bv.superRank[w/blockWords])
+		total += uint64(bits.OnesCount64(bv.words[w]))
+	}
+	bv.totalOnes = total
+}
+
+// Len returns the number of bits in the vector.
+func (bv *BitVector) Len() int {
+	return bv.n
+}
+
+// Get reports whether bit i is set.
+func (bv *BitVector) Get(i int) bool {
+	if i < 0 || i >= bv.n {
+		panic("bitvector: Get index out of range")
+	}
+	return bv.words[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Rank returns the number of set bits in [0, i). Rank(Len()) is the total
+// number of set bits in the vector.
+func (bv *BitVector) Rank(i int) int {
+	if i < 0 || i > bv.n {
+		panic("bitvector: Rank index out of range")
+	}
+	if i == bv.n {
+		return int(bv.totalOnes)
+	}
+
+	wordIdx := i / 64
+	superIdx := wordIdx / blockWords
+	count := bv.superRank[superIdx] + uint64(bv.wordRank[wordIdx])
+
+	mask := uint64(1)<<uint(i%64) - 1
+	count += uint64(bits.OnesCount64(bv.words[wordIdx] & mask))
+	return int(count)
+}
+
+// Select returns the position of the k-th set bit (0-indexed: k=0 is the
+// first set bit). It reports false if the vector has k or fewer set
+// bits. Select binary-searches the superblock index, then the word index
+// within that superblock, then scans the final word bit by bit.
+func (bv *BitVector) Select(k int) (int, bool) {
+	if k < 0 || uint64(k) >= bv.totalOnes {
+		return 0, false
+	}
+	target := uint64(k + 1) // 1-indexed count of the bit we're looking for
+
+	superIdx := sort.Search(len(bv.superRank), func(s int) bool {
+		return bv.superRank[s] >= target
+	}) - 1
+	if superIdx < 0 {
+		superIdx = 0
+	}
+
+	startWord := superIdx * blockWords
+	endWord := startWord + blockWords
+	if endWord > len(bv.words) {
+		endWord = len(bv.words)
+	}
+	base := bv.superRank[superIdx]
+
+	wordIdx := startWord + sort.Search(endWord-startWord, func(j int) bool {
+		return base+uint64(bv.wordRank[startWord+j]) >= target
+	}) - 1
+	if wordIdx < startWord {
+		wordIdx = startWord
+	}
+
+	remaining := target - (base + uint64(bv.wordRank[wordIdx]))
+	word := bv.words[wordIdx]
+	for b := 0; b < 64; b++ {
+		if word&(1<<uint(b)) != 0 {
+			remaining--
+			if remaining == 0 {
+				return wordIdx*64 + b, true
+			}
+		}
+	}
+	return 0, false // unreachable if the index is consistent
+}
@@ -0,0 +1,133 @@
+package bitvector
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func naiveRank(bits []bool, i int) int {
+	count := 0
+	for j := 0; j < i; j++ {
+		if bits[j] {
+			count++
+		}
+	}
+	return count
+}
+
+func naiveSelect(bits []bool, k int) (int, bool) {
+	for i, b := range bits {
+		if b {
+			if k == 0 {
+				return i, true
+			}
+			k--
+		}
+	}
+	return 0, false
+}
+
+func TestRankAndSelectAgainstNaive(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bits := make([]bool, 10_000)
+	for i := range bits {
+		bits[i] = r.Intn(3) == 0
+	}
+	bv := New(bits)
+
+	for i := 0; i <= len(bits); i += 37 {
+		if got, want := bv.Rank(i), naiveRank(bits, i); got != want {
+			t.Fatalf("Rank(%d) = %d, want %d", i, got, want)
+		}
+	}
+
+	ones := 0
+	for _, b := range bits {
+		if b {
+			ones++
+		}
+	}
+	for k := 0; k < ones; k += 13 {
+		got, gotOK := bv.Select(k)
+		want, wantOK := naiveSelect(bits, k)
+		if got != want || gotOK != wantOK {
+			t.Fatalf("Select(%d) = (%d, %v), want (%d, %v)", k, got, gotOK, want, wantOK)
+		}
+	}
+	if _, ok := bv.Select(ones); ok {
+		t.Errorf("Select(%d) reported ok for an out-of-range rank", ones)
+	}
+}
+
+func TestRankBoundaries(t *testing.T) {
+	bv := New([]bool{true, false, true, true, false})
+	if got := bv.Rank(0); got != 0 {
+		t.Errorf("Rank(0) = %d, want 0", got)
+	}
+	if got := bv.Rank(5); got != 3 {
+		t.Errorf("Rank(5) = %d, want 3", got)
+	}
+	if got := bv.Rank(3); got != 2 {
+		t.Errorf("Rank(3) = %d, want 2", got)
+	}
+}
+
+func TestSelectSmall(t *testing.T) {
+	bv := New([]bool{false, true, false, true, true})
+	tests := []struct {
+		k      int
+		want   int
+		wantOK bool
+	}{
+		{0, 1, true},
+		{1, 3, true},
+		{2, 4, true},
+		{3, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := bv.Select(tt.k)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Select(%d) = (%d, %v), want (%d, %v)", tt.k, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestGetAndLen(t *testing.T) {
+	bv := New([]bool{true, false, true})
+	if bv.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", bv.Len())
+	}
+	if !bv.Get(0) || bv.Get(1) || !bv.Get(2) {
+		t.Errorf("Get() mismatched input bits")
+	}
+}
+
+func TestEmptyBitVector(t *testing.T) {
+	bv := New(nil)
+	if bv.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", bv.Len())
+	}
+	if got := bv.Rank(0); got != 0 {
+		t.Errorf("Rank(0) = %d, want 0", got)
+	}
+	if _, ok := bv.Select(0); ok {
+		t.Errorf("Select(0) on empty vector reported ok")
+	}
+}
+
+func TestRankAcrossSuperblockBoundary(t *testing.T) {
+	// 4096 bits is exactly one superblock; make sure rank/select still
+	// work correctly right across that boundary.
+	n := 4096 + 128
+	bits := make([]bool, n)
+	for i := range bits {
+		bits[i] = i%3 == 0
+	}
+	bv := New(bits)
+
+	for _, i := range []int{4095, 4096, 4097, 4200} {
+		if got, want := bv.Rank(i), naiveRank(bits, i); got != want {
+			t.Errorf("Rank(%d) = %d, want %d", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Package bloomfilter implements a classic Bloom filter: a fixed-size
+// bit array tested and set by several independent hash functions, which
+// answers "have I possibly seen this before?" in O(k) time and O(m)
+// space for k hash functions and m bits, trading a tunable false-positive
+// rate for never producing a false negative.
+//
+// It uses the Kirsch-Mitzenmacher technique of deriving all k hash
+// values from just two real hash computations (h1 and h2), rather than
+// running k independent hash functions per item.
+package bloomfilter
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// Filter is a Bloom filter over string items.
+type Filter struct {
+	bits []uint64
+	m    uint64 // number of bits
+	k    int    // number of hash functions
+	n    int    // number of items added
+}
+
+// New returns an empty Filter sized for expectedItems items at
+// falsePositiveRate, using the standard formulas for the optimal bit
+// array size m = -n*ln(p)/(ln(2)^2) and hash count k = (m/n)*ln(2).
+func New(expectedItems int, falsePositiveRate float64) *Filter {
+	if expectedItems < 1 {
+		expectedItems = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+
+	n := float64(expectedItems)
+	ln2 := math.Ln2
+	m := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (ln2 * ln2)))
+	if m < 1 {
+		m = 1
+	}
+	k := int(math.Round(float64(m) / n * ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &Filter{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+	}
+}
+
+// hashPair returns the two base hashes Add/Test derive every probe from.
+func hashPair(item string) (uint64, uint64) {
+	h1 := fnv.New64a()
+	h1.Write([]byte(item))
+	sum1 := h1.Sum64()
+
+	h2 := fnv.New32a()
+	h2.Write([]byte(item))
+	h2.Write([]byte{0xff}) // perturb so h2 isn't a truncation of h1
+	sum2 := uint64(h2.Sum32())
+	if sum2 == 0 {
+		sum2 = 1 // a zero second hash would make every probe identical
+	}
+	return sum1, sum2
+}
+
+func (f *Filter) probe(i int, h1, h2 uint64) uint64 {
+	return (h1 + uint64(i)*h2) % f.m
+}
+
+// Add records item in the filter.
+func (f *Filter) Add(item string) {
+	h1, h2 := hashPair(item)
+	for i := 0; i < f.k; i++ {
+		bit := f.probe(i, h1, h2)
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+	f.n++
+}
+
+// Test reports whether item has possibly been added: false means item
+// was definitely never added; true means it probably was, subject to
+// the filter's false-positive rate.
+func (f *Filter) Test(item string) bool {
+	h1, h2 := hashPair(item)
+	for i := 0; i < f.k; i++ {
+		bit := f.probe(i, h1, h2)
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of items added to the filter.
+func (f *Filter) Len() int {
+	return f.n
+}
+
+// EstimatedFalsePositiveRate estimates the filter's current
+// false-positive rate given how many items have actually been added,
+// which converges to the rate New was configured for once that many
+// items have been added, but is lower while the filter is under-full.
+func (f *Filter) EstimatedFalsePositiveRate() float64 {
+	if f.n == 0 {
+		return 0
+	}
+	return math.Pow(1-math.Exp(-float64(f.k)*float64(f.n)/float64(f.m)), float64(f.k))
+}
@@ -0,0 +1,76 @@
+package bloomfilter
+
+import "testing"
+
+func TestAddAndTest(t *testing.T) {
+	f := New(100, 0.01)
+	words := []string{"apple", "banana", "cherry", "date"}
+	for _, w := range words {
+		f.Add(w)
+	}
+	for _, w := range words {
+		if !f.Test(w) {
+			t.Errorf("Test(%q) = false, want true after Add", w)
+		}
+	}
+}
+
+func TestTestOnUnaddedItemsMostlyFalse(t *testing.T) {
+	f := New(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.Add(string(rune('a'+i%26)) + "-" + string(rune('0'+i%10)))
+	}
+
+	falsePositives := 0
+	const trials = 5000
+	for i := 0; i < trials; i++ {
+		candidate := "unseen-" + string(rune(i%128))
+		if f.Test(candidate) {
+			falsePositives++
+		}
+	}
+
+	rate := float64(falsePositives) / float64(trials)
+	if rate > 0.05 {
+		t.Errorf("observed false-positive rate %v over %d trials, want well under the configured 0.01 (allowing slack)", rate, trials)
+	}
+}
+
+func TestNoFalseNegatives(t *testing.T) {
+	f := New(50, 0.1)
+	var added []string
+	for i := 0; i < 50; i++ {
+		w := "word" + string(rune('a'+i%26)) + string(rune('0'+i%10))
+		f.Add(w)
+		added = append(added, w)
+	}
+	for _, w := range added {
+		if !f.Test(w) {
+			t.Fatalf("Test(%q) = false for an added item; Bloom filters must never false-negative", w)
+		}
+	}
+}
+
+func TestLen(t *testing.T) {
+	f := New(10, 0.01)
+	for i := 0; i < 5; i++ {
+		f.Add(string(rune('a' + i)))
+	}
+	if f.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", f.Len())
+	}
+}
+
+func TestEstimatedFalsePositiveRateGrowsWithLoad(t *testing.T) {
+	f := New(100, 0.01)
+	if r := f.EstimatedFalsePositiveRate(); r != 0 {
+		t.Errorf("EstimatedFalsePositiveRate() on empty filter = %v, want 0", r)
+	}
+	for i := 0; i < 100; i++ {
+		f.Add(string(rune(i)))
+	}
+	rate := f.EstimatedFalsePositiveRate()
+	if rate <= 0 || rate > 0.05 {
+		t.Errorf("EstimatedFalsePositiveRate() after filling to capacity = %v, want roughly the configured 0.01", rate)
+	}
+}
@@ -0,0 +1,171 @@
+package cache
+
+import "github.com/ghostmkg/dsa-code/go/structures/linkedlist"
+
+// arcList names which of ARC's four lists a key currently belongs to.
+// listT1 is the zero value, so looking up an untracked key in listOf
+// safely reads as listT1 rather than aliasing any of the others.
+type arcList int
+
+const (
+	listT1 arcList = iota // resident, seen once
+	listT2                // resident, seen at least twice
+	listB1                // ghost, recently evicted from T1
+	listB2                // ghost, recently evicted from T2
+)
+
+// ARC is a fixed-capacity Cache implementing Adaptive Replacement Cache
+// (Megiddo & Modha): it tracks recency (T1) and frequency (T2) resident
+// lists of equal combined size to the cache's capacity, plus two ghost
+// lists (B1, B2) recording keys recently evicted from each, and adapts
+// the target size p of T1 based on which ghost list produces hits —
+// growing T1 on a B1 hit (favoring recency) and shrinking it on a B2 hit
+// (favoring frequency).
+type ARC[K comparable, V any] struct {
+	capacity int
+	p        int
+	t1, t2   *linkedlist.DList[K]
+	b1, b2   *linkedlist.DList[K]
+	nodes    map[K]*linkedlist.DNode[K]
+	listOf   map[K]arcList
+	values   map[K]V
+}
+
+// NewARC builds an ARC with room for capacity resident entries. capacity
+// is clamped to at least 1.
+func NewARC[K comparable, V any](capacity int) *ARC[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &ARC[K, V]{
+		capacity: capacity,
+		t1:       &linkedlist.DList[K]{},
+		t2:       &linkedlist.DList[K]{},
+		b1:       &linkedlist.DList[K]{},
+		b2:       &linkedlist.DList[K]{},
+		nodes:    make(map[K]*linkedlist.DNode[K]),
+		listOf:   make(map[K]arcList),
+		values:   make(map[K]V),
+	}
+}
+
+func (c *ARC[K, V]) listFor(l arcList) *linkedlist.DList[K] {
+	switch l {
+	case listT1:
+		return c.t1
+	case listT2:
+		return c.t2
+	case listB1:
+		return c.b1
+	default:
+		return c.b2
+	}
+}
+
+// moveToMRU removes key from whichever list it's in (if any) and pushes
+// it to the MRU end of dst, updating listOf and c.nodes to match.
+func (c *ARC[K, V]) moveToMRU(key K, dst arcList) {
+	if n, ok := c.nodes[key]; ok {
+		c.listFor(c.listOf[key]).Remove(n)
+	}
+	c.nodes[key] = c.listFor(dst).PushFront(key)
+	c.listOf[key] = dst
+}
+
+func (c *ARC[K, V]) removeFrom(key K, l arcList) {
+	if n, ok := c.nodes[key]; ok {
+		c.listFor(l).Remove(n)
+	}
+	delete(c.nodes, key)
+	delete(c.listOf, key)
+}
+
+// Get returns key's value. A hit on a resident entry (case I) promotes
+// it to the MRU end of T2.
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	v, ok := c.values[key]
+	if !ok {
+		return v, false
+	}
+	c.moveToMRU(key, listT2)
+	return v, true
+}
+
+// Put inserts or updates key's value, running the classic ARC hit/miss
+// cases to keep T1/T2/B1/B2 and the adaptive target p up to date.
+func (c *ARC[K, V]) Put(key K, value V) {
+	if _, ok := c.values[key]; ok {
+		c.values[key] = value
+		c.moveToMRU(key, listT2)
+		return
+	}
+
+	switch c.listOf[key] {
+	case listB1:
+		// Case II: ghost hit in B1 — grow p toward recency.
+		delta := 1
+		if c.b1.Len() > 0 {
+			delta = max(c.b2.Len()/c.b1.Len(), 1)
+		}
+		c.p = min(c.capacity, c.p+delta)
+		c.replace(key)
+		c.removeFrom(key, listB1)
+		c.values[key] = value
+		c.moveToMRU(key, listT2)
+		return
+	case listB2:
+		// Case III: ghost hit in B2 — shrink p toward frequency.
+		delta := 1
+		if c.b2.Len() > 0 {
+			delta = max(c.b1.Len()/c.b2.Len(), 1)
+		}
+		c.p = max(0, c.p-delta)
+		c.replace(key)
+		c.removeFrom(key, listB2)
+		c.values[key] = value
+		c.moveToMRU(key, listT2)
+		return
+	}
+
+	// Case IV: complete miss.
+	l1Len := c.t1.Len() + c.b1.Len()
+	l2Len := c.t2.Len() + c.b2.Len()
+	if l1Len == c.capacity {
+		if c.b1.Len() == 0 {
+			c.removeFrom(c.t1.Tail.Val, listT1)
+		} else {
+			c.replace(key)
+			c.removeFrom(c.b1.Tail.Val, listB1)
+		}
+	} else if l1Len < c.capacity && l1Len+l2Len >= c.capacity {
+		if l1Len+l2Len == 2*c.capacity {
+			c.removeFrom(c.b2.Tail.Val, listB2)
+		}
+		c.replace(key)
+	}
+	c.values[key] = value
+	c.moveToMRU(key, listT1)
+}
+
+// replace implements the paper's REPLACE(x): it evicts the LRU entry of
+// T1 into B1, or the LRU entry of T2 into B2, based on the current
+// balance between T1's size and the target p.
+func (c *ARC[K, V]) replace(key K) {
+	if c.t1.Len() > 0 && (c.t1.Len() > c.p || (c.listOf[key] == listB2 && c.t1.Len() == c.p)) {
+		victim := c.t1.Tail.Val
+		c.moveToMRU(victim, listB1)
+		delete(c.values, victim)
+		return
+	}
+	if c.t2.Len() > 0 {
+		victim := c.t2.Tail.Val
+		c.moveToMRU(victim, listB2)
+		delete(c.values, victim)
+	}
+}
+
+// Len returns the number of resident entries currently cached.
+func (c *ARC[K, V]) Len() int { return len(c.values) }
+
+// Cap returns c's capacity.
+func (c *ARC[K, V]) Cap() int { return c.capacity }
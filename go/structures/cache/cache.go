@@ -0,0 +1,21 @@
+// Package cache implements several fixed-capacity cache eviction
+// policies — LRU, LFU, ARC, LRU-K, and CLOCK — behind a single generic
+// Cache interface, plus a Simulate harness that replays the same access
+// trace against a set of policies to compare their hit rates.
+package cache
+
+// Cache is a fixed-capacity key/value cache that evicts an entry
+// according to some replacement policy once it's full and a new key
+// needs room.
+type Cache[K comparable, V any] interface {
+	// Get returns the value stored under key, if present, and records
+	// the access for whatever policy this Cache implements.
+	Get(key K) (V, bool)
+	// Put inserts or updates key's value, evicting an entry first if the
+	// cache is already at capacity and key is not already present.
+	Put(key K, value V)
+	// Len returns the number of entries currently cached.
+	Len() int
+	// Cap returns the cache's capacity.
+	Cap() int
+}
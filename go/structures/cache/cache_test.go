@@ -0,0 +1,127 @@
+package cache
+
+import "testing"
+
+func TestLRU(t *testing.T) {
+	c := NewLRU[int, string](2)
+	c.Put(1, "a")
+	c.Put(2, "b")
+	if v, ok := c.Get(1); !ok || v != "a" {
+		t.Fatalf("Get(1) = %v, %v", v, ok)
+	}
+	c.Put(3, "c") // evicts 2 (LRU after touching 1)
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("expected 2 to be evicted")
+	}
+	if v, ok := c.Get(1); !ok || v != "a" {
+		t.Fatalf("expected 1 to survive, got %v, %v", v, ok)
+	}
+	if v, ok := c.Get(3); !ok || v != "c" {
+		t.Fatalf("expected 3 present, got %v, %v", v, ok)
+	}
+}
+
+func TestLFU(t *testing.T) {
+	c := NewLFU[int, string](2)
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Get(1)      // freq(1)=2, freq(2)=1
+	c.Put(3, "c") // evicts 2 (lowest freq)
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("expected 2 to be evicted")
+	}
+	if _, ok := c.Get(1); !ok {
+		t.Fatalf("expected 1 to survive")
+	}
+	if _, ok := c.Get(3); !ok {
+		t.Fatalf("expected 3 present")
+	}
+}
+
+func TestLRUK(t *testing.T) {
+	c := NewLRUK[int, string](2, 2)
+	c.Put(1, "a")
+	c.Get(1) // 1 now has 2 accesses recorded
+	c.Put(2, "b")
+	c.Put(3, "c") // 2 has only 1 access (distance -1), evicted ahead of 1
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("expected 2 to be evicted")
+	}
+	if _, ok := c.Get(1); !ok {
+		t.Fatalf("expected 1 to survive")
+	}
+	if _, ok := c.Get(3); !ok {
+		t.Fatalf("expected 3 present")
+	}
+}
+
+func TestClock(t *testing.T) {
+	c := NewClock[int, string](3)
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Put(3, "c")
+	c.Put(4, "d") // all reference bits set by insertion; sweep clears them and evicts 1
+	if _, ok := c.Get(1); ok {
+		t.Fatalf("expected 1 to be evicted")
+	}
+	c.Get(2)      // give 2 a second chance before the next sweep
+	c.Put(5, "e") // sweep clears 2's bit first, then evicts 3 (never re-referenced)
+	if _, ok := c.Get(3); ok {
+		t.Fatalf("expected 3 to be evicted")
+	}
+	if _, ok := c.Get(2); !ok {
+		t.Fatalf("expected 2 to survive thanks to its second chance")
+	}
+	if _, ok := c.Get(4); !ok {
+		t.Fatalf("expected 4 present")
+	}
+	if _, ok := c.Get(5); !ok {
+		t.Fatalf("expected 5 present")
+	}
+}
+
+func TestARCBasic(t *testing.T) {
+	c := NewARC[int, string](2)
+	c.Put(1, "a")
+	c.Put(2, "b")
+	if v, ok := c.Get(1); !ok || v != "a" {
+		t.Fatalf("Get(1) = %v, %v", v, ok)
+	}
+	c.Put(3, "c")
+	if c.Len() > c.Cap() {
+		t.Fatalf("Len() %d exceeds Cap() %d", c.Len(), c.Cap())
+	}
+	if _, ok := c.Get(1); !ok {
+		t.Fatalf("expected 1 (recently promoted) to survive eviction")
+	}
+}
+
+func TestARCGhostHitPromotesToT2(t *testing.T) {
+	c := NewARC[int, string](2)
+	c.Put(1, "a")
+	c.Put(2, "b")
+	c.Put(3, "c") // evicts 1 into B1
+	c.Put(1, "a") // ghost hit in B1: should grow p and resurrect 1 into T2
+	if _, ok := c.Get(1); !ok {
+		t.Fatalf("expected 1 to be resident again after ghost hit")
+	}
+	if c.listOf[1] != listT2 {
+		t.Fatalf("expected 1 to be promoted to T2, got %v", c.listOf[1])
+	}
+}
+
+func TestSimulateComparesPolicies(t *testing.T) {
+	trace := []int{1, 2, 3, 1, 1, 4, 1, 2}
+	results := Simulate(trace, map[string]Cache[int, int]{
+		"lru": NewLRU[int, int](2),
+		"lfu": NewLFU[int, int](2),
+	})
+	for name, r := range results {
+		if r.Hits+r.Misses != len(trace) {
+			t.Fatalf("%s: got %d+%d accesses, want %d", name, r.Hits, r.Misses, len(trace))
+		}
+		if r.HitRate() < 0 || r.HitRate() > 1 {
+			t.Fatalf("%s: hit rate %v out of range", name, r.HitRate())
+		}
+	}
+}
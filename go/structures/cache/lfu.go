@@ -0,0 +1,98 @@
+package cache
+
+import "github.com/ghostmkg/dsa-code/go/structures/linkedlist"
+
+// LFU is a fixed-capacity Cache that evicts the least frequently used
+// entry, breaking ties by least recently used within that frequency.
+// Each access frequency has its own LRU-ordered bucket, and minFreq
+// tracks the lowest non-empty bucket, so both Get and Put run in O(1).
+type LFU[K comparable, V any] struct {
+	capacity int
+	minFreq  int
+	values   map[K]V
+	freqOf   map[K]int
+	nodes    map[K]*linkedlist.DNode[K]
+	buckets  map[int]*linkedlist.DList[K]
+}
+
+// NewLFU builds an LFU with room for capacity entries. capacity is
+// clamped to at least 1.
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LFU[K, V]{
+		capacity: capacity,
+		values:   make(map[K]V),
+		freqOf:   make(map[K]int),
+		nodes:    make(map[K]*linkedlist.DNode[K]),
+		buckets:  make(map[int]*linkedlist.DList[K]),
+	}
+}
+
+func (c *LFU[K, V]) bucket(freq int) *linkedlist.DList[K] {
+	b, ok := c.buckets[freq]
+	if !ok {
+		b = &linkedlist.DList[K]{}
+		c.buckets[freq] = b
+	}
+	return b
+}
+
+// touch bumps key's frequency by one and moves it to the MRU end of its
+// new bucket, advancing minFreq if that emptied the old one.
+func (c *LFU[K, V]) touch(key K) {
+	freq := c.freqOf[key]
+	c.bucket(freq).Remove(c.nodes[key])
+	if freq == c.minFreq && c.bucket(freq).Len() == 0 {
+		c.minFreq++
+	}
+	freq++
+	c.freqOf[key] = freq
+	c.nodes[key] = c.bucket(freq).PushFront(key)
+}
+
+// Get returns key's value and bumps its frequency.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	if _, ok := c.values[key]; !ok {
+		var zero V
+		return zero, false
+	}
+	c.touch(key)
+	return c.values[key], true
+}
+
+// Put inserts or updates key's value, evicting the least frequently used
+// entry first if the cache is full and key is new.
+func (c *LFU[K, V]) Put(key K, value V) {
+	if _, ok := c.values[key]; ok {
+		c.values[key] = value
+		c.touch(key)
+		return
+	}
+	if len(c.values) >= c.capacity {
+		c.evictOne()
+	}
+	c.values[key] = value
+	c.freqOf[key] = 1
+	c.nodes[key] = c.bucket(1).PushFront(key)
+	c.minFreq = 1
+}
+
+func (c *LFU[K, V]) evictOne() {
+	b := c.buckets[c.minFreq]
+	if b == nil || b.Tail == nil {
+		return
+	}
+	victim := b.Tail.Val
+	b.Remove(b.Tail)
+	delete(c.values, victim)
+	delete(c.freqOf, victim)
+	delete(c.nodes, victim)
+}
+
+// Len returns the number of entries currently cached.
+func (c *LFU[K, V]) Len() int { return len(c.values) }
+
+// Cap returns c's capacity.
+func (c *LFU[K, V]) Cap() int { return c.capacity }
@@ -0,0 +1,69 @@
+package cache
+
+import "github.com/ghostmkg/dsa-code/go/structures/linkedlist"
+
+// LRU is a fixed-capacity Cache that evicts the least recently used entry.
+type LRU[K comparable, V any] struct {
+	capacity int
+	list     *linkedlist.DList[K]
+	nodes    map[K]*linkedlist.DNode[K]
+	values   map[K]V
+}
+
+// NewLRU builds an LRU with room for capacity entries. capacity is
+// clamped to at least 1.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRU[K, V]{
+		capacity: capacity,
+		list:     &linkedlist.DList[K]{},
+		nodes:    make(map[K]*linkedlist.DNode[K]),
+		values:   make(map[K]V),
+	}
+}
+
+// Get returns key's value and marks it most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	n, ok := c.nodes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.list.Remove(n)
+	c.nodes[key] = c.list.PushFront(key)
+	return c.values[key], true
+}
+
+// Put inserts or updates key's value, evicting the least recently used
+// entry first if the cache is full and key is new.
+func (c *LRU[K, V]) Put(key K, value V) {
+	if n, ok := c.nodes[key]; ok {
+		c.values[key] = value
+		c.list.Remove(n)
+		c.nodes[key] = c.list.PushFront(key)
+		return
+	}
+	if len(c.values) >= c.capacity {
+		c.evictOne()
+	}
+	c.values[key] = value
+	c.nodes[key] = c.list.PushFront(key)
+}
+
+func (c *LRU[K, V]) evictOne() {
+	if c.list.Tail == nil {
+		return
+	}
+	victim := c.list.Tail.Val
+	c.list.Remove(c.list.Tail)
+	delete(c.nodes, victim)
+	delete(c.values, victim)
+}
+
+// Len returns the number of entries currently cached.
+func (c *LRU[K, V]) Len() int { return len(c.values) }
+
+// Cap returns c's capacity.
+func (c *LRU[K, V]) Cap() int { return c.capacity }
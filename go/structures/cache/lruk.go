@@ -0,0 +1,109 @@
+package cache
+
+// LRUK is a fixed-capacity Cache that evicts the entry whose K-th most
+// recent access is furthest in the past (its "backward K-distance"),
+// rather than plain LRU's 1st-most-recent — so an entry that was
+// accessed once a long time ago and once again just now is preferred
+// over one accessed steadily K times in a row, which plain LRU can't
+// distinguish. An entry with fewer than K accesses on record has no
+// well-defined K-distance and is evicted ahead of any entry that does.
+//
+// Finding the eviction victim scans every entry (O(n) per eviction)
+// rather than maintaining a priority structure, since this package has
+// no generic heap to build one on; at the trace sizes this is meant for
+// that's not a practical bottleneck.
+type LRUK[K comparable, V any] struct {
+	capacity int
+	k        int
+	clock    int64
+	entries  map[K]*lruKEntry[V]
+}
+
+type lruKEntry[V any] struct {
+	value V
+	// history holds up to k most recent access timestamps, oldest first.
+	history []int64
+}
+
+// NewLRUK builds an LRUK with room for capacity entries, evicting based
+// on each entry's k-th most recent access. capacity and k are both
+// clamped to at least 1.
+func NewLRUK[K comparable, V any](capacity, k int) *LRUK[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	if k < 1 {
+		k = 1
+	}
+	return &LRUK[K, V]{
+		capacity: capacity,
+		k:        k,
+		entries:  make(map[K]*lruKEntry[V]),
+	}
+}
+
+func (c *LRUK[K, V]) record(e *lruKEntry[V]) {
+	c.clock++
+	e.history = append(e.history, c.clock)
+	if len(e.history) > c.k {
+		e.history = e.history[len(e.history)-c.k:]
+	}
+}
+
+// backwardKDistance returns e's k-th most recent access time, or -1 if it
+// has been accessed fewer than k times.
+func (e *lruKEntry[V]) backwardKDistance(k int) int64 {
+	if len(e.history) < k {
+		return -1
+	}
+	return e.history[0]
+}
+
+// Get returns key's value and records the access.
+func (c *LRUK[K, V]) Get(key K) (V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.record(e)
+	return e.value, true
+}
+
+// Put inserts or updates key's value, evicting the largest-backward-
+// K-distance entry first if the cache is full and key is new.
+func (c *LRUK[K, V]) Put(key K, value V) {
+	if e, ok := c.entries[key]; ok {
+		e.value = value
+		c.record(e)
+		return
+	}
+	if len(c.entries) >= c.capacity {
+		c.evictOne()
+	}
+	e := &lruKEntry[V]{}
+	c.record(e)
+	e.value = value
+	c.entries[key] = e
+}
+
+func (c *LRUK[K, V]) evictOne() {
+	var victim K
+	var victimDist int64
+	found := false
+	for key, e := range c.entries {
+		d := e.backwardKDistance(c.k)
+		if !found || d < victimDist {
+			victim, victimDist, found = key, d, true
+		}
+	}
+	if found {
+		delete(c.entries, victim)
+	}
+}
+
+// Len returns the number of entries currently cached.
+func (c *LRUK[K, V]) Len() int { return len(c.entries) }
+
+// Cap returns c's capacity.
+func (c *LRUK[K, V]) Cap() int { return c.capacity }
@@ -0,0 +1,38 @@
+package cache
+
+// SimResult holds one policy's outcome from Simulate.
+type SimResult struct {
+	Hits, Misses int
+}
+
+// HitRate returns the fraction of accesses that were hits, or 0 if the
+// trace was empty.
+func (r SimResult) HitRate() float64 {
+	total := r.Hits + r.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(r.Hits) / float64(total)
+}
+
+// Simulate replays trace — a sequence of key accesses, in order — against
+// every policy in policies independently, treating each access as a Get
+// that falls back to a Put on a miss, and reports per-policy hit/miss
+// counts keyed by the same name.
+func Simulate[K comparable, V any](trace []K, policies map[string]Cache[K, V]) map[string]SimResult {
+	results := make(map[string]SimResult, len(policies))
+	for name, policy := range policies {
+		var r SimResult
+		for _, key := range trace {
+			if _, ok := policy.Get(key); ok {
+				r.Hits++
+			} else {
+				r.Misses++
+				var zero V
+				policy.Put(key, zero)
+			}
+		}
+		results[name] = r
+	}
+	return results
+}
@@ -0,0 +1,28 @@
+// Package clustering implements two classic unsupervised clustering
+// algorithms over points in N-dimensional Euclidean space: K-means
+// (with k-means++ seeding) and DBSCAN.
+//
+// This repository has no existing geometry/spatial package to build on,
+// so DBSCAN's neighbor queries run against a small KD-tree implemented
+// as an unexported helper local to this package rather than a shared
+// module — it supports exactly the fixed-radius neighbor search DBSCAN
+// needs and isn't a general-purpose spatial index.
+package clustering
+
+import "math"
+
+// Point is a point in N-dimensional space.
+type Point []float64
+
+func squaredDistance(a, b Point) float64 {
+	sum := 0.0
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+func distance(a, b Point) float64 {
+	return math.Sqrt(squaredDistance(a, b))
+}
@@ -0,0 +1,98 @@
+package clustering
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestKMeansSeparatesObviousClusters(t *testing.T) {
+	points := []Point{
+		{0, 0}, {0, 1}, {1, 0}, {1, 1},
+		{10, 10}, {10, 11}, {11, 10}, {11, 11},
+	}
+	rng := rand.New(rand.NewSource(1))
+	assignments, centroids := KMeans(points, 2, rng, 50)
+
+	if len(centroids) != 2 {
+		t.Fatalf("KMeans returned %d centroids, want 2", len(centroids))
+	}
+	// Every point in the first group must share a label, every point in
+	// the second group must share a (different) label.
+	for i := 1; i < 4; i++ {
+		if assignments[i] != assignments[0] {
+			t.Errorf("point %d assigned to cluster %d, want same cluster as point 0 (%d)", i, assignments[i], assignments[0])
+		}
+	}
+	for i := 5; i < 8; i++ {
+		if assignments[i] != assignments[4] {
+			t.Errorf("point %d assigned to cluster %d, want same cluster as point 4 (%d)", i, assignments[i], assignments[4])
+		}
+	}
+	if assignments[0] == assignments[4] {
+		t.Errorf("the two well-separated groups were assigned the same cluster")
+	}
+}
+
+func TestKMeansEmptyInput(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	assignments, centroids := KMeans(nil, 3, rng, 10)
+	if assignments != nil || centroids != nil {
+		t.Errorf("KMeans(nil, ...) = %v, %v, want nil, nil", assignments, centroids)
+	}
+}
+
+func TestKMeansKLargerThanPoints(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	points := []Point{{0, 0}, {1, 1}}
+	assignments, centroids := KMeans(points, 5, rng, 10)
+	if len(centroids) != 2 {
+		t.Fatalf("KMeans with k > len(points) returned %d centroids, want 2", len(centroids))
+	}
+	if len(assignments) != 2 {
+		t.Fatalf("KMeans returned %d assignments, want 2", len(assignments))
+	}
+}
+
+func TestDBSCANFindsTwoDenseClustersAndNoise(t *testing.T) {
+	points := []Point{
+		{0, 0}, {0.1, 0}, {0, 0.1}, {0.1, 0.1}, // dense cluster A
+		{10, 10}, {10.1, 10}, {10, 10.1}, // dense cluster B
+		{50, 50}, // noise, far from everything
+	}
+	labels := DBSCAN(points, 0.5, 3)
+
+	clusterA := labels[0]
+	for i := 1; i < 4; i++ {
+		if labels[i] != clusterA {
+			t.Errorf("point %d labeled %d, want same cluster as point 0 (%d)", i, labels[i], clusterA)
+		}
+	}
+	clusterB := labels[4]
+	for i := 5; i < 7; i++ {
+		if labels[i] != clusterB {
+			t.Errorf("point %d labeled %d, want same cluster as point 4 (%d)", i, labels[i], clusterB)
+		}
+	}
+	if clusterA == clusterB {
+		t.Errorf("the two separated dense clusters were given the same label")
+	}
+	if labels[7] != noise {
+		t.Errorf("isolated point labeled %d, want noise (%d)", labels[7], noise)
+	}
+}
+
+func TestDBSCANEmptyInput(t *testing.T) {
+	if got := DBSCAN(nil, 1.0, 3); len(got) != 0 {
+		t.Errorf("DBSCAN(nil, ...) = %v, want empty", got)
+	}
+}
+
+func TestDBSCANAllNoiseWhenMinPtsTooHigh(t *testing.T) {
+	points := []Point{{0, 0}, {0.1, 0}, {0, 0.1}}
+	labels := DBSCAN(points, 0.5, 10)
+	for i, l := range labels {
+		if l != noise {
+			t.Errorf("point %d labeled %d, want noise (%d) since minPts can never be satisfied", i, l, noise)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package clustering
+
+const (
+	unclassified = -2
+	noise        = -1
+)
+
+// DBSCAN clusters points by density: a point is a "core point" if at
+// least minPts other points (including itself) lie within eps of it,
+// and clusters grow by chaining core points together along with every
+// point within eps of one — points that end up in no such chain are
+// reported as noise. It returns each point's cluster index (0-based),
+// or noise (-1) for points not assigned to any cluster.
+func DBSCAN(points []Point, eps float64, minPts int) []int {
+	labels := make([]int, len(points))
+	for i := range labels {
+		labels[i] = unclassified
+	}
+	if len(points) == 0 {
+		return labels
+	}
+
+	tree := buildKDTree(points, len(points[0]))
+	neighbors := func(i int) []int {
+		return rangeSearch(tree, points, points[i], eps, nil)
+	}
+
+	cluster := 0
+	for i := range points {
+		if labels[i] != unclassified {
+			continue
+		}
+		seeds := neighbors(i)
+		if len(seeds) < minPts {
+			labels[i] = noise
+			continue
+		}
+
+		labels[i] = cluster
+		queue := append([]int{}, seeds...)
+		for len(queue) > 0 {
+			j := queue[0]
+			queue = queue[1:]
+
+			if labels[j] == noise {
+				labels[j] = cluster
+			}
+			if labels[j] != unclassified {
+				continue
+			}
+			labels[j] = cluster
+
+			jNeighbors := neighbors(j)
+			if len(jNeighbors) >= minPts {
+				queue = append(queue, jNeighbors...)
+			}
+		}
+		cluster++
+	}
+	return labels
+}
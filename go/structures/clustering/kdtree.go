@@ -0,0 +1,64 @@
+package clustering
+
+import "sort"
+
+// kdNode is one node of the unexported KD-tree DBSCAN uses for
+// fixed-radius neighbor queries.
+type kdNode struct {
+	idx         int
+	left, right *kdNode
+	axis        int
+}
+
+// buildKDTree builds a balanced KD-tree over points, indexed by their
+// position in points so callers can map results back to labels.
+func buildKDTree(points []Point, dims int) *kdNode {
+	indices := make([]int, len(points))
+	for i := range indices {
+		indices[i] = i
+	}
+	return buildKDNode(points, indices, 0, dims)
+}
+
+func buildKDNode(points []Point, indices []int, depth, dims int) *kdNode {
+	if len(indices) == 0 {
+		return nil
+	}
+	axis := depth % dims
+	sort.Slice(indices, func(i, j int) bool {
+		return points[indices[i]][axis] < points[indices[j]][axis]
+	})
+
+	mid := len(indices) / 2
+	node := &kdNode{idx: indices[mid], axis: axis}
+	node.left = buildKDNode(points, indices[:mid], depth+1, dims)
+	node.right = buildKDNode(points, indices[mid+1:], depth+1, dims)
+	return node
+}
+
+// rangeSearch appends to dst the index of every point within eps of
+// target, descending only into subtrees whose splitting plane could
+// still contain a point that close.
+func rangeSearch(node *kdNode, points []Point, target Point, eps float64, dst []int) []int {
+	if node == nil {
+		return dst
+	}
+	if distance(points[node.idx], target) <= eps {
+		dst = append(dst, node.idx)
+	}
+
+	diff := target[node.axis] - points[node.idx][node.axis]
+	near, far := node.left, node.right
+	if diff > 0 {
+		near, far = node.right, node.left
+	}
+
+	dst = rangeSearch(near, points, target, eps, dst)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff <= eps {
+		dst = rangeSearch(far, points, target, eps, dst)
+	}
+	return dst
+}
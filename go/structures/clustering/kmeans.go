@@ -0,0 +1,108 @@
+package clustering
+
+import (
+	"math"
+	"math/rand"
+)
+
+// seedPlusPlus picks k initial centroids from points using k-means++:
+// the first is chosen uniformly at random, and each subsequent one is
+// chosen with probability proportional to its squared distance from the
+// nearest centroid already picked, which spreads the initial centroids
+// out and gives K-means a better starting point than picking k points
+// uniformly at random.
+func seedPlusPlus(points []Point, k int, rng *rand.Rand) []Point {
+	centroids := make([]Point, 0, k)
+	centroids = append(centroids, points[rng.Intn(len(points))])
+
+	dist := make([]float64, len(points))
+	for len(centroids) < k {
+		total := 0.0
+		for i, p := range points {
+			best := math.MaxFloat64
+			for _, c := range centroids {
+				if d := squaredDistance(p, c); d < best {
+					best = d
+				}
+			}
+			dist[i] = best
+			total += best
+		}
+
+		target := rng.Float64() * total
+		cum := 0.0
+		chosen := len(points) - 1
+		for i, d := range dist {
+			cum += d
+			if cum >= target {
+				chosen = i
+				break
+			}
+		}
+		centroids = append(centroids, points[chosen])
+	}
+	return centroids
+}
+
+// KMeans partitions points into k clusters, seeding the initial
+// centroids with k-means++ and then alternating assignment and
+// recentering until no point changes cluster or maxIter rounds pass. It
+// returns each point's cluster index (0..k-1) and the final centroids.
+func KMeans(points []Point, k int, rng *rand.Rand, maxIter int) ([]int, []Point) {
+	if len(points) == 0 || k <= 0 {
+		return nil, nil
+	}
+	if k > len(points) {
+		k = len(points)
+	}
+
+	centroids := seedPlusPlus(points, k, rng)
+	assignments := make([]int, len(points))
+	for i := range assignments {
+		assignments[i] = -1 // force at least one recentering pass
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		changed := false
+		for i, p := range points {
+			best, bestDist := 0, math.MaxFloat64
+			for c, centroid := range centroids {
+				if d := squaredDistance(p, centroid); d < bestDist {
+					best, bestDist = c, d
+				}
+			}
+			if assignments[i] != best {
+				assignments[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		dims := len(points[0])
+		sums := make([]Point, k)
+		counts := make([]int, k)
+		for c := range sums {
+			sums[c] = make(Point, dims)
+		}
+		for i, p := range points {
+			c := assignments[i]
+			counts[c]++
+			for d := 0; d < dims; d++ {
+				sums[c][d] += p[d]
+			}
+		}
+		for c := range centroids {
+			if counts[c] == 0 {
+				continue // keep the old centroid for an empty cluster
+			}
+			for d := 0; d < dims; d++ {
+				sums[c][d] /= float64(counts[c])
+			}
+			centroids[c] = sums[c]
+		}
+	}
+
+	return assignments, centroids
+}
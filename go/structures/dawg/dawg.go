@@ -0,0 +1,187 @@
+// Package dawg implements a DAWG (directed acyclic word graph, also known
+// as a minimal acyclic finite-state automaton) built from a sorted word
+// list. It is built by first assembling a plain trie and then minimizing it
+// by hash-consing isomorphic subtrees — any two trie nodes with the same
+// "is this a word end" flag and the same set of (byte, subtree) children
+// collapse into a single shared node — which is what turns the trie's tree
+// shape into a DAG and shrinks it, often dramatically, on real dictionaries
+// with shared suffixes ("running"/"jumping", "ing"/"ed" endings, etc).
+package dawg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type node struct {
+	final    bool
+	children map[byte]*node
+}
+
+// DAWG is an immutable automaton recognizing the fixed set of words it was
+// built from.
+type DAWG struct {
+	root *node
+
+	// trieNodes and dawgNodes record the node count before and after
+	// minimization respectively, so callers can see how much the
+	// minimization step saved on their dictionary.
+	trieNodes int
+	dawgNodes int
+}
+
+// Build constructs a DAWG from words, which must already be sorted
+// lexicographically (the same requirement real-world DAWG builders impose,
+// since it lets construction process one word at a time without
+// backtracking). It returns an error if words is empty or not sorted.
+func Build(words []string) (*DAWG, error) {
+	if len(words) == 0 {
+		return nil, fmt.Errorf("dawg: Build requires at least one word")
+	}
+	for i := 1; i < len(words); i++ {
+		if words[i-1] >= words[i] {
+			return nil, fmt.Errorf("dawg: words must be sorted with no duplicates, got %q before %q", words[i-1], words[i])
+		}
+	}
+
+	root := &node{children: map[byte]*node{}}
+	for _, w := range words {
+		insert(root, w)
+	}
+	trieNodes := countAll(root)
+
+	registry := map[string]*node{}
+	minRoot := minimize(root, registry)
+
+	return &DAWG{
+		root:      minRoot,
+		trieNodes: trieNodes,
+		dawgNodes: countDistinct(minRoot),
+	}, nil
+}
+
+func insert(root *node, word string) {
+	cur := root
+	for i := 0; i < len(word); i++ {
+		b := word[i]
+		next, ok := cur.children[b]
+		if !ok {
+			next = &node{children: map[byte]*node{}}
+			cur.children[b] = next
+		}
+		cur = next
+	}
+	cur.final = true
+}
+
+// countAll counts every node in the plain trie (tree, so no sharing to
+// account for).
+func countAll(n *node) int {
+	count := 1
+	for _, child := range n.children {
+		count += countAll(child)
+	}
+	return count
+}
+
+// minimize collapses isomorphic subtrees of the trie rooted at n into
+// shared nodes, via a bottom-up signature built from each child's own
+// (already-minimized) signature.
+func minimize(n *node, registry map[string]*node) *node {
+	keys := make([]byte, 0, len(n.children))
+	for b := range n.children {
+		keys = append(keys, b)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	minChildren := make(map[byte]*node, len(keys))
+	var sig strings.Builder
+	if n.final {
+		sig.WriteByte('1')
+	} else {
+		sig.WriteByte('0')
+	}
+	for _, b := range keys {
+		child := minimize(n.children[b], registry)
+		minChildren[b] = child
+		fmt.Fprintf(&sig, "|%d:%p", b, child)
+	}
+
+	key := sig.String()
+	if existing, ok := registry[key]; ok {
+		return existing
+	}
+	merged := &node{final: n.final, children: minChildren}
+	registry[key] = merged
+	return merged
+}
+
+// countDistinct counts the distinct nodes reachable from root, which may
+// be fewer than a naive tree walk since minimized DAWGs share nodes.
+func countDistinct(root *node) int {
+	seen := map[*node]bool{}
+	var walk func(n *node)
+	walk = func(n *node) {
+		if seen[n] {
+			return
+		}
+		seen[n] = true
+		for _, child := range n.children {
+			walk(child)
+		}
+	}
+	walk(root)
+	return len(seen)
+}
+
+// Contains reports whether word is in the set the DAWG was built from.
+func (d *DAWG) Contains(word string) bool {
+	cur := d.root
+	for i := 0; i < len(word); i++ {
+		next, ok := cur.children[word[i]]
+		if !ok {
+			return false
+		}
+		cur = next
+	}
+	return cur.final
+}
+
+// WithPrefix returns every word in the DAWG that starts with prefix, in
+// lexicographic order.
+func (d *DAWG) WithPrefix(prefix string) []string {
+	cur := d.root
+	for i := 0; i < len(prefix); i++ {
+		next, ok := cur.children[prefix[i]]
+		if !ok {
+			return nil
+		}
+		cur = next
+	}
+
+	var out []string
+	collect(cur, prefix, &out)
+	return out
+}
+
+func collect(n *node, prefix string, out *[]string) {
+	if n.final {
+		*out = append(*out, prefix)
+	}
+	keys := make([]byte, 0, len(n.children))
+	for b := range n.children {
+		keys = append(keys, b)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, b := range keys {
+		collect(n.children[b], prefix+string(b), out)
+	}
+}
+
+// TrieNodeCount returns the number of nodes the unminimized trie would have
+// had over the same word list.
+func (d *DAWG) TrieNodeCount() int { return d.trieNodes }
+
+// NodeCount returns the number of distinct nodes in the minimized DAWG.
+func (d *DAWG) NodeCount() int { return d.dawgNodes }
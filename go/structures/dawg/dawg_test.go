@@ -0,0 +1,69 @@
+package dawg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildRejectsUnsortedOrEmpty(t *testing.T) {
+	if _, err := Build(nil); err == nil {
+		t.Errorf("Build(nil) should return an error")
+	}
+	if _, err := Build([]string{"b", "a"}); err == nil {
+		t.Errorf("Build should reject unsorted input")
+	}
+	if _, err := Build([]string{"a", "a"}); err == nil {
+		t.Errorf("Build should reject duplicate words")
+	}
+}
+
+func TestContains(t *testing.T) {
+	words := []string{"cat", "cats", "dog", "dogs"}
+	d, err := Build(words)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	for _, w := range words {
+		if !d.Contains(w) {
+			t.Errorf("Contains(%q) = false, want true", w)
+		}
+	}
+	for _, w := range []string{"ca", "do", "cato", ""} {
+		if d.Contains(w) {
+			t.Errorf("Contains(%q) = true, want false", w)
+		}
+	}
+}
+
+func TestWithPrefix(t *testing.T) {
+	words := []string{"cat", "cats", "cattle", "dog"}
+	d, err := Build(words)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	got := d.WithPrefix("cat")
+	want := []string{"cat", "cats", "cattle"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WithPrefix(cat) = %v, want %v", got, want)
+	}
+
+	if got := d.WithPrefix("z"); got != nil {
+		t.Errorf("WithPrefix(z) = %v, want nil", got)
+	}
+}
+
+func TestMinimizationSharesSuffixNodes(t *testing.T) {
+	// "running" and "jumping" share the "ing" suffix; a DAWG should merge
+	// those shared trailing nodes, while a plain trie never would.
+	words := []string{"jumping", "running"}
+	d, err := Build(words)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if d.NodeCount() >= d.TrieNodeCount() {
+		t.Errorf("NodeCount() = %d, want fewer than TrieNodeCount() = %d", d.NodeCount(), d.TrieNodeCount())
+	}
+}
@@ -0,0 +1,87 @@
+// Package depresolver resolves a package/task dependency graph into a
+// valid install/build order using Kahn's algorithm for topological
+// sorting, and reports dsaerr.ErrCycleDetected if the dependencies
+// contain a cycle (which has no valid order).
+package depresolver
+
+import (
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+// Resolver accumulates named nodes and "depends on" edges between them.
+type Resolver struct {
+	deps map[string][]string
+}
+
+// New returns an empty Resolver.
+func New() *Resolver {
+	return &Resolver{deps: make(map[string][]string)}
+}
+
+// AddNode ensures node is known to the resolver, even if it has no
+// dependencies. Resolve already adds nodes mentioned via AddDependency,
+// so this is only needed for a dependency-free node added on its own.
+func (r *Resolver) AddNode(node string) {
+	if _, ok := r.deps[node]; !ok {
+		r.deps[node] = nil
+	}
+}
+
+// AddDependency records that node depends on dependsOn, meaning
+// dependsOn must appear before node in any order Resolve returns.
+func (r *Resolver) AddDependency(node, dependsOn string) {
+	r.AddNode(dependsOn)
+	r.deps[node] = append(r.deps[node], dependsOn)
+}
+
+// Resolve returns every node in an order where each node appears after
+// all of its dependencies, breaking ties alphabetically so the result is
+// deterministic. It returns dsaerr.ErrCycleDetected if the dependency
+// graph contains a cycle.
+func (r *Resolver) Resolve() ([]string, error) {
+	// indegree[n] counts dependencies of n still unresolved; dependents
+	// is the reverse graph, used to decrement indegree as each
+	// dependency is emitted.
+	indegree := make(map[string]int, len(r.deps))
+	dependents := make(map[string][]string, len(r.deps))
+	for node := range r.deps {
+		if _, ok := indegree[node]; !ok {
+			indegree[node] = 0
+		}
+	}
+	for node, depends := range r.deps {
+		indegree[node] += len(depends)
+		for _, dep := range depends {
+			dependents[dep] = append(dependents[dep], node)
+		}
+	}
+
+	var ready []string
+	for node, deg := range indegree {
+		if deg == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	order := make([]string, 0, len(indegree))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		node := ready[0]
+		ready = ready[1:]
+		order = append(order, node)
+
+		for _, dependent := range dependents[node] {
+			indegree[dependent]--
+			if indegree[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(order) != len(indegree) {
+		return nil, dsaerr.ErrCycleDetected
+	}
+	return order, nil
+}
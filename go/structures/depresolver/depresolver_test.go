@@ -0,0 +1,100 @@
+package depresolver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ghostmkg/dsa-code/go/dsaerr"
+)
+
+func indexOf(order []string, node string) int {
+	for i, n := range order {
+		if n == node {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestResolveOrdersDependenciesFirst(t *testing.T) {
+	r := New()
+	r.AddDependency("app", "lib")
+	r.AddDependency("lib", "base")
+	r.AddDependency("app", "config")
+
+	order, err := r.Resolve()
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if len(order) != 4 {
+		t.Fatalf("Resolve() returned %d nodes, want 4: %v", len(order), order)
+	}
+	if indexOf(order, "base") >= indexOf(order, "lib") {
+		t.Errorf("base must come before lib in %v", order)
+	}
+	if indexOf(order, "lib") >= indexOf(order, "app") {
+		t.Errorf("lib must come before app in %v", order)
+	}
+	if indexOf(order, "config") >= indexOf(order, "app") {
+		t.Errorf("config must come before app in %v", order)
+	}
+}
+
+func TestResolveIsDeterministic(t *testing.T) {
+	r := New()
+	r.AddDependency("c", "a")
+	r.AddDependency("c", "b")
+	r.AddNode("a")
+	r.AddNode("b")
+
+	order, err := r.Resolve()
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	want := []string{"a", "b", "c"}
+	if len(order) != len(want) {
+		t.Fatalf("Resolve() = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("Resolve() = %v, want %v", order, want)
+			break
+		}
+	}
+}
+
+func TestResolveDetectsCycle(t *testing.T) {
+	r := New()
+	r.AddDependency("a", "b")
+	r.AddDependency("b", "c")
+	r.AddDependency("c", "a")
+
+	_, err := r.Resolve()
+	if !errors.Is(err, dsaerr.ErrCycleDetected) {
+		t.Errorf("Resolve() error = %v, want dsaerr.ErrCycleDetected", err)
+	}
+}
+
+func TestResolveNodeWithNoDependencies(t *testing.T) {
+	r := New()
+	r.AddNode("standalone")
+
+	order, err := r.Resolve()
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if len(order) != 1 || order[0] != "standalone" {
+		t.Errorf("Resolve() = %v, want [\"standalone\"]", order)
+	}
+}
+
+func TestResolveEmpty(t *testing.T) {
+	r := New()
+	order, err := r.Resolve()
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if len(order) != 0 {
+		t.Errorf("Resolve() on empty resolver = %v, want empty", order)
+	}
+}
@@ -0,0 +1,120 @@
+// Package deque implements a generic double-ended queue backed by a
+// growable ring buffer, suitable as the backbone for BFS and
+// sliding-window algorithms elsewhere in the repo: every push and pop
+// runs in amortized O(1), and indexed access into the middle of the
+// deque is O(1) too.
+package deque
+
+// Deque is a double-ended queue of T. Its zero value is an empty,
+// ready-to-use deque. The backing buffer's length is always a power of
+// two (or zero), so wrapping around either end uses a bitmask instead
+// of a modulo.
+type Deque[T any] struct {
+	buf  []T
+	head int // index of the front element
+	size int
+}
+
+// New returns an empty Deque.
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// At returns the element at position i, where 0 is the front and
+// Len()-1 is the back. At panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.size {
+		panic("deque: index out of range")
+	}
+	return d.buf[d.wrap(d.head+i)]
+}
+
+func (d *Deque[T]) wrap(i int) int {
+	return i & (len(d.buf) - 1)
+}
+
+// PushBack appends v to the back of the deque.
+func (d *Deque[T]) PushBack(v T) {
+	d.growIfFull()
+	d.buf[d.wrap(d.head+d.size)] = v
+	d.size++
+}
+
+// PushFront prepends v to the front of the deque.
+func (d *Deque[T]) PushFront(v T) {
+	d.growIfFull()
+	d.head = d.wrap(d.head - 1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PopFront removes and returns the front of the deque. ok is false if
+// the deque was empty, in which case the returned value is the zero
+// value of T.
+func (d *Deque[T]) PopFront() (v T, ok bool) {
+	if d.size == 0 {
+		return v, false
+	}
+	v = d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = d.wrap(d.head + 1)
+	d.size--
+	d.shrinkIfSparse()
+	return v, true
+}
+
+// PopBack removes and returns the back of the deque. ok is false if the
+// deque was empty, in which case the returned value is the zero value
+// of T.
+func (d *Deque[T]) PopBack() (v T, ok bool) {
+	if d.size == 0 {
+		return v, false
+	}
+	idx := d.wrap(d.head + d.size - 1)
+	v = d.buf[idx]
+	var zero T
+	d.buf[idx] = zero
+	d.size--
+	d.shrinkIfSparse()
+	return v, true
+}
+
+// growIfFull doubles the backing buffer (starting from 1) once it's
+// full, amortizing the copy cost over the pushes since the last resize.
+func (d *Deque[T]) growIfFull() {
+	if d.size < len(d.buf) {
+		return
+	}
+	newCap := 1
+	if len(d.buf) > 0 {
+		newCap = len(d.buf) * 2
+	}
+	d.resize(newCap)
+}
+
+// shrinkIfSparse halves the backing buffer once usage falls to a
+// quarter of capacity, so a deque that grows and drains repeatedly
+// doesn't hold onto memory it no longer needs. Halving (rather than
+// shrinking as soon as usage drops below half) keeps a push immediately
+// following a pop from bouncing the buffer size back and forth.
+func (d *Deque[T]) shrinkIfSparse() {
+	if len(d.buf) <= 1 || d.size > len(d.buf)/4 {
+		return
+	}
+	d.resize(len(d.buf) / 2)
+}
+
+func (d *Deque[T]) resize(newCap int) {
+	newBuf := make([]T, newCap)
+	for i := 0; i < d.size; i++ {
+		newBuf[i] = d.buf[d.wrap(d.head+i)]
+	}
+	d.buf = newBuf
+	d.head = 0
+}
@@ -0,0 +1,128 @@
+package deque
+
+import "testing"
+
+func TestPushBackPopFrontFIFOOrder(t *testing.T) {
+	d := New[int]()
+	for i := 1; i <= 5; i++ {
+		d.PushBack(i)
+	}
+	for i := 1; i <= 5; i++ {
+		got, ok := d.PopFront()
+		if !ok || got != i {
+			t.Fatalf("PopFront() = (%d, %v), want (%d, true)", got, ok, i)
+		}
+	}
+	if d.Len() != 0 {
+		t.Errorf("Len() = %d after draining, want 0", d.Len())
+	}
+}
+
+func TestPushFrontPopBackFIFOOrder(t *testing.T) {
+	d := New[int]()
+	for i := 1; i <= 5; i++ {
+		d.PushFront(i)
+	}
+	for i := 1; i <= 5; i++ {
+		got, ok := d.PopBack()
+		if !ok || got != i {
+			t.Fatalf("PopBack() = (%d, %v), want (%d, true)", got, ok, i)
+		}
+	}
+}
+
+func TestPushFrontPopFrontLIFOOrder(t *testing.T) {
+	d := New[int]()
+	for i := 1; i <= 5; i++ {
+		d.PushFront(i)
+	}
+	for i := 5; i >= 1; i-- {
+		got, ok := d.PopFront()
+		if !ok || got != i {
+			t.Fatalf("PopFront() = (%d, %v), want (%d, true)", got, ok, i)
+		}
+	}
+}
+
+func TestPopOnEmpty(t *testing.T) {
+	d := New[int]()
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront() on empty deque: ok = true, want false")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("PopBack() on empty deque: ok = true, want false")
+	}
+}
+
+func TestAt(t *testing.T) {
+	d := New[int]()
+	d.PushBack(10)
+	d.PushBack(20)
+	d.PushFront(5)
+	// deque is now: 5 10 20
+	cases := []struct {
+		i    int
+		want int
+	}{{0, 5}, {1, 10}, {2, 20}}
+	for _, c := range cases {
+		if got := d.At(c.i); got != c.want {
+			t.Errorf("At(%d) = %d, want %d", c.i, got, c.want)
+		}
+	}
+}
+
+func TestAtOutOfRangePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("At() did not panic for an out-of-range index")
+		}
+	}()
+	d := New[int]()
+	d.PushBack(1)
+	d.At(5)
+}
+
+func TestMixedPushesAndPopsPreserveOrder(t *testing.T) {
+	d := New[int]()
+	d.PushBack(1)
+	d.PushFront(0)
+	d.PushBack(2)
+	front, _ := d.PopFront()
+	d.PushBack(3)
+	// started 0 1 2, popped 0 -> 1 2, pushed 3 -> 1 2 3
+	if front != 0 {
+		t.Fatalf("first PopFront() = %d, want 0", front)
+	}
+	want := []int{1, 2, 3}
+	for i, w := range want {
+		if got := d.At(i); got != w {
+			t.Errorf("At(%d) = %d, want %d", i, got, w)
+		}
+	}
+}
+
+func TestGrowsAndShrinksAcrossManyOperations(t *testing.T) {
+	d := New[int]()
+	const n = 1000
+	for i := 0; i < n; i++ {
+		d.PushBack(i)
+	}
+	if d.Len() != n {
+		t.Fatalf("Len() = %d after %d pushes, want %d", d.Len(), n, n)
+	}
+	for i := 0; i < n; i++ {
+		got, ok := d.PopFront()
+		if !ok || got != i {
+			t.Fatalf("PopFront() at step %d = (%d, %v), want (%d, true)", i, got, ok, i)
+		}
+	}
+	if d.Len() != 0 {
+		t.Errorf("Len() = %d after draining %d elements, want 0", d.Len(), n)
+	}
+
+	// The buffer should have shrunk back down rather than staying at
+	// whatever size it grew to, so reusing the now-empty deque for a
+	// small workload doesn't carry the old capacity's memory forever.
+	d.PushBack(1)
+	d.PopFront()
+}
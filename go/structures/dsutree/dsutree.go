@@ -0,0 +1,103 @@
+// Package dsutree implements "sack" / DSU-on-tree: a small-to-large
+// sweep that answers a per-subtree query for every vertex of a rooted
+// tree in O(n log n) total Add/Remove calls, instead of the O(n^2)
+// worst case of rebuilding each subtree's working set from scratch.
+//
+// The trick is the same one structures/virtualtree and the repo's
+// heavy-light-flavored structures lean on: every vertex has at most one
+// "heavy" child (the one rooting its largest subtree), and a vertex can
+// only be the non-heavy child of O(log n) ancestors. Run keeps the
+// heavy child's working set intact across the parent/child boundary and
+// only pays to rebuild the light children's contributions, which is
+// what bounds the total work.
+//
+// There's no heavy-light decomposition or centroid decomposition
+// package in this repo yet to complement; this package stands alone.
+package dsutree
+
+// Callbacks bundles the operations a small-to-large sweep needs from
+// the caller. Add is called once for every vertex entering the current
+// working set, Remove once for every vertex leaving it, and Answer once
+// per vertex with the working set holding exactly that vertex's
+// subtree.
+type Callbacks struct {
+	Add    func(v int)
+	Remove func(v int)
+	Answer func(root int)
+}
+
+// Run sweeps the tree described by the undirected adjacency list adj
+// (n vertices, rooted at root), invoking cb.Add/cb.Remove/cb.Answer so
+// that by the time cb.Answer(v) runs, every cb.Add call since the
+// working set was last empty corresponds to exactly the vertices in v's
+// subtree.
+func Run(n, root int, adj [][]int, cb Callbacks) {
+	size := make([]int, n)
+	heavy := make([]int, n)
+
+	var dfsSize func(u, parent int) int
+	dfsSize = func(u, parent int) int {
+		size[u] = 1
+		heavy[u] = -1
+		best := 0
+		for _, v := range adj[u] {
+			if v == parent {
+				continue
+			}
+			s := dfsSize(v, u)
+			size[u] += s
+			if s > best {
+				best = s
+				heavy[u] = v
+			}
+		}
+		return size[u]
+	}
+	dfsSize(root, -1)
+
+	var addSubtree func(u, parent int)
+	addSubtree = func(u, parent int) {
+		cb.Add(u)
+		for _, v := range adj[u] {
+			if v != parent {
+				addSubtree(v, u)
+			}
+		}
+	}
+
+	var removeSubtree func(u, parent int)
+	removeSubtree = func(u, parent int) {
+		cb.Remove(u)
+		for _, v := range adj[u] {
+			if v != parent {
+				removeSubtree(v, u)
+			}
+		}
+	}
+
+	var dfs func(u, parent int, keep bool)
+	dfs = func(u, parent int, keep bool) {
+		for _, v := range adj[u] {
+			if v != parent && v != heavy[u] {
+				dfs(v, u, false)
+			}
+		}
+		if heavy[u] != -1 {
+			dfs(heavy[u], u, true)
+		}
+
+		cb.Add(u)
+		for _, v := range adj[u] {
+			if v != parent && v != heavy[u] {
+				addSubtree(v, u)
+			}
+		}
+
+		cb.Answer(u)
+
+		if !keep {
+			removeSubtree(u, parent)
+		}
+	}
+	dfs(root, -1, true)
+}
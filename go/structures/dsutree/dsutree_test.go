@@ -0,0 +1,114 @@
+package dsutree
+
+import "testing"
+
+// tree:
+//
+//	       0(red)
+//	     / | \
+//	    1  2  3
+//	 (blue)(red)(blue)
+//	   / \    |
+//	  4   5   6
+//	(red)(blue)(red)
+//	 /
+//	7(blue)
+func testTree() ([][]int, []string) {
+	adj := make([][]int, 8)
+	edges := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {3, 6}, {4, 7}}
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	colors := []string{"red", "blue", "red", "blue", "red", "blue", "red", "blue"}
+	return adj, colors
+}
+
+func distinctColorCounts(n, root int, adj [][]int, colors []string) map[int]int {
+	counts := make(map[string]int)
+	distinct := 0
+	answers := make(map[int]int, n)
+
+	Run(n, root, adj, Callbacks{
+		Add: func(v int) {
+			if counts[colors[v]] == 0 {
+				distinct++
+			}
+			counts[colors[v]]++
+		},
+		Remove: func(v int) {
+			counts[colors[v]]--
+			if counts[colors[v]] == 0 {
+				distinct--
+			}
+		},
+		Answer: func(root int) {
+			answers[root] = distinct
+		},
+	})
+	return answers
+}
+
+func TestRunCountsDistinctColorsPerSubtree(t *testing.T) {
+	adj, colors := testTree()
+	got := distinctColorCounts(8, 0, adj, colors)
+
+	want := map[int]int{
+		7: 1, // {blue}
+		4: 2, // {red, blue}
+		5: 1, // {blue}
+		1: 2, // {blue, red, blue} -> {red, blue}
+		2: 1, // {red}
+		6: 1, // {red}
+		3: 2, // {blue, red} -> {blue, red}
+		0: 2, // everything, still just red/blue
+	}
+	for v, w := range want {
+		if got[v] != w {
+			t.Errorf("distinct colors in subtree(%d) = %d, want %d", v, got[v], w)
+		}
+	}
+}
+
+func TestRunVisitsEveryVertexExactlyOnceForAnswer(t *testing.T) {
+	adj, colors := testTree()
+	seen := make(map[int]int)
+	Run(8, 0, adj, Callbacks{
+		Add:    func(v int) {},
+		Remove: func(v int) {},
+		Answer: func(root int) { seen[root]++ },
+	})
+	if len(seen) != 8 {
+		t.Fatalf("Answer called for %d distinct vertices, want 8", len(seen))
+	}
+	for v, n := range seen {
+		if n != 1 {
+			t.Errorf("Answer(%d) called %d times, want 1", v, n)
+		}
+	}
+	_ = colors
+}
+
+func TestRunSingleVertexTree(t *testing.T) {
+	adj := [][]int{{}}
+	colors := []string{"red"}
+	got := distinctColorCounts(1, 0, adj, colors)
+	if got[0] != 1 {
+		t.Errorf("distinct colors in subtree(0) = %d, want 1", got[0])
+	}
+}
+
+func TestRunAddRemoveBalance(t *testing.T) {
+	adj, _ := testTree()
+	adds, removes := 0, 0
+	Run(8, 0, adj, Callbacks{
+		Add:    func(v int) { adds++ },
+		Remove: func(v int) { removes++ },
+		Answer: func(root int) {},
+	})
+	// Every Add on a vertex must be undone by a matching Remove, except
+	// for the O(n) vertices still held by the root's final working set.
+	if adds-removes != 8 {
+		t.Errorf("adds - removes = %d, want 8 (final working set is the whole tree)", adds-removes)
+	}
+}
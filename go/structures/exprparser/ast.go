@@ -0,0 +1,121 @@
+package exprparser
+
+import "fmt"
+
+// Node is one node of an expression AST: either a numeric literal, a
+// binary operator with two children, a unary operator with one child, or
+// a single-argument function call.
+type Node struct {
+	Op       string // "" for a literal, otherwise an operator or function name
+	Value    float64
+	Children []*Node
+}
+
+// Eval evaluates the AST rooted at n.
+func (n *Node) Eval() (float64, error) {
+	if n.Op == "" {
+		return n.Value, nil
+	}
+	if fn, ok := functions[n.Op]; ok {
+		v, err := n.Children[0].Eval()
+		if err != nil {
+			return 0, err
+		}
+		return fn(v), nil
+	}
+	if n.Op == "u-" {
+		v, err := n.Children[0].Eval()
+		if err != nil {
+			return 0, err
+		}
+		return -v, nil
+	}
+
+	left, err := n.Children[0].Eval()
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.Children[1].Eval()
+	if err != nil {
+		return 0, err
+	}
+	switch n.Op {
+	case "+":
+		return left + right, nil
+	case "-":
+		return left - right, nil
+	case "*":
+		return left * right, nil
+	case "/":
+		if right == 0 {
+			return 0, fmt.Errorf("exprparser: division by zero")
+		}
+		return left / right, nil
+	case "^":
+		return power(left, right), nil
+	default:
+		return 0, fmt.Errorf("exprparser: unknown operator %q", n.Op)
+	}
+}
+
+func power(base, exp float64) float64 {
+	result := 1.0
+	for i := 0; i < int(exp); i++ {
+		result *= base
+	}
+	return result
+}
+
+// ParseAST parses an infix expression into an evaluable AST.
+func ParseAST(expr string) (*Node, error) {
+	postfix, err := ToPostfix(expr)
+	if err != nil {
+		return nil, err
+	}
+	return astFromPostfix(postfix)
+}
+
+// astFromPostfix builds an AST by walking postfix tokens with a stack of
+// partially-built subtrees, the standard way to turn postfix into a tree.
+func astFromPostfix(postfix string) (*Node, error) {
+	var stack []*Node
+	for _, tok := range splitTokens(postfix) {
+		if n, ok := parseNumber(tok); ok {
+			stack = append(stack, &Node{Value: n})
+			continue
+		}
+		if _, ok := functions[tok]; ok {
+			if len(stack) < 1 {
+				return nil, fmt.Errorf("exprparser: malformed postfix near function %q", tok)
+			}
+			arg := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			stack = append(stack, &Node{Op: tok, Children: []*Node{arg}})
+			continue
+		}
+		if tok == "u-" {
+			if len(stack) < 1 {
+				return nil, fmt.Errorf("exprparser: malformed postfix near unary minus")
+			}
+			arg := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			stack = append(stack, &Node{Op: tok, Children: []*Node{arg}})
+			continue
+		}
+		if _, ok := precedence[tok]; ok {
+			if len(stack) < 2 {
+				return nil, fmt.Errorf("exprparser: malformed postfix near operator %q", tok)
+			}
+			right := stack[len(stack)-1]
+			left := stack[len(stack)-2]
+			stack = stack[:len(stack)-2]
+			stack = append(stack, &Node{Op: tok, Children: []*Node{left, right}})
+			continue
+		}
+		return nil, fmt.Errorf("exprparser: unrecognized postfix token %q", tok)
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("exprparser: malformed postfix expression")
+	}
+	return stack[0], nil
+}
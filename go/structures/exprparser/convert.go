@@ -0,0 +1,119 @@
+package exprparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// splitTokens splits a space-separated postfix/prefix string into tokens.
+func splitTokens(s string) []string {
+	return strings.Fields(s)
+}
+
+func parseNumber(tok string) (float64, bool) {
+	n, err := strconv.ParseFloat(tok, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+func arity(tok string) int {
+	if tok == "u-" {
+		return 1
+	}
+	if _, ok := functions[tok]; ok {
+		return 1
+	}
+	return 2
+}
+
+// PostfixToPrefix converts a space-separated postfix expression to prefix
+// notation by walking it left to right with a stack of operand strings,
+// the mirror image of building prefix from postfix tokens.
+func PostfixToPrefix(postfix string) (string, error) {
+	var stack []string
+	for _, tok := range splitTokens(postfix) {
+		if _, ok := parseNumber(tok); ok {
+			stack = append(stack, tok)
+			continue
+		}
+		n := arity(tok)
+		if len(stack) < n {
+			return "", fmt.Errorf("exprparser: malformed postfix near %q", tok)
+		}
+		operands := stack[len(stack)-n:]
+		stack = stack[:len(stack)-n]
+		stack = append(stack, tok+" "+strings.Join(operands, " "))
+	}
+	if len(stack) != 1 {
+		return "", fmt.Errorf("exprparser: malformed postfix expression")
+	}
+	return stack[0], nil
+}
+
+// PrefixToPostfix converts a space-separated prefix expression to postfix
+// notation by walking it right to left with a stack of operand strings.
+func PrefixToPostfix(prefix string) (string, error) {
+	toks := splitTokens(prefix)
+	var stack []string
+	for i := len(toks) - 1; i >= 0; i-- {
+		tok := toks[i]
+		if _, ok := parseNumber(tok); ok {
+			stack = append(stack, tok)
+			continue
+		}
+		n := arity(tok)
+		if len(stack) < n {
+			return "", fmt.Errorf("exprparser: malformed prefix near %q", tok)
+		}
+		// Operands were pushed in right-to-left scan order, i.e. the
+		// reverse of the left-to-right order prefix notation lists them
+		// in; reverse before joining so multi-operand operators keep
+		// their arguments in the right order.
+		operands := append([]string{}, stack[len(stack)-n:]...)
+		for l, r := 0, len(operands)-1; l < r; l, r = l+1, r-1 {
+			operands[l], operands[r] = operands[r], operands[l]
+		}
+		stack = stack[:len(stack)-n]
+		stack = append(stack, strings.Join(operands, " ")+" "+tok)
+	}
+	if len(stack) != 1 {
+		return "", fmt.Errorf("exprparser: malformed prefix expression")
+	}
+	return stack[0], nil
+}
+
+// InfixToPrefix converts an infix expression directly to prefix notation,
+// via postfix as an intermediate step.
+func InfixToPrefix(expr string) (string, error) {
+	postfix, err := ToPostfix(expr)
+	if err != nil {
+		return "", err
+	}
+	return PostfixToPrefix(postfix)
+}
+
+// PostfixToInfix reconstructs a fully-parenthesized infix expression from
+// postfix notation by building the AST and rendering it.
+func PostfixToInfix(postfix string) (string, error) {
+	n, err := astFromPostfix(postfix)
+	if err != nil {
+		return "", err
+	}
+	return renderInfix(n), nil
+}
+
+func renderInfix(n *Node) string {
+	if n.Op == "" {
+		return strconv.FormatFloat(n.Value, 'g', -1, 64)
+	}
+	if _, ok := functions[n.Op]; ok {
+		return fmt.Sprintf("%s(%s)", n.Op, renderInfix(n.Children[0]))
+	}
+	if n.Op == "u-" {
+		return fmt.Sprintf("(-%s)", renderInfix(n.Children[0]))
+	}
+	return fmt.Sprintf("(%s %s %s)", renderInfix(n.Children[0]), n.Op, renderInfix(n.Children[1]))
+}
@@ -0,0 +1,184 @@
+// Package exprparser parses infix arithmetic expressions into postfix
+// output and an evaluable AST using the shunting-yard algorithm, and
+// converts freely between infix, postfix, and prefix notation — the
+// classic stack application.
+//
+// Supported syntax: the four basic operators (+ - * /), exponentiation
+// (^, right-associative), parentheses, unary minus, numeric literals,
+// and single-argument functions (e.g. "sqrt(4+5)").
+package exprparser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// tokenKind classifies a single lexed token.
+type tokenKind int
+
+const (
+	tokNumber tokenKind = iota
+	tokOperator
+	tokFunction
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+}
+
+var precedence = map[string]int{
+	"u-": 4, // unary minus
+	"^":  3,
+	"*":  2,
+	"/":  2,
+	"+":  1,
+	"-":  1,
+}
+
+var rightAssoc = map[string]bool{
+	"^":  true,
+	"u-": true,
+}
+
+var functions = map[string]func(float64) float64{
+	"sqrt": math.Sqrt,
+	"abs":  math.Abs,
+	"sin":  math.Sin,
+	"cos":  math.Cos,
+	"log":  math.Log,
+}
+
+// tokenize lexes expr into tokens, distinguishing unary minus from binary
+// minus by the token that precedes it (start of expression, another
+// operator, a comma, or an open paren all imply unary).
+func tokenize(expr string) ([]token, error) {
+	var toks []token
+	runes := []rune(expr)
+	i := 0
+	prevSignificant := func() *token {
+		if len(toks) == 0 {
+			return nil
+		}
+		return &toks[len(toks)-1]
+	}
+
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			n, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("exprparser: invalid number %q", text)
+			}
+			toks = append(toks, token{kind: tokNumber, text: text, num: n})
+		case unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && unicode.IsLetter(runes[i]) {
+				i++
+			}
+			name := string(runes[start:i])
+			if _, ok := functions[name]; !ok {
+				return nil, fmt.Errorf("exprparser: unknown function %q", name)
+			}
+			toks = append(toks, token{kind: tokFunction, text: name})
+		case r == '(':
+			toks = append(toks, token{kind: tokLParen, text: "("})
+			i++
+		case r == ')':
+			toks = append(toks, token{kind: tokRParen, text: ")"})
+			i++
+		case r == ',':
+			toks = append(toks, token{kind: tokComma, text: ","})
+			i++
+		case strings.ContainsRune("+-*/^", r):
+			op := string(r)
+			if op == "-" {
+				prev := prevSignificant()
+				if prev == nil || prev.kind == tokOperator || prev.kind == tokLParen || prev.kind == tokComma {
+					op = "u-"
+				}
+			}
+			toks = append(toks, token{kind: tokOperator, text: op})
+			i++
+		default:
+			return nil, fmt.Errorf("exprparser: unexpected character %q", r)
+		}
+	}
+	return toks, nil
+}
+
+// ToPostfix converts an infix expression to postfix (reverse Polish)
+// notation via the shunting-yard algorithm, returning its tokens
+// space-separated.
+func ToPostfix(expr string) (string, error) {
+	toks, err := tokenize(expr)
+	if err != nil {
+		return "", err
+	}
+
+	var out []string
+	var ops []token
+
+	popWhile := func(cond func(top token) bool) {
+		for len(ops) > 0 && cond(ops[len(ops)-1]) {
+			out = append(out, ops[len(ops)-1].text)
+			ops = ops[:len(ops)-1]
+		}
+	}
+
+	for _, tk := range toks {
+		switch tk.kind {
+		case tokNumber:
+			out = append(out, tk.text)
+		case tokFunction, tokLParen:
+			ops = append(ops, tk)
+		case tokComma:
+			popWhile(func(top token) bool { return top.kind != tokLParen })
+		case tokOperator:
+			popWhile(func(top token) bool {
+				if top.kind != tokOperator {
+					return false
+				}
+				if rightAssoc[tk.text] {
+					return precedence[top.text] > precedence[tk.text]
+				}
+				return precedence[top.text] >= precedence[tk.text]
+			})
+			ops = append(ops, tk)
+		case tokRParen:
+			popWhile(func(top token) bool { return top.kind != tokLParen })
+			if len(ops) == 0 {
+				return "", fmt.Errorf("exprparser: mismatched parentheses")
+			}
+			ops = ops[:len(ops)-1] // discard the matching '('
+			if len(ops) > 0 && ops[len(ops)-1].kind == tokFunction {
+				out = append(out, ops[len(ops)-1].text)
+				ops = ops[:len(ops)-1]
+			}
+		}
+	}
+	for len(ops) > 0 {
+		top := ops[len(ops)-1]
+		if top.kind == tokLParen {
+			return "", fmt.Errorf("exprparser: mismatched parentheses")
+		}
+		out = append(out, top.text)
+		ops = ops[:len(ops)-1]
+	}
+	return strings.Join(out, " "), nil
+}
@@ -0,0 +1,127 @@
+package exprparser
+
+import "testing"
+
+func TestToPostfixBasic(t *testing.T) {
+	got, err := ToPostfix("3 + 4 * 2")
+	if err != nil {
+		t.Fatalf("ToPostfix() error = %v", err)
+	}
+	if want := "3 4 2 * +"; got != want {
+		t.Errorf("ToPostfix() = %q, want %q", got, want)
+	}
+}
+
+func TestToPostfixParens(t *testing.T) {
+	got, err := ToPostfix("(3 + 4) * 2")
+	if err != nil {
+		t.Fatalf("ToPostfix() error = %v", err)
+	}
+	if want := "3 4 + 2 *"; got != want {
+		t.Errorf("ToPostfix() = %q, want %q", got, want)
+	}
+}
+
+func TestToPostfixUnaryMinus(t *testing.T) {
+	got, err := ToPostfix("-3 + 4")
+	if err != nil {
+		t.Fatalf("ToPostfix() error = %v", err)
+	}
+	if want := "3 u- 4 +"; got != want {
+		t.Errorf("ToPostfix() = %q, want %q", got, want)
+	}
+}
+
+func TestToPostfixFunction(t *testing.T) {
+	got, err := ToPostfix("sqrt(4+5)")
+	if err != nil {
+		t.Fatalf("ToPostfix() error = %v", err)
+	}
+	if want := "4 5 + sqrt"; got != want {
+		t.Errorf("ToPostfix() = %q, want %q", got, want)
+	}
+}
+
+func TestToPostfixMismatchedParens(t *testing.T) {
+	if _, err := ToPostfix("(3 + 4"); err == nil {
+		t.Errorf("ToPostfix() should reject unbalanced parentheses")
+	}
+}
+
+func TestParseASTEval(t *testing.T) {
+	cases := []struct {
+		expr string
+		want float64
+	}{
+		{"3 + 4 * 2", 11},
+		{"(3 + 4) * 2", 14},
+		{"2 ^ 3 + 1", 9},
+		{"-3 + 4", 1},
+		{"sqrt(4+5)", 3},
+	}
+	for _, c := range cases {
+		n, err := ParseAST(c.expr)
+		if err != nil {
+			t.Fatalf("ParseAST(%q) error = %v", c.expr, err)
+		}
+		got, err := n.Eval()
+		if err != nil {
+			t.Fatalf("Eval(%q) error = %v", c.expr, err)
+		}
+		if got != c.want {
+			t.Errorf("Eval(%q) = %v, want %v", c.expr, got, c.want)
+		}
+	}
+}
+
+func TestEvalDivisionByZero(t *testing.T) {
+	n, err := ParseAST("1 / 0")
+	if err != nil {
+		t.Fatalf("ParseAST() error = %v", err)
+	}
+	if _, err := n.Eval(); err == nil {
+		t.Errorf("Eval() should reject division by zero")
+	}
+}
+
+func TestPostfixToPrefixAndBack(t *testing.T) {
+	postfix, err := ToPostfix("3 + 4 * 2")
+	if err != nil {
+		t.Fatalf("ToPostfix() error = %v", err)
+	}
+	prefix, err := PostfixToPrefix(postfix)
+	if err != nil {
+		t.Fatalf("PostfixToPrefix() error = %v", err)
+	}
+	if want := "+ 3 * 4 2"; prefix != want {
+		t.Errorf("PostfixToPrefix() = %q, want %q", prefix, want)
+	}
+
+	back, err := PrefixToPostfix(prefix)
+	if err != nil {
+		t.Fatalf("PrefixToPostfix() error = %v", err)
+	}
+	if back != postfix {
+		t.Errorf("PrefixToPostfix(PostfixToPrefix(x)) = %q, want %q", back, postfix)
+	}
+}
+
+func TestInfixToPrefix(t *testing.T) {
+	got, err := InfixToPrefix("(3 + 4) * 2")
+	if err != nil {
+		t.Fatalf("InfixToPrefix() error = %v", err)
+	}
+	if want := "* + 3 4 2"; got != want {
+		t.Errorf("InfixToPrefix() = %q, want %q", got, want)
+	}
+}
+
+func TestPostfixToInfix(t *testing.T) {
+	got, err := PostfixToInfix("3 4 2 * +")
+	if err != nil {
+		t.Fatalf("PostfixToInfix() error = %v", err)
+	}
+	if want := "(3 + (4 * 2))"; got != want {
+		t.Errorf("PostfixToInfix() = %q, want %q", got, want)
+	}
+}
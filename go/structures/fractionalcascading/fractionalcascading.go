@@ -0,0 +1,163 @@
+// Package fractionalcascading answers "successor of x" queries against
+// k sorted lists at once, faster than running k independent binary
+// searches.
+//
+// The structure merges each list with a sampled subset ("every other
+// element") of the list below it, so that the position found by a
+// single binary search in the topmost merged level already narrows the
+// search in every level beneath it to a constant number of candidates.
+// Querying costs O(log n + k) total — one real binary search plus O(1)
+// amortized work per remaining level — versus O(k log n) for k separate
+// binary searches.
+package fractionalcascading
+
+import (
+	"math"
+	"sort"
+)
+
+// sentinel represents "larger than anything a caller could query for".
+// Every list is internally padded with it so every level always has a
+// real entry that's guaranteed to be a valid (if unhelpful) successor,
+// which keeps the bridging logic below from having to special-case
+// running off the end of a level.
+const sentinel = math.MaxInt
+
+// entry is one element of a merged level: either a real element of that
+// level's own list, or an element promoted up from the level below to
+// guide the search down into it.
+type entry struct {
+	value int
+	real  bool
+	// bridge is the index, within the next level down, of an element
+	// known to be >= value. Unused on the bottommost level.
+	bridge int
+}
+
+// FractionalCascading answers successor queries against the k lists it
+// was built from.
+type FractionalCascading struct {
+	levels [][]entry
+}
+
+// New builds a FractionalCascading structure over lists. Each list is
+// sorted internally; the caller's slices are not modified.
+func New(lists [][]int) *FractionalCascading {
+	k := len(lists)
+	if k == 0 {
+		return &FractionalCascading{}
+	}
+
+	augmented := make([][]int, k)
+	for i, list := range lists {
+		sorted := append([]int{}, list...)
+		sort.Ints(sorted)
+		augmented[i] = append(sorted, sentinel)
+	}
+
+	levels := make([][]entry, k)
+	bottom := make([]entry, len(augmented[k-1]))
+	for j, v := range augmented[k-1] {
+		bottom[j] = entry{value: v, real: true}
+	}
+	levels[k-1] = bottom
+
+	for i := k - 2; i >= 0; i-- {
+		levels[i] = mergeLevel(augmented[i], levels[i+1])
+	}
+	return &FractionalCascading{levels: levels}
+}
+
+// promoted is a sampled (every-other) element of a level, carried up one
+// level during construction to seed that level's bridges.
+type promoted struct {
+	value   int
+	nextIdx int
+}
+
+// mergeLevel merges real (the sorted, sentinel-padded list belonging to
+// this level) with every other element of next, then computes each
+// resulting entry's bridge into next.
+func mergeLevel(real []int, next []entry) []entry {
+	var sample []promoted
+	for j := 0; j < len(next); j += 2 {
+		sample = append(sample, promoted{value: next[j].value, nextIdx: j})
+	}
+
+	merged := make([]entry, 0, len(real)+len(sample))
+	ri, si := 0, 0
+	for ri < len(real) || si < len(sample) {
+		if si >= len(sample) || (ri < len(real) && real[ri] <= sample[si].value) {
+			merged = append(merged, entry{value: real[ri], real: true})
+			ri++
+		} else {
+			merged = append(merged, entry{value: sample[si].value, real: false, bridge: sample[si].nextIdx})
+			si++
+		}
+	}
+
+	// Fill in the bridge for every real entry: the nearest sampled
+	// element to its right (inclusive), found with a single right-to-
+	// left pass. A sampled entry's bridge is already exactly right
+	// (its source index in next), so it only ever updates lastIdx for
+	// the real entries to its left.
+	lastIdx := len(next)
+	for idx := len(merged) - 1; idx >= 0; idx-- {
+		if merged[idx].real {
+			merged[idx].bridge = lastIdx
+		} else {
+			lastIdx = merged[idx].bridge
+		}
+	}
+	return merged
+}
+
+// SuccessorResult is the outcome of a per-list successor query.
+type SuccessorResult struct {
+	Value int
+	Found bool
+}
+
+// SuccessorAll returns, for every list fc was built from (in the same
+// order), the smallest element of that list that is >= x.
+func (fc *FractionalCascading) SuccessorAll(x int) []SuccessorResult {
+	k := len(fc.levels)
+	results := make([]SuccessorResult, k)
+	if k == 0 {
+		return results
+	}
+
+	top := fc.levels[0]
+	p := sort.Search(len(top), func(i int) bool { return top[i].value >= x })
+
+	for i := 0; i < k; i++ {
+		lvl := fc.levels[i]
+		// p starts out exact for i == 0 (it came from a real binary
+		// search); for i > 0 it's a bridge value that's guaranteed to
+		// be >= x but may overshoot by the handful of entries that
+		// separate consecutive sampled elements, so walk it back left
+		// to the true leftmost position >= x.
+		for p > 0 && lvl[p-1].value >= x {
+			p--
+		}
+
+		results[i] = firstReal(lvl, p)
+
+		if i+1 < k {
+			p = lvl[p].bridge
+		}
+	}
+	return results
+}
+
+// firstReal scans forward from p for the nearest real (not sampled)
+// entry, which is the level's own answer to the successor query.
+func firstReal(lvl []entry, p int) SuccessorResult {
+	for p < len(lvl) && !lvl[p].real {
+		p++
+	}
+	if p == len(lvl) || lvl[p].value == sentinel {
+		return SuccessorResult{}
+	}
+	return SuccessorResult{Value: lvl[p].value, Found: true}
+}
@@ -0,0 +1,80 @@
+package fractionalcascading
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func bruteForceSuccessorAll(lists [][]int, x int) []SuccessorResult {
+	results := make([]SuccessorResult, len(lists))
+	for i, list := range lists {
+		sorted := append([]int{}, list...)
+		sort.Ints(sorted)
+		p := sort.Search(len(sorted), func(j int) bool { return sorted[j] >= x })
+		if p < len(sorted) {
+			results[i] = SuccessorResult{Value: sorted[p], Found: true}
+		}
+	}
+	return results
+}
+
+func TestSuccessorAllBasic(t *testing.T) {
+	lists := [][]int{
+		{1, 5, 9, 20},
+		{2, 3, 4, 100},
+		{50},
+		{},
+	}
+	fc := New(lists)
+
+	tests := []int{-5, 0, 1, 2, 5, 6, 20, 21, 50, 51, 1000}
+	for _, x := range tests {
+		got := fc.SuccessorAll(x)
+		want := bruteForceSuccessorAll(lists, x)
+		for i := range lists {
+			if got[i] != want[i] {
+				t.Errorf("SuccessorAll(%d)[%d] = %+v, want %+v", x, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestSuccessorAllAgainstBruteForceRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	lists := make([][]int, 7)
+	for i := range lists {
+		n := r.Intn(50)
+		list := make([]int, n)
+		for j := range list {
+			list[j] = r.Intn(500)
+		}
+		lists[i] = list
+	}
+	fc := New(lists)
+
+	for x := -10; x <= 510; x++ {
+		got := fc.SuccessorAll(x)
+		want := bruteForceSuccessorAll(lists, x)
+		for i := range lists {
+			if got[i] != want[i] {
+				t.Fatalf("SuccessorAll(%d)[%d] = %+v, want %+v", x, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestEmptyStructure(t *testing.T) {
+	fc := New(nil)
+	if got := fc.SuccessorAll(5); len(got) != 0 {
+		t.Errorf("SuccessorAll on an empty structure = %v, want an empty slice", got)
+	}
+}
+
+func TestSingleList(t *testing.T) {
+	fc := New([][]int{{3, 1, 4, 1, 5, 9, 2, 6}})
+	got := fc.SuccessorAll(5)
+	if !got[0].Found || got[0].Value != 5 {
+		t.Errorf("SuccessorAll(5) = %+v, want {Value: 5, Found: true}", got[0])
+	}
+}
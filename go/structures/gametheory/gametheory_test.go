@@ -0,0 +1,111 @@
+package gametheory
+
+import "testing"
+
+func TestGrundySinglePileNim(t *testing.T) {
+	calc := NewGrundyCalculator(func(pile int) []int {
+		var moves []int
+		for take := 1; take <= pile; take++ {
+			moves = append(moves, pile-take)
+		}
+		return moves
+	})
+	for pile := 0; pile <= 10; pile++ {
+		if got, want := calc.Grundy(pile), NimValue(pile); got != want {
+			t.Errorf("Grundy(%d) = %d, want %d", pile, got, want)
+		}
+	}
+}
+
+func TestGrundyMemoizes(t *testing.T) {
+	calls := 0
+	calc := NewGrundyCalculator(func(n int) []int {
+		calls++
+		if n == 0 {
+			return nil
+		}
+		return []int{n - 1}
+	})
+	calc.Grundy(5)
+	firstCalls := calls
+	calc.Grundy(5)
+	if calls != firstCalls {
+		t.Errorf("second Grundy(5) call re-explored the game tree: calls went from %d to %d", firstCalls, calls)
+	}
+}
+
+func TestNimSumAndFirstPlayerWins(t *testing.T) {
+	tests := []struct {
+		piles []int
+		sum   int
+		wins  bool
+	}{
+		{[]int{1, 2, 3}, 0, false},
+		{[]int{3, 4, 5}, 2, true},
+		{[]int{0, 0, 0}, 0, false},
+		{[]int{7}, 7, true},
+	}
+	for _, tt := range tests {
+		if got := NimSum(tt.piles); got != tt.sum {
+			t.Errorf("NimSum(%v) = %d, want %d", tt.piles, got, tt.sum)
+		}
+		if got := FirstPlayerWins(tt.piles); got != tt.wins {
+			t.Errorf("FirstPlayerWins(%v) = %v, want %v", tt.piles, got, tt.wins)
+		}
+	}
+}
+
+func TestSubtractionGameGrundy(t *testing.T) {
+	// Subtraction set {1, 2, 3}: this is well known to reduce to
+	// g[k] = k mod 4.
+	g := SubtractionGameGrundy(12, []int{1, 2, 3})
+	for k := 0; k <= 12; k++ {
+		if want := k % 4; g[k] != want {
+			t.Errorf("g[%d] = %d, want %d", k, g[k], want)
+		}
+	}
+}
+
+func TestMinimaxAndAlphaBetaAgree(t *testing.T) {
+	game := NewTicTacToeGame()
+	var empty TicTacToeBoard
+	minimaxVal := game.Minimax(empty, 9)
+	alphaBetaVal := game.AlphaBeta(empty, 9, -1<<30, 1<<30)
+	if minimaxVal != alphaBetaVal {
+		t.Fatalf("Minimax = %d, AlphaBeta = %d, want equal", minimaxVal, alphaBetaVal)
+	}
+	if minimaxVal != 0 {
+		t.Errorf("optimal tic-tac-toe from an empty board = %d, want 0 (a draw)", minimaxVal)
+	}
+}
+
+func TestBestMoveTakesWinningMove(t *testing.T) {
+	game := NewTicTacToeGame()
+	// X to move, can win immediately by completing the top row.
+	b := TicTacToeBoard{
+		'X', 'X', 0,
+		'O', 'O', 0,
+		0, 0, 0,
+	}
+	best, value := game.BestMove(b, 9)
+	if best[2] != 'X' {
+		t.Errorf("BestMove did not take the winning move: %v", best)
+	}
+	if value != 1 {
+		t.Errorf("BestMove value = %d, want 1 (a win for X)", value)
+	}
+}
+
+func TestBestMoveBlocksLoss(t *testing.T) {
+	game := NewTicTacToeGame()
+	// O to move, must block X's win on the top row.
+	b := TicTacToeBoard{
+		'X', 'X', 0,
+		'O', 0, 0,
+		0, 0, 0,
+	}
+	best, _ := game.BestMove(b, 9)
+	if best[2] != 'O' {
+		t.Errorf("BestMove did not block the threatened loss: %v", best)
+	}
+}
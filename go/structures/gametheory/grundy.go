@@ -0,0 +1,50 @@
+// Package gametheory provides combinatorial-game tools: Grundy-number
+// (Sprague-Grundy) computation over user-defined move functions, Nim and
+// a Nim variant, and a generic minimax/alpha-beta searcher demonstrated
+// on tic-tac-toe.
+package gametheory
+
+// GrundyCalculator computes Sprague-Grundy numbers for an impartial game
+// whose states are of type S, given a Moves function describing how the
+// game's position graph is reachable. Results are memoized per state,
+// since the same state is commonly reachable by many different paths.
+type GrundyCalculator[S comparable] struct {
+	moves func(state S) []S
+	memo  map[S]int
+}
+
+// NewGrundyCalculator returns a calculator for the impartial game whose
+// legal moves from state are given by moves. moves must return the empty
+// slice for terminal (losing, by normal play convention) states.
+func NewGrundyCalculator[S comparable](moves func(state S) []S) *GrundyCalculator[S] {
+	return &GrundyCalculator[S]{
+		moves: moves,
+		memo:  make(map[S]int),
+	}
+}
+
+// Grundy returns the Sprague-Grundy number of state: the minimum excludant
+// (mex) of the Grundy numbers of the states reachable from it in one move.
+// A state with no moves has Grundy number 0.
+func (g *GrundyCalculator[S]) Grundy(state S) int {
+	if v, ok := g.memo[state]; ok {
+		return v
+	}
+	reachable := g.moves(state)
+	seen := make(map[int]bool, len(reachable))
+	for _, next := range reachable {
+		seen[g.Grundy(next)] = true
+	}
+	v := mex(seen)
+	g.memo[state] = v
+	return v
+}
+
+// mex returns the smallest non-negative integer not present in seen.
+func mex(seen map[int]bool) int {
+	v := 0
+	for seen[v] {
+		v++
+	}
+	return v
+}
@@ -0,0 +1,153 @@
+package gametheory
+
+import (
+	"math"
+
+	"github.com/ghostmkg/dsa-code/go/structures/phashmap"
+)
+
+// Game describes a two-player, zero-sum, perfect-information game over
+// states of type S, for use with Minimax and AlphaBeta. It mirrors the
+// backtrack package's function-struct style rather than an interface, so
+// a game can be wired up from plain functions/closures without defining
+// a named type.
+type Game[S any] struct {
+	// Moves returns the states reachable from state in one move. It is
+	// only called on states for which Terminal is false.
+	Moves func(state S) []S
+
+	// Terminal reports whether state ends the game (a win, loss, or
+	// draw), or the search has otherwise run out of moves to make.
+	Terminal func(state S) bool
+
+	// Evaluate scores a terminal (or depth-exhausted) state from the
+	// maximizing player's perspective: higher is better for that player.
+	Evaluate func(state S) int
+
+	// Maximizing reports whether the player to move at state is the
+	// maximizing player.
+	Maximizing func(state S) bool
+
+	// Key, if set, returns a canonical key for state, enabling a
+	// transposition table that caches AlphaBeta results across states
+	// reached by more than one path. The table is keyed only by state,
+	// not by remaining search depth, so caching is only safe when every
+	// AlphaBeta call in a given search is made with the same depth — as
+	// this package's own tic-tac-toe demo does, always searching to the
+	// end of the game. Leave nil to disable the table.
+	Key func(state S) string
+
+	table *phashmap.Map[string, int]
+}
+
+// Minimax returns the game-theoretic value of state, searching up to
+// depth moves ahead (or until a Terminal state, if reached sooner),
+// without pruning.
+func (g *Game[S]) Minimax(state S, depth int) int {
+	if depth == 0 || g.Terminal(state) {
+		return g.Evaluate(state)
+	}
+	moves := g.Moves(state)
+	if len(moves) == 0 {
+		return g.Evaluate(state)
+	}
+	if g.Maximizing(state) {
+		best := math.MinInt
+		for _, next := range moves {
+			if v := g.Minimax(next, depth-1); v > best {
+				best = v
+			}
+		}
+		return best
+	}
+	best := math.MaxInt
+	for _, next := range moves {
+		if v := g.Minimax(next, depth-1); v < best {
+			best = v
+		}
+	}
+	return best
+}
+
+// AlphaBeta returns the same value as Minimax would for state and depth,
+// but prunes branches that can't affect the result, using the window
+// [alpha, beta]. Callers searching from scratch should pass
+// math.MinInt and math.MaxInt. If Key is set, results are additionally
+// cached in a transposition table (see the Key field's doc comment for
+// its depth-consistency requirement).
+func (g *Game[S]) AlphaBeta(state S, depth, alpha, beta int) int {
+	if g.Key == nil {
+		return g.alphaBeta(state, depth, alpha, beta)
+	}
+	key := g.Key(state)
+	if g.table != nil {
+		if v, ok := g.table.Get(key); ok {
+			return v
+		}
+	}
+	result := g.alphaBeta(state, depth, alpha, beta)
+	if g.table == nil {
+		g.table = phashmap.New[string, int](phashmap.HashString)
+	}
+	g.table = g.table.Set(key, result)
+	return result
+}
+
+func (g *Game[S]) alphaBeta(state S, depth, alpha, beta int) int {
+	if depth == 0 || g.Terminal(state) {
+		return g.Evaluate(state)
+	}
+	moves := g.Moves(state)
+	if len(moves) == 0 {
+		return g.Evaluate(state)
+	}
+	if g.Maximizing(state) {
+		best := math.MinInt
+		for _, next := range moves {
+			v := g.AlphaBeta(next, depth-1, alpha, beta)
+			if v > best {
+				best = v
+			}
+			if best > alpha {
+				alpha = best
+			}
+			if alpha >= beta {
+				break
+			}
+		}
+		return best
+	}
+	best := math.MaxInt
+	for _, next := range moves {
+		v := g.AlphaBeta(next, depth-1, alpha, beta)
+		if v < best {
+			best = v
+		}
+		if best < beta {
+			beta = best
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	return best
+}
+
+// BestMove returns the move (a state from Moves(state)) that AlphaBeta
+// ranks highest for the player to move at state, searching up to depth
+// moves ahead, along with its value.
+func (g *Game[S]) BestMove(state S, depth int) (best S, value int) {
+	maximizing := g.Maximizing(state)
+	if maximizing {
+		value = math.MinInt
+	} else {
+		value = math.MaxInt
+	}
+	for _, next := range g.Moves(state) {
+		v := g.AlphaBeta(next, depth-1, math.MinInt, math.MaxInt)
+		if (maximizing && v > value) || (!maximizing && v < value) {
+			value, best = v, next
+		}
+	}
+	return best, value
+}
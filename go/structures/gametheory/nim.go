@@ -0,0 +1,45 @@
+package gametheory
+
+// NimValue returns the Grundy number of a single Nim pile of the given
+// size. For standard Nim, a pile's Grundy number equals its size: from a
+// pile of n you can move to a pile of any size in [0, n), so the mex of
+// {0, 1, ..., n-1} is n.
+func NimValue(pile int) int {
+	return pile
+}
+
+// NimSum returns the Grundy number of a multi-pile Nim position: the XOR
+// of the individual pile sizes. A position is a loss for the player about
+// to move (under normal play) exactly when its Nim-sum is 0.
+func NimSum(piles []int) int {
+	sum := 0
+	for _, p := range piles {
+		sum ^= p
+	}
+	return sum
+}
+
+// FirstPlayerWins reports whether the player about to move in a standard
+// Nim game with the given pile sizes can force a win with optimal play.
+func FirstPlayerWins(piles []int) bool {
+	return NimSum(piles) != 0
+}
+
+// SubtractionGameGrundy computes Grundy numbers g[0..n] for the
+// subtraction game: from a pile of size k, a move removes s items for any
+// s in subtractionSet with s <= k. g[0] is always 0 (no moves available).
+// Unlike standard Nim, a single pile's Grundy number need not equal its
+// size, so this is computed bottom-up rather than returned directly.
+func SubtractionGameGrundy(n int, subtractionSet []int) []int {
+	g := make([]int, n+1)
+	for k := 1; k <= n; k++ {
+		seen := make(map[int]bool, len(subtractionSet))
+		for _, s := range subtractionSet {
+			if s <= k {
+				seen[g[k-s]] = true
+			}
+		}
+		g[k] = mex(seen)
+	}
+	return g
+}
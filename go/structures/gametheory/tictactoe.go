@@ -0,0 +1,93 @@
+package gametheory
+
+// TicTacToeBoard is a 3x3 board flattened row-major, cell values 0
+// (empty), 'X', or 'O'.
+type TicTacToeBoard [9]byte
+
+var ticTacToeLines = [8][3]int{
+	{0, 1, 2}, {3, 4, 5}, {6, 7, 8}, // rows
+	{0, 3, 6}, {1, 4, 7}, {2, 5, 8}, // columns
+	{0, 4, 8}, {2, 4, 6}, // diagonals
+}
+
+// ticTacToeTurn returns the mark of the player to move: 'X' moves first,
+// and since marks alternate, X is to move exactly when the counts are
+// equal.
+func ticTacToeTurn(b TicTacToeBoard) byte {
+	var xs, os int
+	for _, c := range b {
+		switch c {
+		case 'X':
+			xs++
+		case 'O':
+			os++
+		}
+	}
+	if xs == os {
+		return 'X'
+	}
+	return 'O'
+}
+
+// ticTacToeWinner returns the winning mark, or 0 if no line is complete.
+func ticTacToeWinner(b TicTacToeBoard) byte {
+	for _, line := range ticTacToeLines {
+		a, bb, c := b[line[0]], b[line[1]], b[line[2]]
+		if a != 0 && a == bb && bb == c {
+			return a
+		}
+	}
+	return 0
+}
+
+// NewTicTacToeGame returns a Game over TicTacToeBoard states, with X as
+// the maximizing player and a transposition table enabled (keyed by the
+// board's own contents). It's meant to be searched to the full depth of
+// the game (at most 9 plies), satisfying AlphaBeta's table-requires-
+// constant-depth rule.
+func NewTicTacToeGame() *Game[TicTacToeBoard] {
+	return &Game[TicTacToeBoard]{
+		Moves: func(b TicTacToeBoard) []TicTacToeBoard {
+			if ticTacToeWinner(b) != 0 {
+				return nil
+			}
+			mark := ticTacToeTurn(b)
+			var moves []TicTacToeBoard
+			for i, c := range b {
+				if c == 0 {
+					next := b
+					next[i] = mark
+					moves = append(moves, next)
+				}
+			}
+			return moves
+		},
+		Terminal: func(b TicTacToeBoard) bool {
+			if ticTacToeWinner(b) != 0 {
+				return true
+			}
+			for _, c := range b {
+				if c == 0 {
+					return false
+				}
+			}
+			return true
+		},
+		Evaluate: func(b TicTacToeBoard) int {
+			switch ticTacToeWinner(b) {
+			case 'X':
+				return 1
+			case 'O':
+				return -1
+			default:
+				return 0
+			}
+		},
+		Maximizing: func(b TicTacToeBoard) bool {
+			return ticTacToeTurn(b) == 'X'
+		},
+		Key: func(b TicTacToeBoard) string {
+			return string(b[:])
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package genetic
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Run evolves a population of config.PopulationSize individuals for
+// config.Generations generations, breeding each next generation from
+// selector's picks via problem.Crossover, mutating the result with
+// probability config.MutationRate, and carrying the current
+// generation's config.Elitism fittest individuals over unchanged. It
+// returns the fittest individual seen across every generation and its
+// fitness.
+func Run[S any](problem Problem[S], selector Selector[S], config Config, rng *rand.Rand) (S, float64) {
+	pop := make([]S, config.PopulationSize)
+	for i := range pop {
+		pop[i] = problem.RandomIndividual(rng)
+	}
+
+	var best S
+	bestFitness := math.Inf(-1)
+	hasBest := false
+
+	for gen := 0; gen < config.Generations; gen++ {
+		fitness := make([]float64, len(pop))
+		order := make([]int, len(pop))
+		for i, ind := range pop {
+			fitness[i] = problem.Fitness(ind)
+			order[i] = i
+			if !hasBest || fitness[i] > bestFitness {
+				best, bestFitness, hasBest = ind, fitness[i], true
+			}
+		}
+
+		sort.Slice(order, func(a, b int) bool { return fitness[order[a]] > fitness[order[b]] })
+
+		next := make([]S, 0, config.PopulationSize)
+		for i := 0; i < config.Elitism && i < len(order); i++ {
+			next = append(next, pop[order[i]])
+		}
+		for len(next) < config.PopulationSize {
+			a := selector(pop, fitness, rng)
+			b := selector(pop, fitness, rng)
+			child := problem.Crossover(a, b, rng)
+			if rng.Float64() < config.MutationRate {
+				child = problem.Mutate(child, rng)
+			}
+			next = append(next, child)
+		}
+		pop = next
+	}
+
+	return best, bestFitness
+}
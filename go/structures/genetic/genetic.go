@@ -0,0 +1,37 @@
+// Package genetic implements a generic genetic algorithm engine: a
+// population of individuals of any caller-defined encoding evolves over
+// a fixed number of generations via selection, crossover, mutation, and
+// elitism. Plugging in a new problem means supplying a Problem's four
+// functions (how fit an individual is, how to make a random one, and
+// how to crossover/mutate a pair) — see the package's tests for worked
+// examples evolving a knapsack packing and a target string.
+package genetic
+
+import "math/rand"
+
+// Problem bundles what this package's engine needs to know about an
+// encoding to evolve a population of it: Fitness scores an individual
+// (higher is better), RandomIndividual seeds the initial population,
+// Crossover combines two parents into a child, and Mutate randomly
+// perturbs an individual, returning the (possibly) changed result.
+type Problem[S any] struct {
+	Fitness          func(individual S) float64
+	RandomIndividual func(rng *rand.Rand) S
+	Crossover        func(a, b S, rng *rand.Rand) S
+	Mutate           func(individual S, rng *rand.Rand) S
+}
+
+// Config controls the engine's population size and how many
+// generations it runs for.
+type Config struct {
+	PopulationSize int
+	Generations    int
+	// MutationRate is the probability, in [0, 1], that a freshly bred
+	// child is mutated before joining the next generation.
+	MutationRate float64
+	// Elitism is how many of the current generation's fittest
+	// individuals are copied unchanged into the next generation,
+	// guaranteeing fitness never regresses from one generation to the
+	// next.
+	Elitism int
+}
@@ -0,0 +1,55 @@
+package genetic
+
+import "math/rand"
+
+// Selector picks one individual from pop to breed, given each
+// individual's fitness (pop[i] scores fitness[i]).
+type Selector[S any] func(pop []S, fitness []float64, rng *rand.Rand) S
+
+// TournamentSelector returns a Selector that picks size individuals
+// uniformly at random and returns the fittest of them — larger size
+// pushes selection pressure toward the fittest individuals harder, at
+// the cost of population diversity.
+func TournamentSelector[S any](size int) Selector[S] {
+	return func(pop []S, fitness []float64, rng *rand.Rand) S {
+		best := rng.Intn(len(pop))
+		for i := 1; i < size; i++ {
+			candidate := rng.Intn(len(pop))
+			if fitness[candidate] > fitness[best] {
+				best = candidate
+			}
+		}
+		return pop[best]
+	}
+}
+
+// RouletteSelector returns a Selector that picks an individual with
+// probability proportional to its fitness (fitness-proportionate
+// selection), falling back to a uniform pick if every fitness is
+// non-positive (roulette selection needs positive weights to be
+// meaningful).
+func RouletteSelector[S any]() Selector[S] {
+	return func(pop []S, fitness []float64, rng *rand.Rand) S {
+		total := 0.0
+		for _, f := range fitness {
+			if f > 0 {
+				total += f
+			}
+		}
+		if total <= 0 {
+			return pop[rng.Intn(len(pop))]
+		}
+
+		target := rng.Float64() * total
+		cum := 0.0
+		for i, f := range fitness {
+			if f > 0 {
+				cum += f
+			}
+			if cum >= target {
+				return pop[i]
+			}
+		}
+		return pop[len(pop)-1]
+	}
+}
@@ -0,0 +1,135 @@
+// Package interval provides a shared Interval type and the handful of
+// classic algorithms built on top of it: merging overlapping intervals,
+// inserting a new interval into a sorted disjoint set, maximum
+// non-overlapping activity selection, minimum meeting rooms via a
+// sweep line, and total interval coverage.
+package interval
+
+import "sort"
+
+// Interval is a closed range [Start, End]. Start must be <= End.
+type Interval struct {
+	Start, End int
+}
+
+func overlaps(a, b Interval) bool {
+	return a.Start <= b.End && b.Start <= a.End
+}
+
+// Merge sorts intervals by start and merges every overlapping or
+// touching pair, returning the minimal disjoint set covering the same
+// points. intervals is not modified.
+func Merge(intervals []Interval) []Interval {
+	if len(intervals) == 0 {
+		return nil
+	}
+	sorted := append([]Interval{}, intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+
+	out := []Interval{sorted[0]}
+	for _, cur := range sorted[1:] {
+		last := &out[len(out)-1]
+		if cur.Start <= last.End {
+			if cur.End > last.End {
+				last.End = cur.End
+			}
+		} else {
+			out = append(out, cur)
+		}
+	}
+	return out
+}
+
+// Insert inserts newInterval into intervals, which must already be
+// sorted by start and pairwise disjoint, merging it with any intervals
+// it overlaps, and returns the updated sorted disjoint set.
+func Insert(intervals []Interval, newInterval Interval) []Interval {
+	var out []Interval
+	i, n := 0, len(intervals)
+
+	for i < n && intervals[i].End < newInterval.Start {
+		out = append(out, intervals[i])
+		i++
+	}
+	for i < n && overlaps(intervals[i], newInterval) {
+		if intervals[i].Start < newInterval.Start {
+			newInterval.Start = intervals[i].Start
+		}
+		if intervals[i].End > newInterval.End {
+			newInterval.End = intervals[i].End
+		}
+		i++
+	}
+	out = append(out, newInterval)
+	out = append(out, intervals[i:]...)
+	return out
+}
+
+// MaxNonOverlapping returns the largest subset of intervals with no two
+// overlapping, via the classic greedy "earliest end time first"
+// activity-selection strategy. intervals is not modified.
+func MaxNonOverlapping(intervals []Interval) []Interval {
+	if len(intervals) == 0 {
+		return nil
+	}
+	sorted := append([]Interval{}, intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].End < sorted[j].End })
+
+	out := []Interval{sorted[0]}
+	lastEnd := sorted[0].End
+	for _, cur := range sorted[1:] {
+		if cur.Start > lastEnd {
+			out = append(out, cur)
+			lastEnd = cur.End
+		}
+	}
+	return out
+}
+
+// MinMeetingRooms returns the minimum number of rooms needed to hold
+// every interval simultaneously, via a sweep line over sorted start and
+// end times: the running count of active intervals at any point is the
+// rooms needed at that point, and the answer is its maximum. Unlike
+// Merge/Insert/Coverage, intervals here are treated as half-open
+// [Start, End) meeting times, so a meeting starting exactly when another
+// ends doesn't need its own room.
+func MinMeetingRooms(intervals []Interval) int {
+	if len(intervals) == 0 {
+		return 0
+	}
+	starts := make([]int, len(intervals))
+	ends := make([]int, len(intervals))
+	for i, iv := range intervals {
+		starts[i] = iv.Start
+		ends[i] = iv.End
+	}
+	sort.Ints(starts)
+	sort.Ints(ends)
+
+	rooms, maxRooms := 0, 0
+	si, ei := 0, 0
+	for si < len(starts) {
+		if starts[si] < ends[ei] {
+			rooms++
+			si++
+		} else {
+			rooms--
+			ei++
+		}
+		if rooms > maxRooms {
+			maxRooms = rooms
+		}
+	}
+	return maxRooms
+}
+
+// Coverage returns the total length of the points covered by at least
+// one interval (the length of their union), counting each integer point
+// in a closed interval, so [1,3] covers length 3.
+func Coverage(intervals []Interval) int {
+	total := 0
+	for _, iv := range Merge(intervals) {
+		total += iv.End - iv.Start + 1
+	}
+	return total
+}
@@ -0,0 +1,87 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	got := Merge([]Interval{{1, 3}, {2, 6}, {8, 10}, {15, 18}})
+	want := []Interval{{1, 6}, {8, 10}, {15, 18}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeTouching(t *testing.T) {
+	got := Merge([]Interval{{1, 4}, {4, 5}})
+	want := []Interval{{1, 5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeEmpty(t *testing.T) {
+	if got := Merge(nil); got != nil {
+		t.Errorf("Merge(nil) = %v, want nil", got)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	got := Insert([]Interval{{1, 3}, {6, 9}}, Interval{2, 5})
+	want := []Interval{{1, 5}, {6, 9}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Insert() = %v, want %v", got, want)
+	}
+}
+
+func TestInsertNoOverlap(t *testing.T) {
+	got := Insert([]Interval{{1, 2}, {3, 5}, {6, 7}, {8, 10}, {12, 16}}, Interval{4, 8})
+	want := []Interval{{1, 2}, {3, 10}, {12, 16}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Insert() = %v, want %v", got, want)
+	}
+}
+
+func TestInsertIntoEmpty(t *testing.T) {
+	got := Insert(nil, Interval{5, 7})
+	want := []Interval{{5, 7}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Insert() = %v, want %v", got, want)
+	}
+}
+
+func TestMaxNonOverlapping(t *testing.T) {
+	// Closed intervals touching at a shared endpoint count as
+	// overlapping (consistent with Merge), so {1,3} and {3,5} can't
+	// both be picked.
+	got := MaxNonOverlapping([]Interval{{1, 3}, {2, 4}, {3, 5}, {6, 8}})
+	want := []Interval{{1, 3}, {6, 8}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MaxNonOverlapping() = %v, want %v", got, want)
+	}
+}
+
+func TestMinMeetingRooms(t *testing.T) {
+	cases := []struct {
+		intervals []Interval
+		want      int
+	}{
+		{[]Interval{{0, 30}, {5, 10}, {15, 20}}, 2},
+		{[]Interval{{7, 10}, {2, 4}}, 1},
+		{[]Interval{{1, 5}, {5, 10}}, 1}, // half-open: back-to-back reuses the room
+		{nil, 0},
+	}
+	for _, c := range cases {
+		if got := MinMeetingRooms(c.intervals); got != c.want {
+			t.Errorf("MinMeetingRooms(%v) = %d, want %d", c.intervals, got, c.want)
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	got := Coverage([]Interval{{1, 3}, {2, 6}, {8, 10}})
+	if want := 9; got != want { // [1,6] (length 6) + [8,10] (length 3)
+		t.Errorf("Coverage() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,121 @@
+// Package iproute implements an IPv4 routing table keyed by CIDR
+// prefixes and queried by longest-prefix match, the same rule real IP
+// routers use to pick which of several overlapping routes applies to a
+// destination address.
+//
+// The table is a binary trie over the address's 32 bits, one level per
+// bit from the most significant down: inserting a /n prefix walks n
+// levels and records the route at the node reached, and looking up an
+// address walks down following its bits while remembering the deepest
+// node visited that carries a route — that route is, by construction,
+// the most specific (longest) prefix covering the address.
+package iproute
+
+import "fmt"
+
+// bitWidth is the number of bits in an IPv4 address.
+const bitWidth = 32
+
+type node[V any] struct {
+	children [2]*node[V]
+	value    V
+	hasValue bool
+}
+
+// Table is an IPv4 routing table mapping CIDR prefixes to values of type
+// V, supporting insertion and longest-prefix-match lookup.
+type Table[V any] struct {
+	root *node[V]
+	size int
+}
+
+// New returns an empty Table.
+func New[V any]() *Table[V] {
+	return &Table[V]{root: &node[V]{}}
+}
+
+// Len returns the number of distinct prefixes currently in the table.
+func (t *Table[V]) Len() int {
+	return t.size
+}
+
+func bit(addr uint32, i int) uint32 {
+	return (addr >> (bitWidth - 1 - i)) & 1
+}
+
+// Insert records value as the route for the CIDR prefix addr/prefixLen,
+// overwriting any route already stored for that exact prefix. prefixLen
+// must be in [0, 32].
+func (t *Table[V]) Insert(addr uint32, prefixLen int, value V) error {
+	if prefixLen < 0 || prefixLen > bitWidth {
+		return fmt.Errorf("iproute: prefix length %d out of range [0, %d]", prefixLen, bitWidth)
+	}
+	n := t.root
+	for i := 0; i < prefixLen; i++ {
+		b := bit(addr, i)
+		if n.children[b] == nil {
+			n.children[b] = &node[V]{}
+		}
+		n = n.children[b]
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value, n.hasValue = value, true
+	return nil
+}
+
+// Lookup returns the value routed for addr by the longest (most
+// specific) prefix in the table that covers it, reporting false if no
+// prefix covers addr at all.
+func (t *Table[V]) Lookup(addr uint32) (V, bool) {
+	n := t.root
+	var best V
+	found := false
+	if n.hasValue {
+		best, found = n.value, true
+	}
+	for i := 0; i < bitWidth && n.children[bit(addr, i)] != nil; i++ {
+		n = n.children[bit(addr, i)]
+		if n.hasValue {
+			best, found = n.value, true
+		}
+	}
+	return best, found
+}
+
+// ParseCIDR parses a dotted-quad CIDR string such as "10.0.0.0/8" into an
+// address and prefix length suitable for Insert.
+func ParseCIDR(cidr string) (addr uint32, prefixLen int, err error) {
+	var a, b, c, d, p int
+	n, scanErr := fmt.Sscanf(cidr, "%d.%d.%d.%d/%d", &a, &b, &c, &d, &p)
+	if scanErr != nil || n != 5 {
+		return 0, 0, fmt.Errorf("iproute: invalid CIDR %q", cidr)
+	}
+	for _, octet := range [4]int{a, b, c, d} {
+		if octet < 0 || octet > 255 {
+			return 0, 0, fmt.Errorf("iproute: invalid CIDR %q", cidr)
+		}
+	}
+	if p < 0 || p > bitWidth {
+		return 0, 0, fmt.Errorf("iproute: invalid CIDR %q", cidr)
+	}
+	addr = uint32(a)<<24 | uint32(b)<<16 | uint32(c)<<8 | uint32(d)
+	return addr, p, nil
+}
+
+// ParseIP parses a dotted-quad IPv4 address such as "10.1.2.3" into its
+// 32-bit representation.
+func ParseIP(ip string) (uint32, error) {
+	var a, b, c, d int
+	n, err := fmt.Sscanf(ip, "%d.%d.%d.%d", &a, &b, &c, &d)
+	if err != nil || n != 4 {
+		return 0, fmt.Errorf("iproute: invalid IP %q", ip)
+	}
+	for _, octet := range [4]int{a, b, c, d} {
+		if octet < 0 || octet > 255 {
+			return 0, fmt.Errorf("iproute: invalid IP %q", ip)
+		}
+	}
+	return uint32(a)<<24 | uint32(b)<<16 | uint32(c)<<8 | uint32(d), nil
+}
@@ -0,0 +1,112 @@
+package iproute
+
+import "testing"
+
+func mustCIDR(t *testing.T, cidr string) (uint32, int) {
+	t.Helper()
+	addr, prefixLen, err := ParseCIDR(cidr)
+	if err != nil {
+		t.Fatalf("ParseCIDR(%q) error: %v", cidr, err)
+	}
+	return addr, prefixLen
+}
+
+func TestLongestPrefixMatch(t *testing.T) {
+	tbl := New[string]()
+
+	routes := map[string]string{
+		"0.0.0.0/0":      "default",
+		"10.0.0.0/8":     "ten-net",
+		"10.1.0.0/16":    "ten-one-net",
+		"10.1.2.0/24":    "ten-one-two-net",
+		"192.168.1.0/24": "home-net",
+	}
+	for cidr, value := range routes {
+		addr, prefixLen := mustCIDR(t, cidr)
+		if err := tbl.Insert(addr, prefixLen, value); err != nil {
+			t.Fatalf("Insert(%q) error: %v", cidr, err)
+		}
+	}
+
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{"10.1.2.5", "ten-one-two-net"},
+		{"10.1.3.5", "ten-one-net"},
+		{"10.2.3.5", "ten-net"},
+		{"192.168.1.5", "home-net"},
+		{"8.8.8.8", "default"},
+	}
+	for _, tc := range tests {
+		addr, err := ParseIP(tc.ip)
+		if err != nil {
+			t.Fatalf("ParseIP(%q) error: %v", tc.ip, err)
+		}
+		got, ok := tbl.Lookup(addr)
+		if !ok {
+			t.Errorf("Lookup(%q) found no route, want %q", tc.ip, tc.want)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Lookup(%q) = %q, want %q", tc.ip, got, tc.want)
+		}
+	}
+}
+
+func TestLookupNoRoute(t *testing.T) {
+	tbl := New[string]()
+	addr, prefixLen := mustCIDR(t, "10.0.0.0/8")
+	_ = tbl.Insert(addr, prefixLen, "ten-net")
+
+	query, _ := ParseIP("192.168.1.1")
+	if _, ok := tbl.Lookup(query); ok {
+		t.Error("Lookup found a route for an address outside every inserted prefix")
+	}
+}
+
+func TestInsertOverwritesExactPrefix(t *testing.T) {
+	tbl := New[string]()
+	addr, prefixLen := mustCIDR(t, "10.0.0.0/8")
+	_ = tbl.Insert(addr, prefixLen, "first")
+	_ = tbl.Insert(addr, prefixLen, "second")
+
+	if tbl.Len() != 1 {
+		t.Errorf("Len() = %d, want 1 after overwriting the same prefix", tbl.Len())
+	}
+	query, _ := ParseIP("10.1.1.1")
+	got, ok := tbl.Lookup(query)
+	if !ok || got != "second" {
+		t.Errorf("Lookup = (%q, %v), want (\"second\", true)", got, ok)
+	}
+}
+
+func TestInsertInvalidPrefixLength(t *testing.T) {
+	tbl := New[string]()
+	if err := tbl.Insert(0, 33, "x"); err == nil {
+		t.Error("Insert with prefix length 33 succeeded, want error")
+	}
+}
+
+func TestParseCIDRInvalid(t *testing.T) {
+	tests := []string{"10.0.0.0", "10.0.0.0/33", "300.0.0.0/8", "not-a-cidr"}
+	for _, cidr := range tests {
+		if _, _, err := ParseCIDR(cidr); err == nil {
+			t.Errorf("ParseCIDR(%q) succeeded, want error", cidr)
+		}
+	}
+}
+
+func TestZeroPrefixIsDefaultRoute(t *testing.T) {
+	tbl := New[string]()
+	addr, prefixLen := mustCIDR(t, "0.0.0.0/0")
+	_ = tbl.Insert(addr, prefixLen, "default")
+
+	for _, ip := range []string{"1.2.3.4", "255.255.255.255", "0.0.0.0"} {
+		query, _ := ParseIP(ip)
+		got, ok := tbl.Lookup(query)
+		if !ok || got != "default" {
+			t.Errorf("Lookup(%q) = (%q, %v), want (\"default\", true)", ip, got, ok)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package lca answers many lowest-common-ancestor queries against a
+// fixed tree at once via Tarjan's offline algorithm: one DFS plus a
+// union-find, answering every query the moment both of its nodes have
+// been fully explored, in O((n + q) log n) total instead of paying for
+// each query independently.
+package lca
+
+// Query asks for the lowest common ancestor of U and V.
+type Query struct {
+	U, V int
+}
+
+// disjointSet is a plain (non-concurrent) union-find with path
+// compression and union by size; Tarjan's offline LCA algorithm is
+// single-threaded, so it doesn't need the locking the concurrency
+// package's union-find pays for.
+type disjointSet struct {
+	parent []int
+	size   []int
+}
+
+func newDisjointSet(n int) *disjointSet {
+	parent := make([]int, n)
+	size := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+		size[i] = 1
+	}
+	return &disjointSet{parent: parent, size: size}
+}
+
+func (d *disjointSet) find(x int) int {
+	for d.parent[x] != x {
+		d.parent[x] = d.parent[d.parent[x]]
+		x = d.parent[x]
+	}
+	return x
+}
+
+func (d *disjointSet) union(x, y int) {
+	rx, ry := d.find(x), d.find(y)
+	if rx == ry {
+		return
+	}
+	if d.size[rx] < d.size[ry] {
+		rx, ry = ry, rx
+	}
+	d.parent[ry] = rx
+	d.size[rx] += d.size[ry]
+}
+
+// OfflineLCA answers every query in queries against the tree described
+// by adj (an undirected adjacency list over nodes 0..n-1) rooted at
+// root, returning one LCA per query in the same order.
+func OfflineLCA(n, root int, adj [][]int, queries []Query) []int {
+	ds := newDisjointSet(n)
+	ancestor := make([]int, n)
+	for i := range ancestor {
+		ancestor[i] = i
+	}
+	visited := make([]bool, n)
+	results := make([]int, len(queries))
+
+	// pending[u] holds the index of every query with u as one endpoint;
+	// whichever endpoint finishes its DFS subtree second looks up the
+	// other endpoint's ancestor to get the query's answer.
+	pending := make([][]int, n)
+	for qi, q := range queries {
+		pending[q.U] = append(pending[q.U], qi)
+		if q.V != q.U {
+			pending[q.V] = append(pending[q.V], qi)
+		}
+	}
+
+	var dfs func(u, parent int)
+	dfs = func(u, parent int) {
+		visited[u] = true
+		for _, v := range adj[u] {
+			if v == parent {
+				continue
+			}
+			dfs(v, u)
+			ds.union(v, u)
+			ancestor[ds.find(u)] = u
+		}
+		for _, qi := range pending[u] {
+			q := queries[qi]
+			v := q.V
+			if v == u {
+				v = q.U
+			}
+			if visited[v] {
+				results[qi] = ancestor[ds.find(v)]
+			}
+		}
+	}
+	dfs(root, -1)
+
+	return results
+}
@@ -0,0 +1,58 @@
+package lca
+
+import "testing"
+
+// tree:
+//
+//	       0
+//	     / | \
+//	    1  2  3
+//	   / \    |
+//	  4   5   6
+//	 /
+//	7
+func testTree() [][]int {
+	adj := make([][]int, 8)
+	edges := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {3, 6}, {4, 7}}
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	return adj
+}
+
+func TestOfflineLCA(t *testing.T) {
+	adj := testTree()
+	queries := []Query{
+		{4, 5},
+		{7, 5},
+		{4, 6},
+		{2, 3},
+		{7, 7},
+		{0, 6},
+	}
+	want := []int{1, 1, 0, 0, 7, 0}
+
+	got := OfflineLCA(8, 0, adj, queries)
+	for i, q := range queries {
+		if got[i] != want[i] {
+			t.Errorf("LCA(%d, %d) = %d, want %d", q.U, q.V, got[i], want[i])
+		}
+	}
+}
+
+func TestOfflineLCANoQueries(t *testing.T) {
+	adj := testTree()
+	got := OfflineLCA(8, 0, adj, nil)
+	if len(got) != 0 {
+		t.Errorf("OfflineLCA with no queries = %v, want empty", got)
+	}
+}
+
+func TestOfflineLCASingleNodeTree(t *testing.T) {
+	adj := make([][]int, 1)
+	got := OfflineLCA(1, 0, adj, []Query{{0, 0}})
+	if len(got) != 1 || got[0] != 0 {
+		t.Errorf("OfflineLCA() = %v, want [0]", got)
+	}
+}
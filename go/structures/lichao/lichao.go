@@ -0,0 +1,162 @@
+// Package lichao implements a Li Chao tree, the standard generalization
+// of the convex hull trick (CHT) to queries interleaved arbitrarily with
+// insertions: it answers the best (maximum or minimum) value among a
+// dynamic set of inserted lines at any query point x, with each
+// insertion and query taking O(log(hi-lo)) time. This repo had no prior
+// CHT module; a Li Chao tree supersedes what a bare CHT would offer
+// here, since it additionally supports restricting a line to a segment
+// (InsertSegment) and, because nodes are allocated lazily rather than as
+// one dense array over the domain, scales to a sparse or very wide
+// coordinate domain without pre-listing every x-coordinate in advance.
+package lichao
+
+// Line is y = M*x + B.
+type Line struct {
+	M, B int64
+}
+
+// At evaluates the line at x.
+func (l Line) At(x int64) int64 {
+	return l.M*x + l.B
+}
+
+type node struct {
+	line        Line
+	hasLine     bool
+	left, right *node
+}
+
+// Tree is a Li Chao tree over the closed domain [Lo, Hi]. Query(x) is
+// only valid for x in that domain.
+type Tree struct {
+	lo, hi int64
+	max    bool
+	root   *node
+}
+
+// New returns an empty Tree over the closed domain [lo, hi]. If max is
+// true, Query returns the maximum value among inserted lines/segments at
+// each point; if false, the minimum.
+func New(lo, hi int64, max bool) *Tree {
+	return &Tree{lo: lo, hi: hi, max: max}
+}
+
+// better reports whether a should be preferred over b under the tree's
+// max/min mode.
+func (t *Tree) better(a, b int64) bool {
+	if t.max {
+		return a > b
+	}
+	return a < b
+}
+
+// InsertLine inserts a line valid across the tree's entire domain.
+func (t *Tree) InsertLine(l Line) {
+	t.insert(&t.root, t.lo, t.hi, l)
+}
+
+// InsertSegment inserts l as valid only for x in [segLo, segHi] (clamped
+// to the tree's domain); outside that range it has no effect on queries.
+func (t *Tree) InsertSegment(l Line, segLo, segHi int64) {
+	if segLo < t.lo {
+		segLo = t.lo
+	}
+	if segHi > t.hi {
+		segHi = t.hi
+	}
+	if segLo > segHi {
+		return
+	}
+	t.insertSegment(&t.root, t.lo, t.hi, segLo, segHi, l)
+}
+
+// insert is the classic recursive Li Chao insertion over [lo, hi]: it
+// keeps whichever of the incoming line and the node's current line wins
+// at mid as the node's line, then recurses into whichever half the loser
+// could still win in a sub-range of.
+func (t *Tree) insert(n **node, lo, hi int64, l Line) {
+	if *n == nil {
+		*n = &node{line: l, hasLine: true}
+		return
+	}
+	if !(*n).hasLine {
+		(*n).line = l
+		(*n).hasLine = true
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	leftBetter := t.better(l.At(lo), (*n).line.At(lo))
+	midBetter := t.better(l.At(mid), (*n).line.At(mid))
+
+	if midBetter {
+		(*n).line, l = l, (*n).line
+	}
+	if lo == hi {
+		return
+	}
+	if leftBetter != midBetter {
+		t.insert(&(*n).left, lo, mid, l)
+	} else {
+		t.insert(&(*n).right, mid+1, hi, l)
+	}
+}
+
+// insertSegment restricts the classic insert to the part of [lo, hi]
+// that overlaps [segLo, segHi]: ranges entirely outside the segment are
+// skipped, ranges entirely inside it are handed to insert, and ranges
+// straddling a segment boundary are split in two and each half
+// recursed into separately.
+func (t *Tree) insertSegment(n **node, lo, hi, segLo, segHi int64, l Line) {
+	if segHi < lo || hi < segLo {
+		return
+	}
+	if segLo <= lo && hi <= segHi {
+		t.insert(n, lo, hi, l)
+		return
+	}
+	if *n == nil {
+		*n = &node{}
+	}
+	mid := lo + (hi-lo)/2
+	t.insertSegment(&(*n).left, lo, mid, segLo, segHi, l)
+	t.insertSegment(&(*n).right, mid+1, hi, segLo, segHi, l)
+}
+
+// Query returns the best value among every inserted line/segment that
+// covers x, and whether any line covers x at all.
+func (t *Tree) Query(x int64) (int64, bool) {
+	return t.query(t.root, t.lo, t.hi, x)
+}
+
+func (t *Tree) query(n *node, lo, hi, x int64) (int64, bool) {
+	if n == nil {
+		return 0, false
+	}
+
+	best, ok := int64(0), false
+	if n.hasLine {
+		best, ok = n.line.At(x), true
+	}
+
+	if lo == hi {
+		return best, ok
+	}
+
+	mid := lo + (hi-lo)/2
+	var childVal int64
+	var childOK bool
+	if x <= mid {
+		childVal, childOK = t.query(n.left, lo, mid, x)
+	} else {
+		childVal, childOK = t.query(n.right, mid+1, hi, x)
+	}
+
+	if !childOK {
+		return best, ok
+	}
+	if !ok || t.better(childVal, best) {
+		return childVal, true
+	}
+	return best, ok
+}
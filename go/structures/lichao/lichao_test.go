@@ -0,0 +1,102 @@
+package lichao
+
+import "testing"
+
+func TestTreeMaxOverFullLines(t *testing.T) {
+	tree := New(-100, 100, true)
+	lines := []Line{{M: 1, B: 0}, {M: -1, B: 10}, {M: 0, B: 5}}
+	for _, l := range lines {
+		tree.InsertLine(l)
+	}
+
+	for x := int64(-100); x <= 100; x += 7 {
+		got, ok := tree.Query(x)
+		if !ok {
+			t.Fatalf("Query(%d): ok = false, want true", x)
+		}
+		want := lines[0].At(x)
+		for _, l := range lines[1:] {
+			if v := l.At(x); v > want {
+				want = v
+			}
+		}
+		if got != want {
+			t.Errorf("Query(%d) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestTreeMinOverFullLines(t *testing.T) {
+	tree := New(-50, 50, false)
+	lines := []Line{{M: 2, B: 0}, {M: -2, B: 3}, {M: 0, B: -1}}
+	for _, l := range lines {
+		tree.InsertLine(l)
+	}
+
+	for x := int64(-50); x <= 50; x += 3 {
+		got, ok := tree.Query(x)
+		if !ok {
+			t.Fatalf("Query(%d): ok = false, want true", x)
+		}
+		want := lines[0].At(x)
+		for _, l := range lines[1:] {
+			if v := l.At(x); v < want {
+				want = v
+			}
+		}
+		if got != want {
+			t.Errorf("Query(%d) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestTreeInsertSegmentRestrictsDomain(t *testing.T) {
+	tree := New(0, 100, true)
+	tree.InsertSegment(Line{M: 0, B: 100}, 10, 20) // only wins inside [10, 20]
+	tree.InsertLine(Line{M: 0, B: 1})              // a worse baseline everywhere
+
+	for x := int64(0); x <= 100; x++ {
+		got, ok := tree.Query(x)
+		if !ok {
+			t.Fatalf("Query(%d): ok = false, want true", x)
+		}
+		want := int64(1)
+		if x >= 10 && x <= 20 {
+			want = 100
+		}
+		if got != want {
+			t.Errorf("Query(%d) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestTreeQueryWithNoLinesCoveringX(t *testing.T) {
+	tree := New(0, 100, true)
+	tree.InsertSegment(Line{M: 1, B: 0}, 0, 5)
+	if _, ok := tree.Query(50); ok {
+		t.Errorf("Query(50): ok = true, want false (no segment covers x=50)")
+	}
+	if _, ok := tree.Query(3); !ok {
+		t.Errorf("Query(3): ok = false, want true (segment [0,5] covers x=3)")
+	}
+}
+
+func TestTreeSparseDomain(t *testing.T) {
+	// A domain far too wide to afford a dense array, exercised only at
+	// a handful of scattered points, to check that nodes really are
+	// allocated lazily rather than eagerly across the whole range.
+	const lo, hi = 0, 1_000_000_000
+	tree := New(lo, hi, false) // minimize, so the flat segment can win over the flat baseline
+	tree.InsertLine(Line{M: 0, B: 1000})
+	tree.InsertSegment(Line{M: 0, B: -1}, 500_000_000, 500_000_100)
+
+	if got, ok := tree.Query(1); !ok || got != 1000 {
+		t.Errorf("Query(1) = (%d, %v), want (1000, true)", got, ok)
+	}
+	if got, ok := tree.Query(500_000_050); !ok || got != -1 {
+		t.Errorf("Query(500000050) = (%d, %v), want (-1, true)", got, ok)
+	}
+	if got, ok := tree.Query(hi); !ok || got != 1000 {
+		t.Errorf("Query(hi) = (%d, %v), want (1000, true)", got, ok)
+	}
+}
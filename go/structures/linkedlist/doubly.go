@@ -0,0 +1,68 @@
+package linkedlist
+
+// DNode is one node of a doubly linked list.
+type DNode[T any] struct {
+	Val        T
+	Next, Prev *DNode[T]
+}
+
+// DList is a doubly linked list of T, supporting O(1) push/remove at
+// either end or at any node already in the list.
+type DList[T any] struct {
+	Head, Tail *DNode[T]
+	size       int
+}
+
+// Len returns the number of nodes in the list.
+func (l *DList[T]) Len() int { return l.size }
+
+// PushBack appends val and returns its node.
+func (l *DList[T]) PushBack(val T) *DNode[T] {
+	n := &DNode[T]{Val: val, Prev: l.Tail}
+	if l.Tail == nil {
+		l.Head = n
+	} else {
+		l.Tail.Next = n
+	}
+	l.Tail = n
+	l.size++
+	return n
+}
+
+// PushFront prepends val and returns its node.
+func (l *DList[T]) PushFront(val T) *DNode[T] {
+	n := &DNode[T]{Val: val, Next: l.Head}
+	if l.Head == nil {
+		l.Tail = n
+	} else {
+		l.Head.Prev = n
+	}
+	l.Head = n
+	l.size++
+	return n
+}
+
+// Remove removes n from the list in O(1), relinking its neighbors.
+func (l *DList[T]) Remove(n *DNode[T]) {
+	if n.Prev != nil {
+		n.Prev.Next = n.Next
+	} else {
+		l.Head = n.Next
+	}
+	if n.Next != nil {
+		n.Next.Prev = n.Prev
+	} else {
+		l.Tail = n.Prev
+	}
+	n.Next, n.Prev = nil, nil
+	l.size--
+}
+
+// ToSlice returns every value in the list, head to tail.
+func (l *DList[T]) ToSlice() []T {
+	out := make([]T, 0, l.size)
+	for n := l.Head; n != nil; n = n.Next {
+		out = append(out, n.Val)
+	}
+	return out
+}
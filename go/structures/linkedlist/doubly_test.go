@@ -0,0 +1,58 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDListPushBackAndFront(t *testing.T) {
+	l := &DList[int]{}
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v, want [1 2 3]", got)
+	}
+	if l.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", l.Len())
+	}
+	if l.Head.Val != 1 || l.Tail.Val != 3 {
+		t.Errorf("Head/Tail = %v/%v, want 1/3", l.Head.Val, l.Tail.Val)
+	}
+}
+
+func TestDListRemoveMiddle(t *testing.T) {
+	l := &DList[int]{}
+	l.PushBack(1)
+	mid := l.PushBack(2)
+	l.PushBack(3)
+
+	l.Remove(mid)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("ToSlice() after removing middle = %v, want [1 3]", got)
+	}
+	if l.Head.Next != l.Tail {
+		t.Errorf("Head.Next should now be Tail directly")
+	}
+}
+
+func TestDListRemoveHeadAndTail(t *testing.T) {
+	l := &DList[int]{}
+	head := l.PushBack(1)
+	l.PushBack(2)
+	tail := l.PushBack(3)
+
+	l.Remove(head)
+	l.Remove(tail)
+
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{2}) {
+		t.Errorf("ToSlice() = %v, want [2]", got)
+	}
+	if l.Head != l.Tail || l.Head.Val != 2 {
+		t.Errorf("Head and Tail should both be the remaining node with value 2")
+	}
+	if l.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", l.Len())
+	}
+}
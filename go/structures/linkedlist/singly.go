@@ -0,0 +1,191 @@
+// Package linkedlist implements singly and doubly linked lists with the
+// classic operations interview problems build on top of them: reversal,
+// merging sorted lists, Floyd cycle detection, k-group reversal, and
+// reordering.
+package linkedlist
+
+// Node is one node of a singly linked list. It's exported so algorithms
+// that want the classic "operate directly on *Node" shape (as most
+// linked-list interview problems are phrased) can do so, instead of only
+// through List's methods.
+type Node[T any] struct {
+	Val  T
+	Next *Node[T]
+}
+
+// List is a singly linked list of T.
+type List[T any] struct {
+	Head *Node[T]
+}
+
+// New builds a List from values, in order.
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	var tail *Node[T]
+	for _, v := range values {
+		n := &Node[T]{Val: v}
+		if tail == nil {
+			l.Head = n
+		} else {
+			tail.Next = n
+		}
+		tail = n
+	}
+	return l
+}
+
+// ToSlice returns every value in the list, in order. It does not
+// terminate if the list is cyclic.
+func (l *List[T]) ToSlice() []T {
+	var out []T
+	for n := l.Head; n != nil; n = n.Next {
+		out = append(out, n.Val)
+	}
+	return out
+}
+
+// ReverseIterative reverses the list in place by walking it once, relinking
+// each node to point at its predecessor.
+func (l *List[T]) ReverseIterative() {
+	var prev *Node[T]
+	cur := l.Head
+	for cur != nil {
+		next := cur.Next
+		cur.Next = prev
+		prev = cur
+		cur = next
+	}
+	l.Head = prev
+}
+
+// ReverseRecursive reverses the list via ReverseNodeRecursive, which is
+// useful on its own when working with a sub-list that isn't a whole List.
+func (l *List[T]) ReverseRecursive() {
+	l.Head = ReverseNodeRecursive(l.Head)
+}
+
+// ReverseNodeRecursive reverses the list starting at head and returns the
+// new head, recursing one node at a time.
+func ReverseNodeRecursive[T any](head *Node[T]) *Node[T] {
+	if head == nil || head.Next == nil {
+		return head
+	}
+	newHead := ReverseNodeRecursive(head.Next)
+	head.Next.Next = head
+	head.Next = nil
+	return newHead
+}
+
+// Merge merges two sorted lists a and b into one sorted list, consuming
+// both (their nodes are relinked, not copied) and returning the merged
+// result. less must report whether x sorts before y.
+func Merge[T any](a, b *List[T], less func(x, y T) bool) *List[T] {
+	dummy := &Node[T]{}
+	tail := dummy
+	x, y := a.Head, b.Head
+
+	for x != nil && y != nil {
+		if less(y.Val, x.Val) {
+			tail.Next = y
+			y = y.Next
+		} else {
+			tail.Next = x
+			x = x.Next
+		}
+		tail = tail.Next
+	}
+	if x != nil {
+		tail.Next = x
+	} else {
+		tail.Next = y
+	}
+	return &List[T]{Head: dummy.Next}
+}
+
+// DetectCycleStart runs Floyd's cycle detection (a slow and fast pointer,
+// the fast one moving twice as far each step) to find whether the list is
+// cyclic and, if so, the node where the cycle begins. It returns nil if
+// there is no cycle.
+func (l *List[T]) DetectCycleStart() *Node[T] {
+	slow, fast := l.Head, l.Head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			// slow and fast have met somewhere inside the cycle; walking
+			// a second pointer from the head at the same pace as slow
+			// will meet it exactly at the cycle's start, a standard
+			// consequence of the distances involved in Floyd's algorithm.
+			p := l.Head
+			for p != slow {
+				p = p.Next
+				slow = slow.Next
+			}
+			return p
+		}
+	}
+	return nil
+}
+
+// ReverseKGroup reverses the list k nodes at a time; a final group with
+// fewer than k remaining nodes is left in its original order, matching
+// the classic LeetCode 25 behavior.
+func (l *List[T]) ReverseKGroup(k int) {
+	if k < 2 {
+		return
+	}
+	l.Head = reverseKGroup(l.Head, k)
+}
+
+func reverseKGroup[T any](head *Node[T], k int) *Node[T] {
+	node := head
+	for i := 0; i < k; i++ {
+		if node == nil {
+			return head // fewer than k nodes remain; leave this group untouched
+		}
+		node = node.Next
+	}
+
+	// node now points just past this group; recurse first so the
+	// remainder of the list is already correctly reversed-in-groups
+	// before this group relinks its tail to it.
+	newHead := reverseKGroup(node, k)
+
+	cur := head
+	prev := newHead
+	for i := 0; i < k; i++ {
+		next := cur.Next
+		cur.Next = prev
+		prev = cur
+		cur = next
+	}
+	return prev
+}
+
+// Reorder rearranges L0,L1,...,Ln into L0,Ln,L1,Ln-1,L2,Ln-2,... in place
+// (LeetCode 143), by splitting the list at its midpoint, reversing the
+// second half, and weaving the two halves back together.
+func (l *List[T]) Reorder() {
+	if l.Head == nil || l.Head.Next == nil {
+		return
+	}
+
+	slow, fast := l.Head, l.Head
+	for fast.Next != nil && fast.Next.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+	secondHalf := slow.Next
+	slow.Next = nil
+	secondHalf = ReverseNodeRecursive(secondHalf)
+
+	first := l.Head
+	for secondHalf != nil {
+		firstNext := first.Next
+		secondNext := secondHalf.Next
+		first.Next = secondHalf
+		secondHalf.Next = firstNext
+		first = firstNext
+		secondHalf = secondNext
+	}
+}
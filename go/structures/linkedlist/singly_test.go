@@ -0,0 +1,133 @@
+package linkedlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewAndToSlice(t *testing.T) {
+	l := New(1, 2, 3)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestReverseIterative(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	l.ReverseIterative()
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{4, 3, 2, 1}) {
+		t.Errorf("ReverseIterative() = %v, want [4 3 2 1]", got)
+	}
+}
+
+func TestReverseRecursive(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	l.ReverseRecursive()
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{4, 3, 2, 1}) {
+		t.Errorf("ReverseRecursive() = %v, want [4 3 2 1]", got)
+	}
+}
+
+func TestReverseEmptyAndSingle(t *testing.T) {
+	empty := New[int]()
+	empty.ReverseIterative()
+	if got := empty.ToSlice(); len(got) != 0 {
+		t.Errorf("ReverseIterative() on empty list = %v, want []", got)
+	}
+
+	single := New(1)
+	single.ReverseRecursive()
+	if got := single.ToSlice(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("ReverseRecursive() on single-node list = %v, want [1]", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := New(1, 3, 5)
+	b := New(2, 4, 6)
+	less := func(x, y int) bool { return x < y }
+
+	merged := Merge(a, b, less)
+	if got := merged.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Merge() = %v, want [1 2 3 4 5 6]", got)
+	}
+}
+
+func TestMergeOneEmpty(t *testing.T) {
+	a := New[int]()
+	b := New(1, 2, 3)
+	merged := Merge(a, b, func(x, y int) bool { return x < y })
+	if got := merged.ToSlice(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Merge() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestDetectCycleStartNoCycle(t *testing.T) {
+	l := New(1, 2, 3)
+	if got := l.DetectCycleStart(); got != nil {
+		t.Errorf("DetectCycleStart() on acyclic list = %v, want nil", got)
+	}
+}
+
+func TestDetectCycleStartWithCycle(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+
+	// Manually walk to the 3rd node (value 3) and relink the tail to it to
+	// create a cycle.
+	var cycleStart *Node[int]
+	n := l.Head
+	for n != nil {
+		if n.Val == 3 {
+			cycleStart = n
+		}
+		if n.Next == nil {
+			n.Next = cycleStart
+			break
+		}
+		n = n.Next
+	}
+
+	if got := l.DetectCycleStart(); got != cycleStart {
+		t.Errorf("DetectCycleStart() = %v, want the node with value 3", got.Val)
+	}
+}
+
+func TestReverseKGroup(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	l.ReverseKGroup(2)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{2, 1, 4, 3, 5}) {
+		t.Errorf("ReverseKGroup(2) = %v, want [2 1 4 3 5]", got)
+	}
+}
+
+func TestReverseKGroupExactMultiple(t *testing.T) {
+	l := New(1, 2, 3, 4, 5, 6)
+	l.ReverseKGroup(3)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{3, 2, 1, 6, 5, 4}) {
+		t.Errorf("ReverseKGroup(3) = %v, want [3 2 1 6 5 4]", got)
+	}
+}
+
+func TestReverseKGroupLessThanK(t *testing.T) {
+	l := New(1, 2)
+	l.ReverseKGroup(3)
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("ReverseKGroup(3) on a 2-node list = %v, want [1 2]", got)
+	}
+}
+
+func TestReorderOdd(t *testing.T) {
+	l := New(1, 2, 3, 4, 5)
+	l.Reorder()
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 5, 2, 4, 3}) {
+		t.Errorf("Reorder() = %v, want [1 5 2 4 3]", got)
+	}
+}
+
+func TestReorderEven(t *testing.T) {
+	l := New(1, 2, 3, 4)
+	l.Reorder()
+	if got := l.ToSlice(); !reflect.DeepEqual(got, []int{1, 4, 2, 3}) {
+		t.Errorf("Reorder() = %v, want [1 4 2 3]", got)
+	}
+}
@@ -0,0 +1,198 @@
+// Package matrix provides a generic Matrix[T] with the handful of
+// transforms grid problems keep reimplementing on raw [][]T: rotation,
+// transpose, spiral traversal, zeroing rows/columns by a predicate, and
+// toroidal (wrap-around) neighbor lookup.
+package matrix
+
+import "fmt"
+
+// Matrix is a dense 2D grid of T, stored row-major.
+type Matrix[T any] struct {
+	data [][]T
+	rows int
+	cols int
+}
+
+// New returns a rows x cols Matrix with every cell set to the zero value
+// of T.
+func New[T any](rows, cols int) *Matrix[T] {
+	data := make([][]T, rows)
+	for i := range data {
+		data[i] = make([]T, cols)
+	}
+	return &Matrix[T]{data: data, rows: rows, cols: cols}
+}
+
+// FromRows builds a Matrix from data, which must be rectangular (every row
+// the same length).
+func FromRows[T any](data [][]T) (*Matrix[T], error) {
+	if len(data) == 0 {
+		return &Matrix[T]{}, nil
+	}
+	cols := len(data[0])
+	for i, row := range data {
+		if len(row) != cols {
+			return nil, fmt.Errorf("matrix: row %d has length %d, want %d (rows must be rectangular)", i, len(row), cols)
+		}
+	}
+	return &Matrix[T]{data: data, rows: len(data), cols: cols}, nil
+}
+
+// Rows returns the number of rows.
+func (m *Matrix[T]) Rows() int { return m.rows }
+
+// Cols returns the number of columns.
+func (m *Matrix[T]) Cols() int { return m.cols }
+
+// Get returns the value at (r, c).
+func (m *Matrix[T]) Get(r, c int) T { return m.data[r][c] }
+
+// Set stores val at (r, c).
+func (m *Matrix[T]) Set(r, c int, val T) { m.data[r][c] = val }
+
+// RowSlice returns row r's backing slice directly (not a copy), so callers
+// can range over it without going through Get.
+func (m *Matrix[T]) RowSlice(r int) []T { return m.data[r] }
+
+// TransposeInPlace swaps m[i][j] with m[j][i] for every i < j. It panics
+// on a non-square matrix, since an in-place transpose can't change a
+// matrix's dimensions.
+func (m *Matrix[T]) TransposeInPlace() {
+	if m.rows != m.cols {
+		panic("matrix: TransposeInPlace requires a square matrix")
+	}
+	for i := 0; i < m.rows; i++ {
+		for j := i + 1; j < m.cols; j++ {
+			m.data[i][j], m.data[j][i] = m.data[j][i], m.data[i][j]
+		}
+	}
+}
+
+// RotateClockwise rotates m 90 degrees clockwise in place. It panics on a
+// non-square matrix for the same reason TransposeInPlace does. The
+// classic approach: transpose, then reverse each row.
+func (m *Matrix[T]) RotateClockwise() {
+	m.TransposeInPlace()
+	for i := 0; i < m.rows; i++ {
+		row := m.data[i]
+		for l, r := 0, len(row)-1; l < r; l, r = l+1, r-1 {
+			row[l], row[r] = row[r], row[l]
+		}
+	}
+}
+
+// SpiralOrder returns every element of m visited in clockwise spiral
+// order, starting from (0, 0).
+func (m *Matrix[T]) SpiralOrder() []T {
+	if m.rows == 0 || m.cols == 0 {
+		return nil
+	}
+	out := make([]T, 0, m.rows*m.cols)
+	top, bottom := 0, m.rows-1
+	left, right := 0, m.cols-1
+
+	for top <= bottom && left <= right {
+		for c := left; c <= right; c++ {
+			out = append(out, m.data[top][c])
+		}
+		top++
+		for r := top; r <= bottom; r++ {
+			out = append(out, m.data[r][right])
+		}
+		right--
+		if top <= bottom {
+			for c := right; c >= left; c-- {
+				out = append(out, m.data[bottom][c])
+			}
+			bottom--
+		}
+		if left <= right {
+			for r := bottom; r >= top; r-- {
+				out = append(out, m.data[r][left])
+			}
+			left++
+		}
+	}
+	return out
+}
+
+// ZeroRowsCols zeroes out every row and column containing at least one
+// cell for which isZero reports true, in place, using O(1) extra space by
+// marking the first row/column as it scans rather than allocating
+// separate boolean trackers (the standard trick for LeetCode 73, "Set
+// Matrix Zeroes", generalized to a caller-supplied zero predicate instead
+// of hardcoding the number 0).
+func (m *Matrix[T]) ZeroRowsCols(isZero func(T) bool) {
+	if m.rows == 0 || m.cols == 0 {
+		return
+	}
+	var zero T
+	firstRowHasZero, firstColHasZero := false, false
+
+	for c := 0; c < m.cols; c++ {
+		if isZero(m.data[0][c]) {
+			firstRowHasZero = true
+		}
+	}
+	for r := 0; r < m.rows; r++ {
+		if isZero(m.data[r][0]) {
+			firstColHasZero = true
+		}
+	}
+
+	for r := 1; r < m.rows; r++ {
+		for c := 1; c < m.cols; c++ {
+			if isZero(m.data[r][c]) {
+				m.data[r][0] = zero
+				m.data[0][c] = zero
+			}
+		}
+	}
+
+	for r := 1; r < m.rows; r++ {
+		if isZero(m.data[r][0]) {
+			for c := 0; c < m.cols; c++ {
+				m.data[r][c] = zero
+			}
+		}
+	}
+	for c := 1; c < m.cols; c++ {
+		if isZero(m.data[0][c]) {
+			for r := 0; r < m.rows; r++ {
+				m.data[r][c] = zero
+			}
+		}
+	}
+
+	if firstRowHasZero {
+		for c := 0; c < m.cols; c++ {
+			m.data[0][c] = zero
+		}
+	}
+	if firstColHasZero {
+		for r := 0; r < m.rows; r++ {
+			m.data[r][0] = zero
+		}
+	}
+}
+
+// eightDirections are the offsets to every neighbor of a cell, including
+// diagonals.
+var eightDirections = [8][2]int{
+	{-1, -1}, {-1, 0}, {-1, 1},
+	{0, -1}, {0, 1},
+	{1, -1}, {1, 0}, {1, 1},
+}
+
+// ToroidalNeighbors returns the 8 neighboring coordinates of (r, c),
+// wrapping around every edge as if the matrix were a torus, so a cell in
+// the corner still has a full set of 8 neighbors instead of 3.
+func (m *Matrix[T]) ToroidalNeighbors(r, c int) [][2]int {
+	out := make([][2]int, 0, 8)
+	for _, d := range eightDirections {
+		nr := ((r+d[0])%m.rows + m.rows) % m.rows
+		nc := ((c+d[1])%m.cols + m.cols) % m.cols
+		out = append(out, [2]int{nr, nc})
+	}
+	return out
+}
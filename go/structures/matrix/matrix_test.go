@@ -0,0 +1,133 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func must[T any](m *Matrix[T], err error) *Matrix[T] {
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func rowsOf[T any](m *Matrix[T]) [][]T {
+	out := make([][]T, m.Rows())
+	for r := range out {
+		out[r] = append([]T{}, m.RowSlice(r)...)
+	}
+	return out
+}
+
+func TestFromRowsRejectsNonRectangular(t *testing.T) {
+	_, err := FromRows([][]int{{1, 2}, {3}})
+	if err == nil {
+		t.Errorf("FromRows should reject a jagged input")
+	}
+}
+
+func TestTransposeInPlace(t *testing.T) {
+	m := must(FromRows([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}))
+	m.TransposeInPlace()
+
+	want := [][]int{
+		{1, 4, 7},
+		{2, 5, 8},
+		{3, 6, 9},
+	}
+	if got := rowsOf(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("TransposeInPlace() = %v, want %v", got, want)
+	}
+}
+
+func TestTransposeInPlacePanicsOnNonSquare(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("TransposeInPlace on a non-square matrix should panic")
+		}
+	}()
+	must(FromRows([][]int{{1, 2, 3}, {4, 5, 6}})).TransposeInPlace()
+}
+
+func TestRotateClockwise(t *testing.T) {
+	m := must(FromRows([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}))
+	m.RotateClockwise()
+
+	want := [][]int{
+		{7, 4, 1},
+		{8, 5, 2},
+		{9, 6, 3},
+	}
+	if got := rowsOf(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("RotateClockwise() = %v, want %v", got, want)
+	}
+}
+
+func TestSpiralOrder(t *testing.T) {
+	m := must(FromRows([][]int{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}))
+	want := []int{1, 2, 3, 6, 9, 8, 7, 4, 5}
+	if got := m.SpiralOrder(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SpiralOrder() = %v, want %v", got, want)
+	}
+}
+
+func TestSpiralOrderNonSquare(t *testing.T) {
+	m := must(FromRows([][]int{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+	}))
+	want := []int{1, 2, 3, 4, 8, 7, 6, 5}
+	if got := m.SpiralOrder(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SpiralOrder() = %v, want %v", got, want)
+	}
+}
+
+func TestZeroRowsCols(t *testing.T) {
+	m := must(FromRows([][]int{
+		{1, 1, 1},
+		{1, 0, 1},
+		{1, 1, 1},
+	}))
+	m.ZeroRowsCols(func(v int) bool { return v == 0 })
+
+	want := [][]int{
+		{1, 0, 1},
+		{0, 0, 0},
+		{1, 0, 1},
+	}
+	if got := rowsOf(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("ZeroRowsCols() = %v, want %v", got, want)
+	}
+}
+
+func TestToroidalNeighborsWrapsAtCorner(t *testing.T) {
+	m := New[int](3, 3)
+	got := m.ToroidalNeighbors(0, 0)
+	if len(got) != 8 {
+		t.Fatalf("ToroidalNeighbors() returned %d neighbors, want 8", len(got))
+	}
+
+	want := map[[2]int]bool{
+		{2, 2}: true, {2, 0}: true, {2, 1}: true,
+		{0, 2}: true, {0, 1}: true,
+		{1, 2}: true, {1, 0}: true, {1, 1}: true,
+	}
+	for _, n := range got {
+		if !want[n] {
+			t.Errorf("unexpected neighbor %v of (0,0) on a 3x3 torus", n)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Package merkletree implements a binary Merkle tree over arbitrary leaf
+// byte slices, with support for generating and verifying inclusion proofs
+// without needing the whole tree in hand.
+package merkletree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// HashFunc hashes an arbitrary byte slice to a digest. The zero value of
+// MerkleTree uses sha256.Sum256 via Sum256Hash; callers may supply their own
+// for other digest sizes or algorithms.
+type HashFunc func(data []byte) []byte
+
+// Sum256Hash is the default HashFunc, wrapping crypto/sha256.
+func Sum256Hash(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// MerkleTree is a binary Merkle tree built over a fixed list of leaves.
+// Odd levels duplicate their last node so every level has an even width,
+// a common, simple convention for padding unbalanced trees.
+type MerkleTree struct {
+	hash   HashFunc
+	levels [][][]byte // levels[0] = leaf hashes, levels[len-1] = {root}
+}
+
+// New builds a MerkleTree over leaves using hash. It panics if leaves is
+// empty, since an empty tree has no meaningful root.
+func New(leaves [][]byte, hash HashFunc) *MerkleTree {
+	if len(leaves) == 0 {
+		panic("merkletree: New requires at least one leaf")
+	}
+	if hash == nil {
+		hash = Sum256Hash
+	}
+
+	level := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = hash(leaf)
+	}
+
+	levels := [][][]byte{level}
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = hash(append(append([]byte{}, level[2*i]...), level[2*i+1]...))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &MerkleTree{hash: hash, levels: levels}
+}
+
+// Root returns the tree's root hash.
+func (t *MerkleTree) Root() []byte {
+	top := t.levels[len(t.levels)-1]
+	return top[0]
+}
+
+// ProofStep is one sibling hash encountered while climbing from a leaf to
+// the root, along with which side of the current hash it sits on.
+type ProofStep struct {
+	Hash          []byte
+	SiblingOnLeft bool // true if Hash should be combined on the left of the running hash
+}
+
+// Prove returns the inclusion proof for the leaf at index: the sequence of
+// sibling hashes needed to recompute the root from that leaf's hash.
+func (t *MerkleTree) Prove(index int) ([]ProofStep, error) {
+	leafLevel := t.levels[0]
+	if index < 0 || index >= len(leafLevel) {
+		return nil, fmt.Errorf("merkletree: index %d out of range [0, %d)", index, len(leafLevel))
+	}
+
+	var proof []ProofStep
+	idx := index
+	for _, level := range t.levels[:len(t.levels)-1] {
+		siblingIdx := idx ^ 1
+		var sibling []byte
+		if siblingIdx < len(level) {
+			sibling = level[siblingIdx]
+		} else {
+			sibling = level[idx] // duplicated last node is its own sibling
+		}
+		proof = append(proof, ProofStep{Hash: sibling, SiblingOnLeft: idx%2 == 1})
+		idx /= 2
+	}
+	return proof, nil
+}
+
+// Verify reports whether leaf, combined with proof, reconstructs root under
+// hash. It does not require a MerkleTree instance, so verifiers only ever
+// need the root, not the whole tree.
+func Verify(hash HashFunc, root, leaf []byte, proof []ProofStep) bool {
+	if hash == nil {
+		hash = Sum256Hash
+	}
+	current := hash(leaf)
+	for _, step := range proof {
+		if step.SiblingOnLeft {
+			current = hash(append(append([]byte{}, step.Hash...), current...))
+		} else {
+			current = hash(append(append([]byte{}, current...), step.Hash...))
+		}
+	}
+	return bytes.Equal(current, root)
+}
@@ -0,0 +1,68 @@
+package merkletree
+
+import "testing"
+
+func leaves(words ...string) [][]byte {
+	out := make([][]byte, len(words))
+	for i, w := range words {
+		out[i] = []byte(w)
+	}
+	return out
+}
+
+func TestNewPanicsOnEmpty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New(nil leaves) should panic")
+		}
+	}()
+	New(nil, nil)
+}
+
+func TestRootStableAndSensitiveToOrder(t *testing.T) {
+	t1 := New(leaves("a", "b", "c", "d"), nil)
+	t2 := New(leaves("a", "b", "c", "d"), nil)
+	if string(t1.Root()) != string(t2.Root()) {
+		t.Errorf("two trees over the same leaves should have the same root")
+	}
+
+	t3 := New(leaves("d", "c", "b", "a"), nil)
+	if string(t1.Root()) == string(t3.Root()) {
+		t.Errorf("reordering leaves should change the root")
+	}
+}
+
+func TestProveAndVerify(t *testing.T) {
+	words := []string{"a", "b", "c", "d", "e"} // odd count exercises the duplicated-last-node padding
+	tree := New(leaves(words...), nil)
+
+	for i, w := range words {
+		proof, err := tree.Prove(i)
+		if err != nil {
+			t.Fatalf("Prove(%d): %v", i, err)
+		}
+		if !Verify(nil, tree.Root(), []byte(w), proof) {
+			t.Errorf("Verify failed for leaf %d (%q)", i, w)
+		}
+	}
+}
+
+func TestVerifyRejectsTamperedLeaf(t *testing.T) {
+	words := []string{"a", "b", "c", "d"}
+	tree := New(leaves(words...), nil)
+
+	proof, err := tree.Prove(1)
+	if err != nil {
+		t.Fatalf("Prove: %v", err)
+	}
+	if Verify(nil, tree.Root(), []byte("tampered"), proof) {
+		t.Errorf("Verify should reject a proof for the wrong leaf")
+	}
+}
+
+func TestProveOutOfRange(t *testing.T) {
+	tree := New(leaves("a", "b"), nil)
+	if _, err := tree.Prove(5); err == nil {
+		t.Errorf("Prove(5) should return an error for an out-of-range index")
+	}
+}
@@ -0,0 +1,37 @@
+package metaheuristics
+
+import (
+	"math"
+	"math/rand"
+)
+
+// SimulatedAnnealing runs maxIter iterations of simulated annealing
+// starting from initial, moving to each randomly generated neighbor
+// outright when it's no worse, and otherwise accepting it with
+// probability exp(-delta/temperature) — so early on, when schedule
+// reports a high temperature, the search can escape local minima by
+// accepting worsening moves, and it settles down into pure
+// hill-climbing as the temperature falls toward zero. It returns the
+// best state seen and its energy, which need not be the state the
+// search ends on.
+func SimulatedAnnealing[S any](problem Problem[S], initial S, schedule Schedule, maxIter int, rng *rand.Rand) (S, float64) {
+	current := initial
+	currentEnergy := problem.Energy(current)
+	best, bestEnergy := current, currentEnergy
+
+	for iter := 0; iter < maxIter; iter++ {
+		temp := schedule(iter, maxIter)
+		candidate := problem.Neighbor(current, rng)
+		candidateEnergy := problem.Energy(candidate)
+		delta := candidateEnergy - currentEnergy
+
+		if delta <= 0 || (temp > 0 && rng.Float64() < math.Exp(-delta/temp)) {
+			current, currentEnergy = candidate, candidateEnergy
+			if currentEnergy < bestEnergy {
+				best, bestEnergy = current, currentEnergy
+			}
+		}
+	}
+
+	return best, bestEnergy
+}
@@ -0,0 +1,44 @@
+package metaheuristics
+
+import "math/rand"
+
+// HillClimbing runs stochastic hill-climbing from initial: at each of
+// up to maxIter steps it generates one random neighbor and moves to it
+// only if its energy is no worse, returning early once stall
+// consecutive neighbors in a row fail to improve on the current state
+// (a local optimum, for this neighborhood). It returns the best state
+// found and its energy.
+func HillClimbing[S any](problem Problem[S], initial S, maxIter, stall int, rng *rand.Rand) (S, float64) {
+	current := initial
+	currentEnergy := problem.Energy(current)
+	sinceImprovement := 0
+
+	for iter := 0; iter < maxIter && sinceImprovement < stall; iter++ {
+		candidate := problem.Neighbor(current, rng)
+		candidateEnergy := problem.Energy(candidate)
+		if candidateEnergy < currentEnergy {
+			current, currentEnergy = candidate, candidateEnergy
+			sinceImprovement = 0
+		} else {
+			sinceImprovement++
+		}
+	}
+
+	return current, currentEnergy
+}
+
+// HillClimbingRestarts runs HillClimbing restarts times, each starting
+// from a fresh state produced by newState, and returns the best state
+// and energy found across all of them — a cheap way to escape
+// HillClimbing's susceptibility to local optima without the tuning
+// simulated annealing's schedule requires.
+func HillClimbingRestarts[S any](problem Problem[S], newState func(rng *rand.Rand) S, restarts, maxIterPerRun, stall int, rng *rand.Rand) (S, float64) {
+	best, bestEnergy := HillClimbing(problem, newState(rng), maxIterPerRun, stall, rng)
+	for i := 1; i < restarts; i++ {
+		state, energy := HillClimbing(problem, newState(rng), maxIterPerRun, stall, rng)
+		if energy < bestEnergy {
+			best, bestEnergy = state, energy
+		}
+	}
+	return best, bestEnergy
+}
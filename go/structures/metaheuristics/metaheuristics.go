@@ -0,0 +1,52 @@
+// Package metaheuristics implements two general-purpose local-search
+// optimizers — simulated annealing and hill-climbing (with random
+// restarts) — over a state space too large to search exactly. Both take
+// a Problem describing how to measure a state's energy (lower is
+// better) and how to generate a random neighboring state, so the same
+// two search strategies apply to any problem that fits that shape; see
+// the package's tests for worked examples over TSP tours and N-Queens
+// boards.
+package metaheuristics
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Problem bundles the two things a state space must supply for either
+// search strategy in this package to explore it: Energy scores a state
+// (lower is better; the searches minimize this), and Neighbor produces
+// a random nearby state to consider moving to.
+type Problem[S any] struct {
+	Energy   func(state S) float64
+	Neighbor func(state S, rng *rand.Rand) S
+}
+
+// Schedule returns the annealing temperature to use at the given
+// iteration out of maxIter total, and should decrease as iteration
+// approaches maxIter so the search accepts fewer worsening moves over
+// time.
+type Schedule func(iteration, maxIter int) float64
+
+// ExponentialSchedule returns a Schedule that starts at initial and
+// multiplies by coolingRate (expected in (0, 1)) every iteration.
+func ExponentialSchedule(initial, coolingRate float64) Schedule {
+	return func(iteration, maxIter int) float64 {
+		return initial * math.Pow(coolingRate, float64(iteration))
+	}
+}
+
+// LinearSchedule returns a Schedule that falls linearly from initial at
+// iteration 0 to (approximately) 0 at iteration maxIter.
+func LinearSchedule(initial float64) Schedule {
+	return func(iteration, maxIter int) float64 {
+		if maxIter <= 0 {
+			return initial
+		}
+		frac := 1 - float64(iteration)/float64(maxIter)
+		if frac < 0 {
+			frac = 0
+		}
+		return initial * frac
+	}
+}
@@ -0,0 +1,150 @@
+package metaheuristics
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// --- TSP: state is a permutation of city indices, energy is tour length.
+
+func tourLength(dist [][]float64, tour []int) float64 {
+	total := 0.0
+	for i := range tour {
+		j := (i + 1) % len(tour)
+		total += dist[tour[i]][tour[j]]
+	}
+	return total
+}
+
+func swapNeighbor(tour []int, rng *rand.Rand) []int {
+	next := append([]int{}, tour...)
+	i, j := rng.Intn(len(next)), rng.Intn(len(next))
+	next[i], next[j] = next[j], next[i]
+	return next
+}
+
+func squareDist(points [][2]float64) [][]float64 {
+	n := len(points)
+	dist := make([][]float64, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		for j := range dist[i] {
+			dx, dy := points[i][0]-points[j][0], points[i][1]-points[j][1]
+			dist[i][j] = math.Sqrt(dx*dx + dy*dy)
+		}
+	}
+	return dist
+}
+
+func TestSimulatedAnnealingTSP(t *testing.T) {
+	// A small square plus a center point: the optimal tour visits the
+	// four corners in order and is easy to check against.
+	points := [][2]float64{{0, 0}, {0, 1}, {1, 1}, {1, 0}}
+	dist := squareDist(points)
+
+	problem := Problem[[]int]{
+		Energy:   func(tour []int) float64 { return tourLength(dist, tour) },
+		Neighbor: swapNeighbor,
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	initial := []int{0, 2, 1, 3} // a deliberately bad starting tour
+	schedule := ExponentialSchedule(10, 0.995)
+	best, bestEnergy := SimulatedAnnealing(problem, initial, schedule, 2000, rng)
+
+	if len(best) != 4 {
+		t.Fatalf("SimulatedAnnealing returned a tour of length %d, want 4", len(best))
+	}
+	if bestEnergy > 4.0+1e-9 { // optimal square tour has length exactly 4
+		t.Errorf("SimulatedAnnealing found tour energy %v, optimal is 4", bestEnergy)
+	}
+}
+
+func TestHillClimbingRestartsTSP(t *testing.T) {
+	points := [][2]float64{{0, 0}, {0, 1}, {1, 1}, {1, 0}}
+	dist := squareDist(points)
+
+	problem := Problem[[]int]{
+		Energy:   func(tour []int) float64 { return tourLength(dist, tour) },
+		Neighbor: swapNeighbor,
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	newState := func(rng *rand.Rand) []int {
+		perm := rng.Perm(4)
+		return perm
+	}
+	_, bestEnergy := HillClimbingRestarts(problem, newState, 20, 100, 10, rng)
+	if bestEnergy > 4.0+1e-9 {
+		t.Errorf("HillClimbingRestarts found tour energy %v, optimal is 4", bestEnergy)
+	}
+}
+
+// --- N-Queens: state is a board where board[col] = row of the queen in that column.
+
+func queensConflicts(board []int) float64 {
+	conflicts := 0
+	n := len(board)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if board[i] == board[j] || abs(board[i]-board[j]) == abs(i-j) {
+				conflicts++
+			}
+		}
+	}
+	return float64(conflicts)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func queensNeighbor(board []int, rng *rand.Rand) []int {
+	next := append([]int{}, board...)
+	col := rng.Intn(len(next))
+	next[col] = rng.Intn(len(next))
+	return next
+}
+
+func TestSimulatedAnnealingNQueens(t *testing.T) {
+	const n = 8
+	problem := Problem[[]int]{
+		Energy:   queensConflicts,
+		Neighbor: queensNeighbor,
+	}
+
+	rng := rand.New(rand.NewSource(42))
+	initial := make([]int, n)
+	for i := range initial {
+		initial[i] = i // all queens on the diagonal: maximally conflicted
+	}
+
+	schedule := ExponentialSchedule(5, 0.99)
+	_, bestEnergy := SimulatedAnnealing(problem, initial, schedule, 5000, rng)
+	if bestEnergy != 0 {
+		t.Errorf("SimulatedAnnealing N-Queens: %v conflicts remain, want a solved board (0)", bestEnergy)
+	}
+}
+
+func TestHillClimbingLocalOptimum(t *testing.T) {
+	// Energy that's strictly decreasing in x up to a point, so plain
+	// hill-climbing from a fixed start should reach the bottom and stop.
+	problem := Problem[int]{
+		Energy: func(x int) float64 { return math.Abs(float64(x - 5)) },
+		Neighbor: func(x int, rng *rand.Rand) int {
+			if rng.Intn(2) == 0 {
+				return x + 1
+			}
+			return x - 1
+		},
+	}
+	rng := rand.New(rand.NewSource(1))
+	_, energy := HillClimbing(problem, 0, 1000, 20, rng)
+	if energy != 0 {
+		t.Errorf("HillClimbing energy = %v, want 0 (x = 5)", energy)
+	}
+}
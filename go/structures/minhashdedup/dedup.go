@@ -0,0 +1,58 @@
+package minhashdedup
+
+// Config configures a Dedup run. NumHashes controls the MinHash
+// signature length; Bands and RowsPerBand split that signature into
+// LSH bands (Bands*RowsPerBand should not exceed NumHashes); Threshold
+// is the minimum exact Jaccard similarity a candidate pair must reach
+// to be reported as a duplicate.
+type Config struct {
+	ShingleSize int
+	NumHashes   int
+	Bands       int
+	RowsPerBand int
+	Threshold   float64
+}
+
+// DefaultConfig returns reasonable settings for short-to-medium text
+// documents: 3-word shingles, a 64-value signature split into 16 bands
+// of 4 rows each, and a 0.5 similarity threshold.
+func DefaultConfig() Config {
+	return Config{
+		ShingleSize: 3,
+		NumHashes:   64,
+		Bands:       16,
+		RowsPerBand: 4,
+		Threshold:   0.5,
+	}
+}
+
+// Pair is a confirmed near-duplicate pair of documents by index into
+// the slice passed to Dedup, along with their exact Jaccard similarity.
+type Pair struct {
+	A, B    int
+	Jaccard float64
+}
+
+// Dedup runs the full pipeline over docs: tokenize, shingle, MinHash,
+// LSH bucket into candidates, and verify each candidate with exact
+// Jaccard similarity over the original shingle sets. It returns every
+// pair whose exact similarity meets cfg.Threshold, in no particular
+// order.
+func Dedup(docs []string, cfg Config) []Pair {
+	sets := make([]ShingleSet, len(docs))
+	sigs := make([]Signature, len(docs))
+	for i, doc := range docs {
+		shingles := Shingle(Tokenize(doc), cfg.ShingleSize)
+		sets[i] = NewShingleSet(shingles)
+		sigs[i] = NewSignature(shingles, cfg.NumHashes)
+	}
+
+	var results []Pair
+	for _, p := range CandidatePairs(sigs, cfg.Bands, cfg.RowsPerBand) {
+		similarity := ExactJaccard(sets[p[0]], sets[p[1]])
+		if similarity >= cfg.Threshold {
+			results = append(results, Pair{A: p[0], B: p[1], Jaccard: similarity})
+		}
+	}
+	return results
+}
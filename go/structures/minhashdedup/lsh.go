@@ -0,0 +1,67 @@
+package minhashdedup
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// CandidatePairs groups signature indices into LSH buckets and returns
+// every pair of indices that landed in the same bucket at least once —
+// the set of pairs worth checking with an exact Jaccard comparison,
+// rather than comparing every document against every other one.
+//
+// Each signature is split into bands of rowsPerBand consecutive values;
+// two signatures land in the same bucket for a band if that band's
+// values are identical, which (for a similarity threshold tuned by
+// bands and rowsPerBand together) happens with high probability exactly
+// when the documents are actually similar.
+func CandidatePairs(sigs []Signature, bands, rowsPerBand int) [][2]int {
+	type key struct {
+		band   int
+		bucket uint64
+	}
+	buckets := make(map[key][]int)
+
+	for idx, sig := range sigs {
+		for b := 0; b < bands; b++ {
+			start := b * rowsPerBand
+			end := start + rowsPerBand
+			if start >= len(sig) {
+				break
+			}
+			if end > len(sig) {
+				end = len(sig)
+			}
+			k := key{band: b, bucket: hashBand(sig[start:end])}
+			buckets[k] = append(buckets[k], idx)
+		}
+	}
+
+	seen := make(map[[2]int]bool)
+	var pairs [][2]int
+	for _, indices := range buckets {
+		for i := 0; i < len(indices); i++ {
+			for j := i + 1; j < len(indices); j++ {
+				p := [2]int{indices[i], indices[j]}
+				if p[0] > p[1] {
+					p[0], p[1] = p[1], p[0]
+				}
+				if !seen[p] {
+					seen[p] = true
+					pairs = append(pairs, p)
+				}
+			}
+		}
+	}
+	return pairs
+}
+
+func hashBand(band []uint64) uint64 {
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, v := range band {
+		binary.LittleEndian.PutUint64(buf, v)
+		h.Write(buf)
+	}
+	return h.Sum64()
+}
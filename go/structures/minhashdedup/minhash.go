@@ -0,0 +1,83 @@
+package minhashdedup
+
+import "hash/fnv"
+
+// Signature is a MinHash signature: one minimum hash value per hash
+// function, sampled over a document's shingle set. Two documents whose
+// signatures agree in a large fraction of positions are, with high
+// probability, similarly large fractions of their shingle sets in
+// common (their true Jaccard similarity).
+type Signature []uint64
+
+// hashFuncs returns numHashes independent-enough 64-bit hash functions
+// built from a single base hash by the standard "double hashing" trick:
+// h_i(x) = base(x) * a_i + b_i, with odd multipliers so every a_i is
+// coprime to 2^64 and the resulting values spread out over the full
+// range. The multipliers and offsets are fixed and deterministic so the
+// same shingle always hashes to the same value across runs.
+func hashFuncs(numHashes int) []func(s string) uint64 {
+	funcs := make([]func(s string) uint64, numHashes)
+	for i := 0; i < numHashes; i++ {
+		a := uint64(i)*2654435761 + 1 // odd: a Knuth multiplicative-hash constant, offset to stay odd
+		b := uint64(i) * 40503
+		funcs[i] = func(s string) uint64 {
+			h := fnv.New64a()
+			h.Write([]byte(s))
+			return h.Sum64()*a + b
+		}
+	}
+	return funcs
+}
+
+// NewSignature builds a MinHash signature of numHashes values from
+// shingles.
+func NewSignature(shingles []string, numHashes int) Signature {
+	sig := make(Signature, numHashes)
+	for i := range sig {
+		sig[i] = ^uint64(0) // max uint64, so any real hash value is smaller
+	}
+	funcs := hashFuncs(numHashes)
+	for _, shingle := range shingles {
+		for i, fn := range funcs {
+			if v := fn(shingle); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// EstimateJaccard estimates the Jaccard similarity of the two
+// documents a and b were signed from, as the fraction of signature
+// positions where they agree.
+func EstimateJaccard(a, b Signature) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	matches := 0
+	for i := range a {
+		if a[i] == b[i] {
+			matches++
+		}
+	}
+	return float64(matches) / float64(len(a))
+}
+
+// ExactJaccard returns the true Jaccard similarity |a∩b| / |a∪b| of two
+// shingle sets, used to confirm LSH candidate pairs exactly.
+func ExactJaccard(a, b ShingleSet) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	intersection := 0
+	for s := range a {
+		if _, ok := b[s]; ok {
+			intersection++
+		}
+	}
+	union := len(a) + len(b) - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
@@ -0,0 +1,125 @@
+package minhashdedup
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTokenizeAndShingle(t *testing.T) {
+	tokens := Tokenize("The quick, brown fox!")
+	want := []string{"the", "quick", "brown", "fox"}
+	if len(tokens) != len(want) {
+		t.Fatalf("Tokenize() = %v, want %v", tokens, want)
+	}
+	for i, w := range want {
+		if tokens[i] != w {
+			t.Errorf("Tokenize()[%d] = %q, want %q", i, tokens[i], w)
+		}
+	}
+
+	shingles := Shingle(tokens, 2)
+	wantShingles := []string{"the quick", "quick brown", "brown fox"}
+	if len(shingles) != len(wantShingles) {
+		t.Fatalf("Shingle() = %v, want %v", shingles, wantShingles)
+	}
+	for i, w := range wantShingles {
+		if shingles[i] != w {
+			t.Errorf("Shingle()[%d] = %q, want %q", i, shingles[i], w)
+		}
+	}
+}
+
+func TestShingleShorterThanK(t *testing.T) {
+	got := Shingle([]string{"a", "b"}, 5)
+	if len(got) != 1 || got[0] != "a b" {
+		t.Errorf("Shingle() = %v, want [\"a b\"]", got)
+	}
+}
+
+func TestExactJaccard(t *testing.T) {
+	a := NewShingleSet([]string{"x", "y", "z"})
+	b := NewShingleSet([]string{"y", "z", "w"})
+	// intersection {y,z} = 2, union {x,y,z,w} = 4
+	if got := ExactJaccard(a, b); got != 0.5 {
+		t.Errorf("ExactJaccard() = %v, want 0.5", got)
+	}
+	if got := ExactJaccard(a, a); got != 1 {
+		t.Errorf("ExactJaccard(a, a) = %v, want 1", got)
+	}
+}
+
+func TestMinHashEstimateTracksExactJaccard(t *testing.T) {
+	a := Shingle(Tokenize("the quick brown fox jumps over the lazy dog"), 3)
+	b := Shingle(Tokenize("the quick brown fox leaps over the lazy dog"), 3)
+	c := Shingle(Tokenize("completely unrelated text about something else entirely"), 3)
+
+	sigA := NewSignature(a, 128)
+	sigB := NewSignature(b, 128)
+	sigC := NewSignature(c, 128)
+
+	estAB := EstimateJaccard(sigA, sigB)
+	estAC := EstimateJaccard(sigA, sigC)
+
+	exactAB := ExactJaccard(NewShingleSet(a), NewShingleSet(b))
+	exactAC := ExactJaccard(NewShingleSet(a), NewShingleSet(c))
+
+	if math.Abs(estAB-exactAB) > 0.25 {
+		t.Errorf("EstimateJaccard(a, b) = %v, too far from exact %v", estAB, exactAB)
+	}
+	if math.Abs(estAC-exactAC) > 0.25 {
+		t.Errorf("EstimateJaccard(a, c) = %v, too far from exact %v", estAC, exactAC)
+	}
+	if estAB <= estAC {
+		t.Errorf("EstimateJaccard(a, b) = %v should be greater than EstimateJaccard(a, c) = %v", estAB, estAC)
+	}
+}
+
+func TestCandidatePairsFindsIdenticalSignatures(t *testing.T) {
+	sig := Signature{1, 2, 3, 4}
+	sigs := []Signature{sig, append(Signature{}, sig...), {9, 9, 9, 9}}
+
+	pairs := CandidatePairs(sigs, 2, 2)
+	found := false
+	for _, p := range pairs {
+		if p == [2]int{0, 1} {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("CandidatePairs() = %v, want to include (0, 1)", pairs)
+	}
+}
+
+func TestDedupFindsNearDuplicates(t *testing.T) {
+	docs := []string{
+		"the quick brown fox jumps over the lazy dog",
+		"the quick brown fox leaps over the lazy dog",
+		"completely unrelated text about something else entirely",
+	}
+
+	cfg := DefaultConfig()
+	cfg.NumHashes = 128
+	cfg.Bands = 64
+	cfg.RowsPerBand = 2
+	cfg.Threshold = 0.35
+
+	pairs := Dedup(docs, cfg)
+	found := false
+	for _, p := range pairs {
+		if (p.A == 0 && p.B == 1) || (p.A == 1 && p.B == 0) {
+			found = true
+		}
+		if p.A == 2 || p.B == 2 {
+			t.Errorf("Dedup() reported unrelated doc 2 as a near-duplicate: %+v", p)
+		}
+	}
+	if !found {
+		t.Errorf("Dedup() did not find the near-duplicate pair (0, 1); pairs = %v", pairs)
+	}
+}
+
+func TestDedupEmpty(t *testing.T) {
+	if got := Dedup(nil, DefaultConfig()); len(got) != 0 {
+		t.Errorf("Dedup(nil) = %v, want empty", got)
+	}
+}
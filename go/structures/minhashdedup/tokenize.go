@@ -0,0 +1,54 @@
+// Package minhashdedup is an end-to-end near-duplicate document
+// detection pipeline: tokenize each document into word shingles, hash
+// each document's shingle set down to a small MinHash signature,
+// bucket signatures with LSH so only documents that are likely similar
+// are ever compared directly, and confirm each candidate pair with an
+// exact Jaccard similarity over the original shingle sets. It exists as
+// an integration example wiring hashing, sampling (the MinHash
+// signature is a random sample of shingle hashes), and string
+// processing together, not as a single new algorithm.
+package minhashdedup
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenize lowercases doc and splits it into words, dropping
+// punctuation.
+func Tokenize(doc string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(doc), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return fields
+}
+
+// Shingle groups tokens into overlapping windows of k consecutive
+// tokens, joined by a space, which MinHash signatures are built from
+// instead of raw single words — two documents that share phrasing but
+// not exact word order produce fewer matching shingles, which is what
+// makes the similarity estimate meaningful. If tokens has fewer than k
+// elements, the whole of tokens is returned as a single shingle.
+func Shingle(tokens []string, k int) []string {
+	if k <= 1 || len(tokens) <= k {
+		return []string{strings.Join(tokens, " ")}
+	}
+	shingles := make([]string, 0, len(tokens)-k+1)
+	for i := 0; i+k <= len(tokens); i++ {
+		shingles = append(shingles, strings.Join(tokens[i:i+k], " "))
+	}
+	return shingles
+}
+
+// ShingleSet is the distinct set of shingles a document produced, the
+// representation ExactJaccard compares directly.
+type ShingleSet map[string]struct{}
+
+// NewShingleSet builds a ShingleSet from shingles, deduplicating them.
+func NewShingleSet(shingles []string) ShingleSet {
+	set := make(ShingleSet, len(shingles))
+	for _, s := range shingles {
+		set[s] = struct{}{}
+	}
+	return set
+}
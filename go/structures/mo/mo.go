@@ -0,0 +1,100 @@
+// Package mo implements Mo's algorithm: a framework for answering many
+// offline range queries over a static array efficiently by processing them
+// in an order that keeps the [L, R) window moving as little as possible,
+// rather than recomputing each query from scratch.
+package mo
+
+import "sort"
+
+// Query is one offline range query over [L, R) (R exclusive), tagged with
+// Index so results can be reported back in the caller's original order
+// after Mo's algorithm reorders them for processing.
+type Query struct {
+	L, R  int
+	Index int
+}
+
+// Driver processes a batch of queries against an implicit window [L, R)
+// using Add/Remove to move the window one element at a time and Answer to
+// record the window's current answer for a query. Callers provide these as
+// closures over whatever state their specific query needs (a frequency
+// map for "distinct values in range", a running sum for "sum in range",
+// etc); this package only owns the query ordering and window movement.
+type Driver struct {
+	Add    func(index int)
+	Remove func(index int)
+	Answer func(q Query) any
+}
+
+// Run answers every query in queries and returns their answers in the same
+// order queries was given (not the internal processing order), using the
+// classic odd-even block sort: queries are bucketed into blocks of size
+// blockSize by L, sorted by block and then by R — with R ascending on
+// even-indexed blocks and descending on odd-indexed ones — so the R
+// pointer sweeps back and forth across neighboring blocks instead of
+// resetting, which is what gives Mo's algorithm its O((n+q)*sqrt(n)) total
+// window movement instead of O(n*q).
+//
+// If blockSize <= 0, it defaults to ceil(sqrt(n)), the standard choice that
+// balances the number of blocks against their size.
+func (d *Driver) Run(n int, queries []Query, blockSize int) []any {
+	if blockSize <= 0 {
+		blockSize = isqrtCeil(n)
+		if blockSize < 1 {
+			blockSize = 1
+		}
+	}
+
+	order := make([]int, len(queries))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		qi, qj := queries[order[i]], queries[order[j]]
+		bi, bj := qi.L/blockSize, qj.L/blockSize
+		if bi != bj {
+			return bi < bj
+		}
+		if bi%2 == 0 {
+			return qi.R < qj.R
+		}
+		return qi.R > qj.R
+	})
+
+	answers := make([]any, len(queries))
+	curL, curR := 0, 0 // window is currently [curL, curR)
+	for _, qi := range order {
+		q := queries[qi]
+		for curR < q.R {
+			d.Add(curR)
+			curR++
+		}
+		for curL > q.L {
+			curL--
+			d.Add(curL)
+		}
+		for curR > q.R {
+			curR--
+			d.Remove(curR)
+		}
+		for curL < q.L {
+			d.Remove(curL)
+			curL++
+		}
+		answers[q.Index] = d.Answer(q)
+	}
+	return answers
+}
+
+// isqrtCeil returns ceil(sqrt(n)) for n >= 0, using only integer
+// arithmetic.
+func isqrtCeil(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	r := 0
+	for r*r < n {
+		r++
+	}
+	return r
+}
@@ -0,0 +1,98 @@
+package mo
+
+import (
+	"reflect"
+	"testing"
+)
+
+// distinctInRange answers "how many distinct values are in arr[L:R]" for
+// each query, the textbook example for Mo's algorithm: Add/Remove maintain
+// a running frequency count, and Answer just reads the count of nonzero
+// entries.
+func distinctInRange(arr []int, queries []Query) []int {
+	freq := map[int]int{}
+	distinct := 0
+
+	d := &Driver{
+		Add: func(i int) {
+			if freq[arr[i]] == 0 {
+				distinct++
+			}
+			freq[arr[i]]++
+		},
+		Remove: func(i int) {
+			freq[arr[i]]--
+			if freq[arr[i]] == 0 {
+				distinct--
+			}
+		},
+		Answer: func(q Query) any {
+			return distinct
+		},
+	}
+
+	raw := d.Run(len(arr), queries, 0)
+	out := make([]int, len(raw))
+	for i, v := range raw {
+		out[i] = v.(int)
+	}
+	return out
+}
+
+func bruteDistinct(arr []int, l, r int) int {
+	seen := map[int]bool{}
+	for i := l; i < r; i++ {
+		seen[arr[i]] = true
+	}
+	return len(seen)
+}
+
+func TestDistinctInRange(t *testing.T) {
+	arr := []int{1, 2, 1, 3, 2, 4, 1, 5, 5, 3}
+	ranges := [][2]int{{0, 10}, {0, 1}, {2, 5}, {1, 8}, {5, 9}, {0, 4}}
+
+	queries := make([]Query, len(ranges))
+	want := make([]int, len(ranges))
+	for i, rg := range ranges {
+		queries[i] = Query{L: rg[0], R: rg[1], Index: i}
+		want[i] = bruteDistinct(arr, rg[0], rg[1])
+	}
+
+	got := distinctInRange(arr, queries)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("distinctInRange() = %v, want %v", got, want)
+	}
+}
+
+func TestDistinctInRangeRespectsExplicitBlockSize(t *testing.T) {
+	arr := []int{5, 5, 5, 1, 1, 2, 2, 2, 2, 3}
+	queries := []Query{
+		{L: 0, R: 3, Index: 0},
+		{L: 3, R: 9, Index: 1},
+		{L: 0, R: 10, Index: 2},
+	}
+
+	freq := map[int]int{}
+	distinct := 0
+	d := &Driver{
+		Add: func(i int) {
+			if freq[arr[i]] == 0 {
+				distinct++
+			}
+			freq[arr[i]]++
+		},
+		Remove: func(i int) {
+			freq[arr[i]]--
+			if freq[arr[i]] == 0 {
+				distinct--
+			}
+		},
+		Answer: func(q Query) any { return distinct },
+	}
+
+	got := d.Run(len(arr), queries, 2) // force a small, non-default block size
+	want := []any{1, 2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() with explicit blockSize = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,207 @@
+// Package multiset implements an ordered multiset over cmp.Ordered
+// values, backed by an AVL tree so Insert, Remove, Min, and Max all run
+// in O(log n) even as duplicates accumulate — the building block the
+// skyline sweep-line algorithm uses to track the tallest of the
+// currently-open buildings.
+package multiset
+
+import "cmp"
+
+type node[T cmp.Ordered] struct {
+	value  T
+	count  int
+	height int
+	left   *node[T]
+	right  *node[T]
+}
+
+// Multiset is an ordered multiset of T, allowing repeated values.
+type Multiset[T cmp.Ordered] struct {
+	root *node[T]
+	size int
+}
+
+// New creates an empty Multiset.
+func New[T cmp.Ordered]() *Multiset[T] {
+	return &Multiset[T]{}
+}
+
+// Len returns the total number of elements in the multiset, counting
+// duplicates.
+func (m *Multiset[T]) Len() int {
+	return m.size
+}
+
+// Insert adds value to the multiset.
+func (m *Multiset[T]) Insert(value T) {
+	m.root = insert(m.root, value)
+	m.size++
+}
+
+// Remove removes one occurrence of value from the multiset, if present,
+// reporting whether it found one to remove.
+func (m *Multiset[T]) Remove(value T) bool {
+	if !contains(m.root, value) {
+		return false
+	}
+	m.root = remove(m.root, value)
+	m.size--
+	return true
+}
+
+// Count returns the number of occurrences of value in the multiset.
+func (m *Multiset[T]) Count(value T) int {
+	n := m.root
+	for n != nil {
+		switch {
+		case value < n.value:
+			n = n.left
+		case value > n.value:
+			n = n.right
+		default:
+			return n.count
+		}
+	}
+	return 0
+}
+
+// Max returns the greatest element in the multiset. It reports false if
+// the multiset is empty.
+func (m *Multiset[T]) Max() (T, bool) {
+	if m.root == nil {
+		var zero T
+		return zero, false
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, true
+}
+
+// Min returns the smallest element in the multiset. It reports false if
+// the multiset is empty.
+func (m *Multiset[T]) Min() (T, bool) {
+	if m.root == nil {
+		var zero T
+		return zero, false
+	}
+	n := m.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value, true
+}
+
+func contains[T cmp.Ordered](n *node[T], value T) bool {
+	for n != nil {
+		switch {
+		case value < n.value:
+			n = n.left
+		case value > n.value:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+func nodeHeight[T cmp.Ordered](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func balanceFactor[T cmp.Ordered](n *node[T]) int {
+	return nodeHeight(n.left) - nodeHeight(n.right)
+}
+
+func updateHeight[T cmp.Ordered](n *node[T]) {
+	n.height = 1 + max(nodeHeight(n.left), nodeHeight(n.right))
+}
+
+func rotateRight[T cmp.Ordered](n *node[T]) *node[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	updateHeight(n)
+	updateHeight(l)
+	return l
+}
+
+func rotateLeft[T cmp.Ordered](n *node[T]) *node[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	updateHeight(n)
+	updateHeight(r)
+	return r
+}
+
+func rebalance[T cmp.Ordered](n *node[T]) *node[T] {
+	updateHeight(n)
+	switch bf := balanceFactor(n); {
+	case bf > 1:
+		if balanceFactor(n.left) < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if balanceFactor(n.right) > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+func insert[T cmp.Ordered](n *node[T], value T) *node[T] {
+	if n == nil {
+		return &node[T]{value: value, count: 1, height: 1}
+	}
+	switch {
+	case value < n.value:
+		n.left = insert(n.left, value)
+	case value > n.value:
+		n.right = insert(n.right, value)
+	default:
+		n.count++
+		return n
+	}
+	return rebalance(n)
+}
+
+func remove[T cmp.Ordered](n *node[T], value T) *node[T] {
+	if n == nil {
+		return nil
+	}
+	switch {
+	case value < n.value:
+		n.left = remove(n.left, value)
+	case value > n.value:
+		n.right = remove(n.right, value)
+	case n.count > 1:
+		n.count--
+		return n
+	default:
+		switch {
+		case n.left == nil:
+			return n.right
+		case n.right == nil:
+			return n.left
+		default:
+			successor := n.right
+			for successor.left != nil {
+				successor = successor.left
+			}
+			n.value = successor.value
+			n.count = successor.count
+			successor.count = 1
+			n.right = remove(n.right, successor.value)
+		}
+	}
+	return rebalance(n)
+}
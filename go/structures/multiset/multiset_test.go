@@ -0,0 +1,82 @@
+package multiset
+
+import "testing"
+
+func TestMultisetInsertAndCount(t *testing.T) {
+	m := New[int]()
+	m.Insert(5)
+	m.Insert(5)
+	m.Insert(3)
+
+	if got := m.Count(5); got != 2 {
+		t.Errorf("Count(5) = %d, want 2", got)
+	}
+	if got := m.Count(3); got != 1 {
+		t.Errorf("Count(3) = %d, want 1", got)
+	}
+	if got := m.Count(99); got != 0 {
+		t.Errorf("Count(99) = %d, want 0", got)
+	}
+	if got := m.Len(); got != 3 {
+		t.Errorf("Len() = %d, want 3", got)
+	}
+}
+
+func TestMultisetMinMax(t *testing.T) {
+	m := New[int]()
+	if _, ok := m.Max(); ok {
+		t.Errorf("Max() on empty multiset reported a value")
+	}
+
+	for _, v := range []int{5, 1, 9, 3, 9} {
+		m.Insert(v)
+	}
+	if got, ok := m.Max(); !ok || got != 9 {
+		t.Errorf("Max() = (%d, %v), want (9, true)", got, ok)
+	}
+	if got, ok := m.Min(); !ok || got != 1 {
+		t.Errorf("Min() = (%d, %v), want (1, true)", got, ok)
+	}
+}
+
+func TestMultisetRemove(t *testing.T) {
+	m := New[int]()
+	m.Insert(9)
+	m.Insert(9)
+	m.Insert(5)
+
+	if !m.Remove(9) {
+		t.Fatalf("Remove(9) = false, want true")
+	}
+	if got := m.Count(9); got != 1 {
+		t.Errorf("Count(9) after one Remove = %d, want 1", got)
+	}
+
+	if !m.Remove(9) {
+		t.Fatalf("Remove(9) (second) = false, want true")
+	}
+	if got, ok := m.Max(); !ok || got != 5 {
+		t.Errorf("Max() after removing all 9s = (%d, %v), want (5, true)", got, ok)
+	}
+
+	if m.Remove(42) {
+		t.Errorf("Remove(42) = true, want false for a value never inserted")
+	}
+}
+
+func TestMultisetStaysBalancedUnderSortedInsertion(t *testing.T) {
+	m := New[int]()
+	const n = 1000
+	for i := 0; i < n; i++ {
+		m.Insert(i)
+	}
+	if got, ok := m.Max(); !ok || got != n-1 {
+		t.Errorf("Max() = (%d, %v), want (%d, true)", got, ok, n-1)
+	}
+	if got, ok := m.Min(); !ok || got != 0 {
+		t.Errorf("Min() = (%d, %v), want (0, true)", got, ok)
+	}
+	if m.root.height > 20 {
+		t.Errorf("tree height = %d after %d sorted inserts, want a balanced tree (<=20)", m.root.height, n)
+	}
+}
@@ -0,0 +1,212 @@
+// Package ordermaintenance implements the classic order-maintenance list
+// labeling problem: keep a sequence of elements in a known relative
+// order, support inserting a new element right after an existing one,
+// and answer "does x come before y?" queries, all without renumbering
+// the whole sequence on every insert.
+//
+// Each element gets an integer label drawn from a sparse space
+// (uint64), chosen so that labels increase along the list. Order
+// queries are then a single label comparison. Insertion picks the
+// midpoint label between two neighbors; when neighbors are label-adjacent
+// (no room for a midpoint) a local relabeling pass widens an
+// exponentially growing window around the insertion point until it has
+// enough slack to re-space evenly, the standard Dietz-Sleator technique
+// that makes InsertAfter and Order run in O(1) amortized time.
+package ordermaintenance
+
+import "fmt"
+
+// maxLabel is the top of the label space; labels live in [0, maxLabel].
+const maxLabel = ^uint64(0)
+
+// Node is a handle to an element tracked by a List. It is returned by
+// Insert/InsertAfter and passed back in to InsertAfter/Order/Remove.
+type Node[T any] struct {
+	Value T
+
+	label      uint64
+	prev, next *Node[T]
+}
+
+// List is an order-maintenance structure: a doubly linked list whose
+// nodes carry monotonically increasing labels.
+type List[T any] struct {
+	head, tail *Node[T]
+	size       int
+}
+
+// New creates an empty order-maintenance list.
+func New[T any]() *List[T] {
+	return &List[T]{}
+}
+
+// Len returns the number of elements currently tracked.
+func (l *List[T]) Len() int {
+	return l.size
+}
+
+// Insert adds value as the very first element of an empty list. It
+// panics if the list is not empty; use InsertAfter to grow a non-empty
+// list.
+func (l *List[T]) Insert(value T) *Node[T] {
+	if l.size != 0 {
+		panic("ordermaintenance: Insert requires an empty list, use InsertAfter")
+	}
+	n := &Node[T]{Value: value, label: maxLabel / 2}
+	l.head, l.tail = n, n
+	l.size = 1
+	return n
+}
+
+// InsertAfter inserts value immediately after at, returning the new
+// node. It runs in O(1) amortized time: most insertions just split the
+// gap between at and its current successor, and the occasional
+// relabeling pass is paid for by the insertions that triggered it.
+func (l *List[T]) InsertAfter(at *Node[T], value T) *Node[T] {
+	if at == nil {
+		panic("ordermaintenance: InsertAfter called with a nil node")
+	}
+
+	if gap(at, at.next) < 2 {
+		l.relabel(at)
+	}
+
+	next := at.next
+	n := &Node[T]{Value: value, label: midpoint(at, next), prev: at, next: next}
+	at.next = n
+	if next != nil {
+		next.prev = n
+	} else {
+		l.tail = n
+	}
+	l.size++
+	return n
+}
+
+// Remove detaches n from the list. n's label is left untouched, so any
+// node still holding a stale reference to it must not call Order with it
+// afterwards.
+func (l *List[T]) Remove(n *Node[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	l.size--
+}
+
+// Order reports the relative order of a and b: -1 if a comes before b,
+// 1 if a comes after b, and 0 if they are the same node. It is a single
+// label comparison, O(1).
+func Order[T any](a, b *Node[T]) int {
+	switch {
+	case a.label < b.label:
+		return -1
+	case a.label > b.label:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// gap returns the distance between a node's label and its successor's
+// label (or the distance up to maxLabel if there is no successor).
+func gap[T any](at, next *Node[T]) uint64 {
+	if next == nil {
+		return maxLabel - at.label
+	}
+	return next.label - at.label
+}
+
+// midpoint picks a label strictly between at and next (or between at and
+// maxLabel if next is nil), assuming gap(at, next) >= 2.
+func midpoint[T any](at, next *Node[T]) uint64 {
+	if next == nil {
+		return at.label + (maxLabel-at.label)/2
+	}
+	return at.label + (next.label-at.label)/2
+}
+
+// relabel widens the gap right after at by re-spacing an exponentially
+// growing window of nodes centered on at, until the window's label range
+// is dense enough to leave slack for future insertions (or the window
+// has grown to cover the whole list).
+func (l *List[T]) relabel(at *Node[T]) {
+	window := 2
+	for {
+		if window > l.size {
+			window = l.size
+		}
+		nodes := l.window(at, window)
+
+		low, high := nodes[0].label, nodes[len(nodes)-1].label
+		if nodes[0] == l.head {
+			low = 0
+		}
+		if nodes[len(nodes)-1] == l.tail {
+			high = maxLabel
+		}
+
+		full := len(nodes) == l.size
+		if full || len(nodes) == 1 || (high-low)/uint64(len(nodes)) >= 2 {
+			relabelEvenly(nodes, low, high)
+			return
+		}
+		window *= 2
+	}
+}
+
+// window collects up to count nodes in list order, centered as closely
+// as possible on at, clipped at the head/tail of the list. If at sits
+// near one end, the shortfall is pulled from the other direction so the
+// window still reaches count nodes whenever the list is long enough.
+func (l *List[T]) window(at *Node[T], count int) []*Node[T] {
+	if count > l.size {
+		count = l.size
+	}
+
+	before := count / 2
+	low := at
+	for i := 0; i < before && low.prev != nil; i++ {
+		low = low.prev
+	}
+
+	nodes := make([]*Node[T], 0, count)
+	for n := low; n != nil && len(nodes) < count; n = n.next {
+		nodes = append(nodes, n)
+	}
+	for len(nodes) < count && low.prev != nil {
+		low = low.prev
+		nodes = append([]*Node[T]{low}, nodes...)
+	}
+	return nodes
+}
+
+// relabelEvenly assigns nodes[i] evenly spaced labels across [low, high].
+func relabelEvenly[T any](nodes []*Node[T], low, high uint64) {
+	if len(nodes) == 1 {
+		nodes[0].label = low + (high-low)/2
+		return
+	}
+	step := (high - low) / uint64(len(nodes)-1)
+	for i, n := range nodes {
+		n.label = low + step*uint64(i)
+	}
+}
+
+// String renders the list's values in order, for debugging.
+func (l *List[T]) String() string {
+	s := "["
+	for n := l.head; n != nil; n = n.next {
+		if n != l.head {
+			s += " "
+		}
+		s += fmt.Sprint(n.Value)
+	}
+	return s + "]"
+}
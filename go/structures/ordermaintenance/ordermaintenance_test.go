@@ -0,0 +1,121 @@
+package ordermaintenance
+
+import "testing"
+
+func TestInsertAndOrder(t *testing.T) {
+	l := New[string]()
+	a := l.Insert("a")
+	b := l.InsertAfter(a, "b")
+	c := l.InsertAfter(b, "c")
+
+	if Order(a, b) != -1 {
+		t.Errorf("Order(a, b) = %d, want -1", Order(a, b))
+	}
+	if Order(b, c) != -1 {
+		t.Errorf("Order(b, c) = %d, want -1", Order(b, c))
+	}
+	if Order(a, c) != -1 {
+		t.Errorf("Order(a, c) = %d, want -1", Order(a, c))
+	}
+	if Order(a, a) != 0 {
+		t.Errorf("Order(a, a) = %d, want 0", Order(a, a))
+	}
+	if Order(c, a) != 1 {
+		t.Errorf("Order(c, a) = %d, want 1", Order(c, a))
+	}
+}
+
+func TestInsertBetweenExistingNodes(t *testing.T) {
+	l := New[int]()
+	a := l.Insert(1)
+	c := l.InsertAfter(a, 3)
+	b := l.InsertAfter(a, 2)
+
+	if Order(a, b) != -1 || Order(b, c) != -1 {
+		t.Errorf("expected order a < b < c, got Order(a,b)=%d Order(b,c)=%d", Order(a, b), Order(b, c))
+	}
+}
+
+func TestInsertTriggersRelabel(t *testing.T) {
+	l := New[int]()
+	head := l.Insert(0)
+
+	// Repeatedly insert immediately after head: every InsertAfter(head, _)
+	// halves the gap between head and its successor, so this forces many
+	// relabeling passes well before i reaches 64. Each new node becomes
+	// head's new immediate successor, so the list ends up in descending
+	// insertion order right after head.
+	want := len(traverse(l, head)) + 200
+	for i := 1; i <= 200; i++ {
+		l.InsertAfter(head, i)
+	}
+
+	got := traverse(l, head)
+	if len(got) != want {
+		t.Fatalf("traverse returned %d nodes, want %d", len(got), want)
+	}
+	for i := 1; i < len(got); i++ {
+		if Order(got[i-1], got[i]) != -1 {
+			t.Fatalf("nodes out of order at position %d", i)
+		}
+	}
+	if l.Len() != want {
+		t.Errorf("Len() = %d, want %d", l.Len(), want)
+	}
+}
+
+// traverse walks the list from head following next pointers, returning
+// the nodes in actual list order.
+func traverse[T any](l *List[T], head *Node[T]) []*Node[T] {
+	var nodes []*Node[T]
+	for n := head; n != nil; n = n.next {
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func TestInsertManyAppendsStayOrdered(t *testing.T) {
+	l := New[int]()
+	head := l.Insert(0)
+	tail := head
+	nodes := []*Node[int]{head}
+	for i := 1; i <= 500; i++ {
+		tail = l.InsertAfter(tail, i)
+		nodes = append(nodes, tail)
+	}
+
+	for i := 1; i < len(nodes); i++ {
+		if Order(nodes[i-1], nodes[i]) != -1 {
+			t.Fatalf("nodes out of order at position %d", i)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	l := New[int]()
+	a := l.Insert(1)
+	b := l.InsertAfter(a, 2)
+	c := l.InsertAfter(b, 3)
+
+	l.Remove(b)
+	if l.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", l.Len())
+	}
+	if Order(a, c) != -1 {
+		t.Errorf("Order(a, c) = %d, want -1", Order(a, c))
+	}
+	if l.String() != "[1 3]" {
+		t.Errorf("String() = %q, want [1 3]", l.String())
+	}
+}
+
+func TestInsertPanicsOnNonEmptyList(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Insert on a non-empty list did not panic")
+		}
+	}()
+	l := New[int]()
+	l.Insert(1)
+	l.Insert(2)
+}
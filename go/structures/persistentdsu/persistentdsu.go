@@ -0,0 +1,104 @@
+// Package persistentdsu implements a partially persistent disjoint-set
+// union: every past version remains queryable via Connected(u, v,
+// version), while only the latest version can be mutated by Union. This
+// repo's existing union-find (concurrency/unionfind) only ever exposes
+// its current state, so it can't answer "were u and v connected after
+// the third union" — DSU needs its own historical record for that.
+//
+// Persistence is achieved by union-by-rank without path compression
+// (path compression would rewrite a node's parent purely to speed up a
+// future Find, which a persistent structure can't do for free) plus a
+// per-node append-only log of every parent change and the version it
+// happened at — a "fat node" persistent array rather than copying the
+// whole parent array on every union. Looking up a node's historical
+// parent is then a binary search over its own log for the newest entry
+// at or before the requested version.
+package persistentdsu
+
+// parentChange records that, as of version, a node's parent became
+// parent. Every node's log starts with {version: 0, parent: itself}.
+type parentChange struct {
+	version int
+	parent  int
+}
+
+// DSU is a partially persistent disjoint-set union over n elements.
+type DSU struct {
+	history [][]parentChange // history[i] is node i's parent changes, sorted by version
+	rank    []int            // current rank; only ever consulted at the latest version
+	version int
+}
+
+// New returns a DSU over n elements, each initially its own singleton
+// set, as of version 0.
+func New(n int) *DSU {
+	d := &DSU{history: make([][]parentChange, n), rank: make([]int, n)}
+	for i := range d.history {
+		d.history[i] = []parentChange{{version: 0, parent: i}}
+	}
+	return d
+}
+
+// Version returns the latest version: the number of Union calls made so
+// far.
+func (d *DSU) Version() int {
+	return d.version
+}
+
+// parentAt returns x's parent as of version v, via binary search over
+// x's change log for the newest entry at or before v.
+func (d *DSU) parentAt(x, v int) int {
+	log := d.history[x]
+	lo, hi := 0, len(log)-1
+	best := log[0].parent
+	for lo <= hi {
+		mid := (lo + hi) / 2
+		if log[mid].version <= v {
+			best = log[mid].parent
+			lo = mid + 1
+		} else {
+			hi = mid - 1
+		}
+	}
+	return best
+}
+
+// findAt returns the representative of x's set as of version v.
+func (d *DSU) findAt(x, v int) int {
+	for {
+		p := d.parentAt(x, v)
+		if p == x {
+			return x
+		}
+		x = p
+	}
+}
+
+// Union merges the sets containing x and y, advancing the DSU to a new
+// version. It returns the new version and whether x and y were
+// previously in different sets (false if they were already connected,
+// in which case the version still advances but nothing else changes).
+func (d *DSU) Union(x, y int) (version int, merged bool) {
+	d.version++
+	rx, ry := d.findAt(x, d.version-1), d.findAt(y, d.version-1)
+	if rx == ry {
+		return d.version, false
+	}
+
+	if d.rank[rx] < d.rank[ry] {
+		rx, ry = ry, rx
+	} else if d.rank[rx] == d.rank[ry] {
+		d.rank[rx]++
+	}
+	d.history[ry] = append(d.history[ry], parentChange{version: d.version, parent: rx})
+	return d.version, true
+}
+
+// Connected reports whether x and y were in the same set as of version.
+// Connected panics if version is negative or greater than Version().
+func (d *DSU) Connected(x, y, version int) bool {
+	if version < 0 || version > d.version {
+		panic("persistentdsu: version out of range")
+	}
+	return d.findAt(x, version) == d.findAt(y, version)
+}
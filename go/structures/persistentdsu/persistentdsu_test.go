@@ -0,0 +1,85 @@
+package persistentdsu
+
+import "testing"
+
+func TestConnectedAtVersionZero(t *testing.T) {
+	d := New(4)
+	if d.Connected(0, 1, 0) {
+		t.Errorf("Connected(0, 1, 0) = true, want false (nothing unioned yet)")
+	}
+}
+
+func TestUnionAdvancesVersion(t *testing.T) {
+	d := New(4)
+	v1, merged := d.Union(0, 1)
+	if !merged || v1 != 1 {
+		t.Fatalf("Union(0, 1) = (%d, %v), want (1, true)", v1, merged)
+	}
+	if d.Version() != 1 {
+		t.Errorf("Version() = %d, want 1", d.Version())
+	}
+}
+
+func TestHistoricalQueriesSeePastState(t *testing.T) {
+	d := New(4)
+	d.Union(0, 1) // version 1: {0,1} {2} {3}
+	d.Union(2, 3) // version 2: {0,1} {2,3}
+	d.Union(1, 2) // version 3: {0,1,2,3}
+
+	if d.Connected(0, 3, 1) {
+		t.Errorf("Connected(0, 3, 1) = true, want false (3 joined the set at version 3)")
+	}
+	if !d.Connected(0, 1, 1) {
+		t.Errorf("Connected(0, 1, 1) = false, want true")
+	}
+	if d.Connected(0, 2, 2) {
+		t.Errorf("Connected(0, 2, 2) = true, want false (0 and 2 merge at version 3)")
+	}
+	if !d.Connected(0, 3, 3) {
+		t.Errorf("Connected(0, 3, 3) = false, want true (everything merged by version 3)")
+	}
+}
+
+func TestCurrentVersionMatchesLatest(t *testing.T) {
+	d := New(3)
+	d.Union(0, 1)
+	if !d.Connected(0, 1, d.Version()) {
+		t.Errorf("Connected(0, 1, Version()) = false, want true")
+	}
+}
+
+func TestUnionOnAlreadyConnectedStillAdvancesVersion(t *testing.T) {
+	d := New(2)
+	d.Union(0, 1)
+	before := d.Version()
+	v, merged := d.Union(0, 1)
+	if merged {
+		t.Errorf("Union(0, 1) second call: merged = true, want false")
+	}
+	if v != before+1 {
+		t.Errorf("Union(0, 1) second call version = %d, want %d", v, before+1)
+	}
+}
+
+func TestConnectedPanicsOnOutOfRangeVersion(t *testing.T) {
+	d := New(2)
+	d.Union(0, 1)
+	defer func() {
+		if recover() == nil {
+			t.Error("Connected() did not panic for a version beyond Version()")
+		}
+	}()
+	d.Connected(0, 1, d.Version()+1)
+}
+
+func TestSingletonsStayDisconnected(t *testing.T) {
+	d := New(5)
+	d.Union(0, 1)
+	d.Union(2, 3)
+	if d.Connected(0, 4, d.Version()) {
+		t.Errorf("Connected(0, 4, ...) = true, want false")
+	}
+	if d.Connected(1, 2, d.Version()) {
+		t.Errorf("Connected(1, 2, ...) = true, want false")
+	}
+}
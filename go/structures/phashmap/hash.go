@@ -0,0 +1,22 @@
+package phashmap
+
+import "hash/fnv"
+
+// HashString hashes s with FNV-1a, a convenient default for Map[string, V].
+func HashString(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// HashInt hashes an int key by mixing its bits (Fibonacci hashing), a
+// convenient default for Map[int, V].
+func HashInt(n int) uint32 {
+	x := uint32(n)
+	x ^= x >> 16
+	x *= 0x45d9f3b
+	x ^= x >> 16
+	x *= 0x45d9f3b
+	x ^= x >> 16
+	return x
+}
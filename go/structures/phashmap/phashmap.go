@@ -0,0 +1,195 @@
+// Package phashmap implements a HAMT (hash array mapped trie): an
+// immutable map where Set and Delete return a new Map sharing every node
+// of the original that the change didn't touch, the same structural-
+// sharing idea pvector applies to vectors.
+//
+// The trie consumes 5 bits of each key's 32-bit hash per level (32-way
+// branching), compressing each branch node's children with a bitmap +
+// popcount so a node only stores slots it actually uses, rather than 32
+// pointers most of which would be nil. Keys whose hashes still collide
+// after all 32 bits are consumed (depth reaches maxDepth) fall back to a
+// linear-scan collision list at that leaf.
+package phashmap
+
+import "math/bits"
+
+const (
+	chunkBits = 5
+	chunkMask = 1<<chunkBits - 1
+	maxDepth  = 32 / chunkBits // 6, with a final partial chunk covering the remaining 2 bits
+)
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// node is either a leaf (entries non-nil: one entry in the common case, or
+// several if their hashes fully collided) or a branch (bitmap/children
+// used, entries nil).
+type node[K comparable, V any] struct {
+	bitmap   uint32
+	children []*node[K, V] // compacted: len(children) == bits.OnesCount32(bitmap)
+	entries  []entry[K, V]
+}
+
+// Map is an immutable, persistent key-value map over keys of type K. The
+// zero value is not usable directly; construct one with New.
+type Map[K comparable, V any] struct {
+	root *node[K, V]
+	hash func(K) uint32
+	size int
+}
+
+// New returns an empty Map that hashes keys with hash.
+func New[K comparable, V any](hash func(K) uint32) *Map[K, V] {
+	return &Map[K, V]{hash: hash}
+}
+
+// Len returns the number of entries in m.
+func (m *Map[K, V]) Len() int { return m.size }
+
+// Get returns the value stored for key, if any.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	return get(m.root, m.hash(key), 0, key)
+}
+
+func get[K comparable, V any](n *node[K, V], hash uint32, depth int, key K) (V, bool) {
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+	if n.entries != nil {
+		for _, e := range n.entries {
+			if e.key == key {
+				return e.value, true
+			}
+		}
+		var zero V
+		return zero, false
+	}
+
+	bit := childBit(hash, depth)
+	if n.bitmap&bit == 0 {
+		var zero V
+		return zero, false
+	}
+	return get(n.children[childIndex(n.bitmap, bit)], hash, depth+1, key)
+}
+
+// Set returns a new Map with key bound to val, sharing every node that
+// wasn't on the path to key with m.
+func (m *Map[K, V]) Set(key K, val V) *Map[K, V] {
+	_, existed := m.Get(key)
+	root := insert(m.root, m.hash, m.hash(key), 0, key, val)
+	size := m.size
+	if !existed {
+		size++
+	}
+	return &Map[K, V]{root: root, hash: m.hash, size: size}
+}
+
+func insert[K comparable, V any](n *node[K, V], hashFn func(K) uint32, hash uint32, depth int, key K, val V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{entries: []entry[K, V]{{key, val}}}
+	}
+
+	if n.entries != nil {
+		for i, e := range n.entries {
+			if e.key == key {
+				entries := append([]entry[K, V]{}, n.entries...)
+				entries[i] = entry[K, V]{key, val}
+				return &node[K, V]{entries: entries}
+			}
+		}
+		if depth >= maxDepth {
+			entries := append(append([]entry[K, V]{}, n.entries...), entry[K, V]{key, val})
+			return &node[K, V]{entries: entries}
+		}
+
+		// This leaf holds exactly one entry (collision lists only form at
+		// maxDepth, handled above) whose hash differs from the new key's
+		// at this depth — otherwise it would already have been pushed
+		// further down. Split it into a branch and reinsert both.
+		existing := n.entries[0]
+		branch := insert(&node[K, V]{}, hashFn, hashFn(existing.key), depth, existing.key, existing.value)
+		return insert(branch, hashFn, hash, depth, key, val)
+	}
+
+	bit := childBit(hash, depth)
+	idx := childIndex(n.bitmap, bit)
+	if n.bitmap&bit != 0 {
+		children := append([]*node[K, V]{}, n.children...)
+		children[idx] = insert(n.children[idx], hashFn, hash, depth+1, key, val)
+		return &node[K, V]{bitmap: n.bitmap, children: children}
+	}
+
+	children := make([]*node[K, V], len(n.children)+1)
+	copy(children[:idx], n.children[:idx])
+	children[idx] = insert(nil, hashFn, hash, depth+1, key, val)
+	copy(children[idx+1:], n.children[idx:])
+	return &node[K, V]{bitmap: n.bitmap | bit, children: children}
+}
+
+// Delete returns a new Map with key removed, or m itself if key wasn't
+// present.
+func (m *Map[K, V]) Delete(key K) *Map[K, V] {
+	root, removed := remove(m.root, m.hash(key), 0, key)
+	if !removed {
+		return m
+	}
+	return &Map[K, V]{root: root, hash: m.hash, size: m.size - 1}
+}
+
+func remove[K comparable, V any](n *node[K, V], hash uint32, depth int, key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	if n.entries != nil {
+		for i, e := range n.entries {
+			if e.key == key {
+				if len(n.entries) == 1 {
+					return nil, true
+				}
+				entries := append(append([]entry[K, V]{}, n.entries[:i:i]...), n.entries[i+1:]...)
+				return &node[K, V]{entries: entries}, true
+			}
+		}
+		return n, false
+	}
+
+	bit := childBit(hash, depth)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	idx := childIndex(n.bitmap, bit)
+	newChild, removed := remove(n.children[idx], hash, depth+1, key)
+	if !removed {
+		return n, false
+	}
+	if newChild == nil {
+		if len(n.children) == 1 {
+			return nil, true
+		}
+		children := make([]*node[K, V], len(n.children)-1)
+		copy(children[:idx], n.children[:idx])
+		copy(children[idx:], n.children[idx+1:])
+		return &node[K, V]{bitmap: n.bitmap &^ bit, children: children}, true
+	}
+
+	children := append([]*node[K, V]{}, n.children...)
+	children[idx] = newChild
+	return &node[K, V]{bitmap: n.bitmap, children: children}, true
+}
+
+// childBit returns the single-bit bitmap slot for hash's chunkBits at
+// depth.
+func childBit(hash uint32, depth int) uint32 {
+	return 1 << ((hash >> (depth * chunkBits)) & chunkMask)
+}
+
+// childIndex returns bit's position within a bitmap-compressed children
+// slice: the number of set bits below it.
+func childIndex(bitmap, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
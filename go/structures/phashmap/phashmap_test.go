@@ -0,0 +1,125 @@
+package phashmap
+
+import "testing"
+
+func TestSetAndGet(t *testing.T) {
+	m := New[string, int](HashString)
+	m = m.Set("a", 1)
+	m = m.Set("b", 2)
+	m = m.Set("c", 3)
+
+	for k, want := range map[string]int{"a": 1, "b": 2, "c": 3} {
+		got, ok := m.Get(k)
+		if !ok || got != want {
+			t.Errorf("Get(%q) = (%d, %v), want (%d, true)", k, got, ok, want)
+		}
+	}
+	if _, ok := m.Get("missing"); ok {
+		t.Errorf("Get(missing) should report ok=false")
+	}
+	if m.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", m.Len())
+	}
+}
+
+func TestSetOverwritesAndKeepsLen(t *testing.T) {
+	m := New[string, int](HashString)
+	m = m.Set("a", 1)
+	m = m.Set("a", 2)
+
+	got, _ := m.Get("a")
+	if got != 2 {
+		t.Errorf("Get(a) = %d, want 2", got)
+	}
+	if m.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", m.Len())
+	}
+}
+
+func TestSetIsStructurallyIndependent(t *testing.T) {
+	m := New[string, int](HashString)
+	m = m.Set("a", 1)
+	m = m.Set("b", 2)
+
+	m2 := m.Set("a", 100)
+
+	got, _ := m.Get("a")
+	if got != 1 {
+		t.Errorf("original map should be unaffected by Set on the derived map; Get(a) = %d, want 1", got)
+	}
+	got2, _ := m2.Get("a")
+	if got2 != 100 {
+		t.Errorf("m2.Get(a) = %d, want 100", got2)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	m := New[string, int](HashString)
+	m = m.Set("a", 1)
+	m = m.Set("b", 2)
+
+	m2 := m.Delete("a")
+	if _, ok := m2.Get("a"); ok {
+		t.Errorf("m2 should no longer contain a")
+	}
+	if m2.Len() != 1 {
+		t.Errorf("m2.Len() = %d, want 1", m2.Len())
+	}
+	if got, ok := m.Get("a"); !ok || got != 1 {
+		t.Errorf("original map should be unaffected by Delete on the derived map")
+	}
+
+	m3 := m.Delete("missing")
+	if m3.Len() != m.Len() {
+		t.Errorf("Delete of a missing key should leave Len() unchanged")
+	}
+}
+
+// constantHash forces every key into the same bucket, exercising the
+// maxDepth collision-list fallback directly.
+func constantHash[K comparable](K) uint32 { return 0 }
+
+func TestHashCollisions(t *testing.T) {
+	m := New[string, int](constantHash[string])
+	keys := []string{"one", "two", "three", "four", "five"}
+	for i, k := range keys {
+		m = m.Set(k, i)
+	}
+
+	if m.Len() != len(keys) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(keys))
+	}
+	for i, k := range keys {
+		got, ok := m.Get(k)
+		if !ok || got != i {
+			t.Errorf("Get(%q) = (%d, %v), want (%d, true)", k, got, ok, i)
+		}
+	}
+
+	m2 := m.Delete("three")
+	if _, ok := m2.Get("three"); ok {
+		t.Errorf("Delete(three) should remove it from the collision list")
+	}
+	for _, k := range []string{"one", "two", "four", "five"} {
+		if _, ok := m2.Get(k); !ok {
+			t.Errorf("Get(%q) should still succeed after deleting an unrelated colliding key", k)
+		}
+	}
+}
+
+func TestManyKeysRealHash(t *testing.T) {
+	m := New[int, int](HashInt)
+	const n = 5000
+	for i := 0; i < n; i++ {
+		m = m.Set(i, i*i)
+	}
+	if m.Len() != n {
+		t.Fatalf("Len() = %d, want %d", m.Len(), n)
+	}
+	for i := 0; i < n; i++ {
+		got, ok := m.Get(i)
+		if !ok || got != i*i {
+			t.Errorf("Get(%d) = (%d, %v), want (%d, true)", i, got, ok, i*i)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package pvector
+
+import "fmt"
+
+// indexError reports index i as invalid for a Vector of the given length.
+func indexError(i, length int) error {
+	return fmt.Errorf("pvector: index %d out of range [0, %d)", i, length)
+}
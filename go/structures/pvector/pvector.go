@@ -0,0 +1,110 @@
+// Package pvector implements a persistent (immutable) vector: Push and Set
+// return a new Vector that shares every unchanged part of the old one's
+// tree instead of copying it, the way Clojure's PersistentVector works.
+// It is a bitmapped trie with a branching factor of 32, which gives Get,
+// Set, and Push all O(log32 n) — in practice close to O(1) for any
+// realistic size, since a tree five levels deep already holds over a
+// million elements.
+//
+// This is the plain bitmapped-trie design, not a full RRB-tree: it does
+// not support the O(log n) concatenation/slicing a relaxed radix balance
+// would add, only indexed access, update, and append.
+package pvector
+
+const (
+	bitsPerLevel = 5
+	branchFactor = 1 << bitsPerLevel // 32
+	mask         = branchFactor - 1
+)
+
+type node[T any] struct {
+	children []*node[T] // non-nil for an internal node
+	values   []T        // non-nil for a leaf node
+}
+
+// Vector is an immutable, persistent sequence of T. The zero value is a
+// valid empty Vector.
+type Vector[T any] struct {
+	root  *node[T]
+	count int
+	shift uint // bitsPerLevel * (tree height - 1); 0 when the root is a leaf
+}
+
+// Len returns the number of elements in v.
+func (v *Vector[T]) Len() int { return v.count }
+
+// Get returns the element at index i, or an error if i is out of range.
+func (v *Vector[T]) Get(i int) (T, error) {
+	var zero T
+	if i < 0 || i >= v.count {
+		return zero, indexError(i, v.count)
+	}
+	n := v.root
+	for shift := v.shift; shift > 0; shift -= bitsPerLevel {
+		n = n.children[(i>>shift)&mask]
+	}
+	return n.values[i&mask], nil
+}
+
+// Set returns a new Vector with index i replaced by val, sharing every
+// other node with v.
+func (v *Vector[T]) Set(i int, val T) (*Vector[T], error) {
+	if i < 0 || i >= v.count {
+		return nil, indexError(i, v.count)
+	}
+	return &Vector[T]{root: setAt(v.root, v.shift, i, val), count: v.count, shift: v.shift}, nil
+}
+
+func setAt[T any](n *node[T], shift uint, i int, val T) *node[T] {
+	if shift == 0 {
+		values := append([]T{}, n.values...)
+		values[i&mask] = val
+		return &node[T]{values: values}
+	}
+	idx := (i >> shift) & mask
+	children := append([]*node[T]{}, n.children...)
+	children[idx] = setAt(n.children[idx], shift-bitsPerLevel, i, val)
+	return &node[T]{children: children}
+}
+
+// Push returns a new Vector with val appended, sharing every node of v
+// that didn't lie on the path to the new element.
+func (v *Vector[T]) Push(val T) *Vector[T] {
+	if v.root == nil {
+		return &Vector[T]{root: &node[T]{values: []T{val}}, count: 1}
+	}
+
+	// capacity is how many leaf slots the current tree can address; once
+	// count reaches it, the tree needs another level before it can grow.
+	capacity := 1 << (v.shift + bitsPerLevel)
+	if v.count == capacity {
+		newShift := v.shift + bitsPerLevel
+		newRoot := insertAt(&node[T]{children: []*node[T]{v.root}}, newShift, v.count, val)
+		return &Vector[T]{root: newRoot, count: v.count + 1, shift: newShift}
+	}
+
+	return &Vector[T]{root: insertAt(v.root, v.shift, v.count, val), count: v.count + 1, shift: v.shift}
+}
+
+// insertAt returns a copy of the path from n down to the slot for index i,
+// with val placed there — creating new nodes along the way for any part
+// of the path that didn't exist yet.
+func insertAt[T any](n *node[T], shift uint, i int, val T) *node[T] {
+	if shift == 0 {
+		return &node[T]{values: append(append([]T{}, n.values...), val)}
+	}
+
+	idx := (i >> shift) & mask
+	children := append([]*node[T]{}, n.children...)
+	child := &node[T]{}
+	if idx < len(n.children) {
+		child = n.children[idx]
+	}
+	newChild := insertAt(child, shift-bitsPerLevel, i, val)
+	if idx < len(children) {
+		children[idx] = newChild
+	} else {
+		children = append(children, newChild)
+	}
+	return &node[T]{children: children}
+}
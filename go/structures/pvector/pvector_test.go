@@ -0,0 +1,98 @@
+package pvector
+
+import "testing"
+
+func TestPushAndGet(t *testing.T) {
+	var v *Vector[int]
+	v = &Vector[int]{}
+
+	const n = 2000 // several levels deep at branchFactor 32
+	for i := 0; i < n; i++ {
+		v = v.Push(i * 10)
+	}
+	if v.Len() != n {
+		t.Fatalf("Len() = %d, want %d", v.Len(), n)
+	}
+	for i := 0; i < n; i++ {
+		got, err := v.Get(i)
+		if err != nil {
+			t.Fatalf("Get(%d): %v", i, err)
+		}
+		if got != i*10 {
+			t.Errorf("Get(%d) = %d, want %d", i, got, i*10)
+		}
+	}
+}
+
+func TestGetOutOfRange(t *testing.T) {
+	v := &Vector[int]{}
+	v = v.Push(1)
+	if _, err := v.Get(-1); err == nil {
+		t.Errorf("Get(-1) should return an error")
+	}
+	if _, err := v.Get(1); err == nil {
+		t.Errorf("Get(1) on a length-1 vector should return an error")
+	}
+}
+
+func TestSetIsStructurallyIndependent(t *testing.T) {
+	var v *Vector[int]
+	v = &Vector[int]{}
+	for i := 0; i < 100; i++ {
+		v = v.Push(i)
+	}
+
+	v2, err := v.Set(50, 999)
+	if err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	got50, _ := v2.Get(50)
+	if got50 != 999 {
+		t.Errorf("v2.Get(50) = %d, want 999", got50)
+	}
+	orig50, _ := v.Get(50)
+	if orig50 != 50 {
+		t.Errorf("Set should not mutate v: v.Get(50) = %d, want 50", orig50)
+	}
+
+	// every other index should be unaffected and still shared.
+	for i := 0; i < 100; i++ {
+		if i == 50 {
+			continue
+		}
+		a, _ := v.Get(i)
+		b, _ := v2.Get(i)
+		if a != b {
+			t.Errorf("Get(%d): v=%d v2=%d, want equal", i, a, b)
+		}
+	}
+}
+
+func TestSetOutOfRange(t *testing.T) {
+	v := &Vector[int]{}
+	v = v.Push(1)
+	if _, err := v.Set(5, 0); err == nil {
+		t.Errorf("Set(5, ...) on a length-1 vector should return an error")
+	}
+}
+
+func TestPushDoesNotMutateOriginal(t *testing.T) {
+	var v *Vector[int]
+	v = &Vector[int]{}
+	for i := 0; i < 40; i++ { // crosses the first leaf boundary (32)
+		v = v.Push(i)
+	}
+
+	before := v.Len()
+	v2 := v.Push(999)
+	if v.Len() != before {
+		t.Errorf("Push on v2 should not change v.Len(); got %d, want %d", v.Len(), before)
+	}
+	if v2.Len() != before+1 {
+		t.Errorf("v2.Len() = %d, want %d", v2.Len(), before+1)
+	}
+	if _, err := v.Get(before); err == nil {
+		t.Errorf("v.Get(%d) should still be out of range after pushing only onto v2", before)
+	}
+}
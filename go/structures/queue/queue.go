@@ -0,0 +1,58 @@
+package queue
+
+import "fmt"
+
+type Queue[T any] struct {
+	items []T
+	front int
+	rear  int
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{
+		items: make([]T, 0),
+		front: -1,
+		rear:  -1,
+	}
+}
+
+func (q *Queue[T]) IsEmpty() bool {
+	return q.front == -1
+}
+
+func (q *Queue[T]) Enqueue(item T) {
+	if q.IsEmpty() {
+		q.front = 0
+	}
+	q.rear++
+	q.items = append(q.items, item)
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+
+	item := q.items[q.front]
+	q.front++
+
+	if q.front > q.rear {
+		q.front = -1
+		q.rear = -1
+	}
+
+	return item, true
+}
+
+func (q *Queue[T]) Display() {
+	if q.IsEmpty() {
+		fmt.Println("Queue is empty")
+		return
+	}
+
+	for i := q.front; i <= q.rear; i++ {
+		fmt.Print(q.items[i], " ")
+	}
+	fmt.Println()
+}
@@ -0,0 +1,19 @@
+package queue
+
+import "fmt"
+
+func ExampleQueue() {
+	q := NewQueue[int]()
+	q.Enqueue(10)
+	q.Enqueue(20)
+	q.Enqueue(30)
+
+	dequeued, _ := q.Dequeue()
+	fmt.Println("Dequeued:", dequeued)
+
+	fmt.Print("Queue after dequeue: ")
+	q.Display()
+	// Output:
+	// Dequeued: 10
+	// Queue after dequeue: 20 30
+}
@@ -0,0 +1,60 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// toSlice returns the queue's items front-to-rear, independent of how much
+// of the underlying items slice has already been dequeued.
+func (q *Queue[T]) toSlice() []T {
+	if q.IsEmpty() {
+		return []T{}
+	}
+	return append([]T(nil), q.items[q.front:q.rear+1]...)
+}
+
+// fromSlice resets the queue to hold exactly items, in order.
+func (q *Queue[T]) fromSlice(items []T) {
+	q.items = items
+	if len(items) == 0 {
+		q.front, q.rear = -1, -1
+		return
+	}
+	q.front, q.rear = 0, len(items)-1
+}
+
+// MarshalJSON encodes the queue as a JSON array of its items, front-to-rear.
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.toSlice())
+}
+
+// UnmarshalJSON decodes a queue previously written by MarshalJSON.
+func (q *Queue[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	q.fromSlice(items)
+	return nil
+}
+
+// GobEncode encodes the queue for encoding/gob.
+func (q *Queue[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q.toSlice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes a queue previously written by GobEncode.
+func (q *Queue[T]) GobDecode(data []byte) error {
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	q.fromSlice(items)
+	return nil
+}
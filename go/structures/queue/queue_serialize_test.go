@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func buildTestQueue() *Queue[int] {
+	q := NewQueue[int]()
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+	return q
+}
+
+func TestQueueJSONRoundTrip(t *testing.T) {
+	q := buildTestQueue()
+
+	b, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := "[1,2,3]"; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	got := NewQueue[int]()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !slices.Equal(got.toSlice(), q.toSlice()) {
+		t.Errorf("round-tripped queue = %v, want %v", got.toSlice(), q.toSlice())
+	}
+}
+
+func TestQueueJSONEmpty(t *testing.T) {
+	q := NewQueue[int]()
+	b, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := "[]"; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	got := NewQueue[int]()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !got.IsEmpty() {
+		t.Errorf("round-tripped empty queue should be empty, got %v", got.toSlice())
+	}
+}
+
+func TestQueueGobRoundTrip(t *testing.T) {
+	q := buildTestQueue()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(q); err != nil {
+		t.Fatalf("gob encode error = %v", err)
+	}
+
+	got := NewQueue[int]()
+	if err := gob.NewDecoder(&buf).Decode(got); err != nil {
+		t.Fatalf("gob decode error = %v", err)
+	}
+	if !slices.Equal(got.toSlice(), q.toSlice()) {
+		t.Errorf("round-tripped queue = %v, want %v", got.toSlice(), q.toSlice())
+	}
+}
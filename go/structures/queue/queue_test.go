@@ -0,0 +1,42 @@
+package queue
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	t.Run("new queue is empty", func(t *testing.T) {
+		q := NewQueue[int]()
+		if !q.IsEmpty() {
+			t.Errorf("NewQueue() should be empty")
+		}
+		if _, ok := q.Dequeue(); ok {
+			t.Errorf("Dequeue() on empty queue should return ok=false")
+		}
+	})
+
+	t.Run("enqueue and dequeue preserve FIFO order", func(t *testing.T) {
+		q := NewQueue[int]()
+		q.Enqueue(1)
+		q.Enqueue(2)
+		q.Enqueue(3)
+
+		for _, want := range []int{1, 2, 3} {
+			got, ok := q.Dequeue()
+			if !ok || got != want {
+				t.Errorf("Dequeue() = (%d, %v), want (%d, true)", got, ok, want)
+			}
+		}
+
+		if !q.IsEmpty() {
+			t.Errorf("queue should be empty after draining all elements")
+		}
+	})
+
+	t.Run("single element", func(t *testing.T) {
+		q := NewQueue[string]()
+		q.Enqueue("only")
+		got, ok := q.Dequeue()
+		if !ok || got != "only" {
+			t.Errorf("Dequeue() = (%q, %v), want (%q, true)", got, ok, "only")
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package rangequery
+
+import "math/bits"
+
+// DisjointSparseTable answers arbitrary associative-op range queries
+// (sum, product, min, xor, ...) in O(1) after an O(n log n) build.
+// Unlike SparseTable, combine need not be idempotent: at each level k it
+// splits the array into blocks of size 2^(k+1) and, within each block,
+// precomputes a prefix from the midpoint going left and a suffix from
+// the midpoint going right — the two halves of any query range always
+// land in exactly one such disjoint prefix/suffix pair, so no range is
+// ever combined with itself.
+type DisjointSparseTable[T any] struct {
+	data    []T
+	table   [][]T
+	combine func(a, b T) T
+}
+
+// NewDisjointSparseTable builds a DisjointSparseTable over data using
+// combine, which must be associative but need not be idempotent.
+func NewDisjointSparseTable[T any](data []T, combine func(a, b T) T) *DisjointSparseTable[T] {
+	n := len(data)
+	t := &DisjointSparseTable[T]{data: append([]T{}, data...), combine: combine}
+	if n == 0 {
+		return t
+	}
+
+	levels := 1
+	for (1 << levels) < n {
+		levels++
+	}
+	t.table = make([][]T, levels)
+
+	for k := 0; k < levels; k++ {
+		row := make([]T, n)
+		blockSize := 1 << (k + 1)
+		for blockStart := 0; blockStart < n; blockStart += blockSize {
+			mid := blockStart + (1 << k)
+			if mid > n {
+				mid = n
+			}
+			end := blockStart + blockSize
+			if end > n {
+				end = n
+			}
+
+			if mid > blockStart {
+				row[mid-1] = data[mid-1]
+				for j := mid - 2; j >= blockStart; j-- {
+					row[j] = combine(data[j], row[j+1])
+				}
+			}
+			if end > mid {
+				row[mid] = data[mid]
+				for j := mid + 1; j < end; j++ {
+					row[j] = combine(row[j-1], data[j])
+				}
+			}
+		}
+		t.table[k] = row
+	}
+	return t
+}
+
+// Query returns combine applied across data[l:r+1] (inclusive), in
+// O(1). l and r must be in range and l <= r.
+func (t *DisjointSparseTable[T]) Query(l, r int) T {
+	if l == r {
+		return t.data[l]
+	}
+	k := bits.Len(uint(l^r)) - 1
+	return t.combine(t.table[k][l], t.table[k][r])
+}
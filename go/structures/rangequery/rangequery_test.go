@@ -0,0 +1,100 @@
+package rangequery
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func minOp(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func sumOp(a, b int) int { return a + b }
+
+func TestSparseTableMin(t *testing.T) {
+	data := []int{5, 2, 4, 7, 6, 3, 1, 8}
+	st := NewSparseTable(data, minOp)
+
+	for l := 0; l < len(data); l++ {
+		for r := l; r < len(data); r++ {
+			want := data[l]
+			for i := l + 1; i <= r; i++ {
+				want = minOp(want, data[i])
+			}
+			if got := st.Query(l, r); got != want {
+				t.Errorf("Query(%d, %d) = %d, want %d", l, r, got, want)
+			}
+		}
+	}
+}
+
+func TestDisjointSparseTableSum(t *testing.T) {
+	data := []int{5, 2, 4, 7, 6, 3, 1, 8, 9}
+	dst := NewDisjointSparseTable(data, sumOp)
+
+	for l := 0; l < len(data); l++ {
+		for r := l; r < len(data); r++ {
+			want := 0
+			for i := l; i <= r; i++ {
+				want += data[i]
+			}
+			if got := dst.Query(l, r); got != want {
+				t.Errorf("Query(%d, %d) = %d, want %d", l, r, got, want)
+			}
+		}
+	}
+}
+
+func TestDisjointSparseTableAgreesWithSparseTableOnMin(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]int, 50)
+	for i := range data {
+		data[i] = r.Intn(1000)
+	}
+	st := NewSparseTable(data, minOp)
+	dst := NewDisjointSparseTable(data, minOp)
+
+	for trial := 0; trial < 200; trial++ {
+		l := r.Intn(len(data))
+		rr := l + r.Intn(len(data)-l)
+		if st.Query(l, rr) != dst.Query(l, rr) {
+			t.Fatalf("mismatch on [%d,%d]: sparse=%d disjoint=%d", l, rr, st.Query(l, rr), dst.Query(l, rr))
+		}
+	}
+}
+
+func TestDisjointSparseTableSingleElement(t *testing.T) {
+	dst := NewDisjointSparseTable([]int{42}, sumOp)
+	if got := dst.Query(0, 0); got != 42 {
+		t.Errorf("Query(0,0) = %d, want 42", got)
+	}
+}
+
+func TestSlidingWindowMin(t *testing.T) {
+	got := SlidingWindowMin([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3)
+	want := []int{-1, -3, -3, -3, 3, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SlidingWindowMin() = %v, want %v", got, want)
+	}
+}
+
+func TestSlidingWindowMax(t *testing.T) {
+	got := SlidingWindowMax([]int{1, 3, -1, -3, 5, 3, 6, 7}, 3)
+	want := []int{3, 3, 5, 5, 6, 7}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SlidingWindowMax() = %v, want %v", got, want)
+	}
+}
+
+func TestSlidingWindowInvalidK(t *testing.T) {
+	if got := SlidingWindowMin([]int{1, 2, 3}, 0); got != nil {
+		t.Errorf("SlidingWindowMin with k=0 = %v, want nil", got)
+	}
+	if got := SlidingWindowMin([]int{1, 2, 3}, 4); got != nil {
+		t.Errorf("SlidingWindowMin with k>len = %v, want nil", got)
+	}
+}
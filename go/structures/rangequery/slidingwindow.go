@@ -0,0 +1,42 @@
+package rangequery
+
+// SlidingWindowMin returns, for every window of k consecutive elements
+// in data, the minimum of that window — len(data)-k+1 values in all —
+// computed in O(n) total via a monotonic deque of candidate indices
+// kept in increasing value order, so the window minimum is always at
+// its front.
+func SlidingWindowMin(data []int, k int) []int {
+	return slidingWindow(data, k, func(a, b int) bool { return a <= b })
+}
+
+// SlidingWindowMax is SlidingWindowMin's counterpart for window maxima.
+func SlidingWindowMax(data []int, k int) []int {
+	return slidingWindow(data, k, func(a, b int) bool { return a >= b })
+}
+
+// slidingWindow computes the running best of every k-window of data,
+// where keepFront(candidate, incoming) reports whether the front of the
+// deque should still be kept ahead of incoming (true for "min" means
+// front <= incoming, so only smaller incoming values evict it).
+func slidingWindow(data []int, k int, keepFront func(front, incoming int) bool) []int {
+	if k <= 0 || k > len(data) {
+		return nil
+	}
+	var deque []int // indices into data, values in monotonic order front-to-back
+	out := make([]int, 0, len(data)-k+1)
+
+	for i, v := range data {
+		for len(deque) > 0 && !keepFront(data[deque[len(deque)-1]], v) {
+			deque = deque[:len(deque)-1]
+		}
+		deque = append(deque, i)
+
+		if deque[0] <= i-k {
+			deque = deque[1:]
+		}
+		if i >= k-1 {
+			out = append(out, data[deque[0]])
+		}
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+// Package rangequery provides O(1)-query structures for static range
+// problems: a classic sparse table restricted to idempotent
+// associative ops (min, max, gcd, ...) where overlapping query ranges
+// are fine, a disjoint sparse table that lifts the same O(1) query time
+// to any associative op including non-idempotent ones (sum, product,
+// ...) by never letting its precomputed ranges overlap, and a
+// sliding-window RMQ over a monotonic deque for the fixed-window case.
+package rangequery
+
+// SparseTable answers min/max/gcd-style range queries in O(1) after an
+// O(n log n) build. combine must be idempotent — combine(x, x) == x —
+// because a query covers its range with two overlapping power-of-two
+// blocks; combine(+) would double-count the overlap.
+type SparseTable[T any] struct {
+	table   [][]T
+	combine func(a, b T) T
+	log     []int
+}
+
+// NewSparseTable builds a SparseTable over data using combine, which
+// must be idempotent (see the type doc).
+func NewSparseTable[T any](data []T, combine func(a, b T) T) *SparseTable[T] {
+	n := len(data)
+	st := &SparseTable[T]{combine: combine, log: make([]int, n+1)}
+	for i := 2; i <= n; i++ {
+		st.log[i] = st.log[i/2] + 1
+	}
+
+	levels := st.log[n] + 1
+	if n == 0 {
+		levels = 0
+	}
+	st.table = make([][]T, levels)
+	if levels > 0 {
+		st.table[0] = append([]T{}, data...)
+	}
+	for k := 1; k < levels; k++ {
+		half := 1 << (k - 1)
+		size := n - (1 << k) + 1
+		st.table[k] = make([]T, size)
+		for i := 0; i < size; i++ {
+			st.table[k][i] = combine(st.table[k-1][i], st.table[k-1][i+half])
+		}
+	}
+	return st
+}
+
+// Query returns combine applied across data[l:r+1] (inclusive), in
+// O(1). l and r must be in range and l <= r.
+func (st *SparseTable[T]) Query(l, r int) T {
+	k := st.log[r-l+1]
+	return st.combine(st.table[k][l], st.table[k][r-(1<<k)+1])
+}
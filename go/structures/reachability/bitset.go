@@ -0,0 +1,27 @@
+package reachability
+
+// bitset is a fixed-size bit vector used to represent one node's set of
+// reachable nodes.
+type bitset []uint64
+
+func bitsetWords(n int) int {
+	return (n + 63) / 64
+}
+
+func newBitset(words int) bitset {
+	return make(bitset, words)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (uint(i) % 64)
+}
+
+func (b bitset) test(i int) bool {
+	return b[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+func (b bitset) orWith(other bitset) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
@@ -0,0 +1,120 @@
+package reachability
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func bruteForceReachable(n int, adj [][]int, u, v int) bool {
+	if u == v {
+		return true
+	}
+	visited := make([]bool, n)
+	queue := []int{u}
+	visited[u] = true
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, w := range adj[cur] {
+			if w == v {
+				return true
+			}
+			if !visited[w] {
+				visited[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+	return false
+}
+
+func TestReachableSimpleChain(t *testing.T) {
+	// 0 -> 1 -> 2 -> 3
+	adj := [][]int{{1}, {2}, {3}, {}}
+	idx, err := New(4, adj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if !idx.Reachable(0, 3) {
+		t.Error("Reachable(0, 3) = false, want true")
+	}
+	if idx.Reachable(3, 0) {
+		t.Error("Reachable(3, 0) = true, want false")
+	}
+	if !idx.Reachable(1, 1) {
+		t.Error("Reachable(1, 1) = false, want true (every node reaches itself)")
+	}
+}
+
+func TestReachableBranching(t *testing.T) {
+	// 0 -> 1, 0 -> 2, 1 -> 3, 2 -> 3, 3 -> 4
+	adj := [][]int{{1, 2}, {3}, {3}, {4}, {}}
+	idx, err := New(5, adj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	tests := []struct {
+		u, v int
+		want bool
+	}{
+		{0, 4, true},
+		{1, 2, false},
+		{2, 1, false},
+		{1, 4, true},
+		{4, 0, false},
+	}
+	for _, tt := range tests {
+		if got := idx.Reachable(tt.u, tt.v); got != tt.want {
+			t.Errorf("Reachable(%d, %d) = %v, want %v", tt.u, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestNewRejectsCycle(t *testing.T) {
+	adj := [][]int{{1}, {2}, {0}}
+	if _, err := New(3, adj); err == nil {
+		t.Error("New on a cyclic graph returned no error, want one")
+	}
+}
+
+func TestReachableAgainstBruteForceRandomDAG(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	n := 80
+	adj := make([][]int, n)
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if r.Intn(5) == 0 {
+				adj[u] = append(adj[u], v)
+			}
+		}
+	}
+	idx, err := New(n, adj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if got, want := idx.Reachable(u, v), bruteForceReachable(n, adj, u, v); got != want {
+				t.Fatalf("Reachable(%d, %d) = %v, want %v", u, v, got, want)
+			}
+		}
+	}
+}
+
+func TestReachableFrom(t *testing.T) {
+	adj := [][]int{{1, 2}, {3}, {3}, {}}
+	idx, err := New(4, adj)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	got := idx.ReachableFrom(0)
+	want := []int{0, 1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("ReachableFrom(0) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ReachableFrom(0) = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Package rectanglearea computes the total area and perimeter of the
+// union of a set of axis-aligned rectangles, via a coordinate-compressed
+// sweep line driving a counting segment tree over the compressed
+// y-coordinates — the classic "Rectangle Area II" technique, extended to
+// also report the union's perimeter.
+package rectanglearea
+
+import "sort"
+
+// Rectangle is an axis-aligned rectangle spanning [X1, X2) x [Y1, Y2).
+// Rectangles with X1 >= X2 or Y1 >= Y2 are degenerate and contribute
+// nothing to Area or Perimeter.
+type Rectangle struct {
+	X1, Y1, X2, Y2 int
+}
+
+func degenerate(r Rectangle) bool {
+	return r.X1 >= r.X2 || r.Y1 >= r.Y2
+}
+
+// Area returns the total area covered by the union of rects, counting
+// overlapping regions only once.
+func Area(rects []Rectangle) int64 {
+	rects = withoutDegenerate(rects)
+	if len(rects) == 0 {
+		return 0
+	}
+	ys := compressY(rects)
+	tree := newCountSegTree(ys)
+	events := buildEvents(rects, ys)
+
+	var area int64
+	for i := 0; i < len(events); {
+		x := events[i].x
+		for i < len(events) && events[i].x == x {
+			tree.update(events[i].loIdx, events[i].hiIdx-1, events[i].delta)
+			i++
+		}
+		if i < len(events) {
+			width := int64(events[i].x - x)
+			area += width * int64(tree.coveredLength())
+		}
+	}
+	return area
+}
+
+// Perimeter returns the total perimeter of the outline traced by the
+// union of rects — the length of the boundary between covered and
+// uncovered regions, counted once even where rectangles overlap.
+//
+// It's computed as two independent edge sweeps: one sweeping x to total
+// up the vertical edges (wherever the covered y-length changes as the
+// sweep line crosses a rectangle's left or right edge, that change is
+// exactly the length of newly exposed or newly hidden vertical boundary),
+// and one doing the same after swapping the x and y axes to total up the
+// horizontal edges.
+func Perimeter(rects []Rectangle) int64 {
+	rects = withoutDegenerate(rects)
+	return edgeLength(rects) + edgeLength(swapXY(rects))
+}
+
+func withoutDegenerate(rects []Rectangle) []Rectangle {
+	out := make([]Rectangle, 0, len(rects))
+	for _, r := range rects {
+		if !degenerate(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func swapXY(rects []Rectangle) []Rectangle {
+	out := make([]Rectangle, len(rects))
+	for i, r := range rects {
+		out[i] = Rectangle{X1: r.Y1, Y1: r.X1, X2: r.Y2, Y2: r.X2}
+	}
+	return out
+}
+
+func edgeLength(rects []Rectangle) int64 {
+	if len(rects) == 0 {
+		return 0
+	}
+	ys := compressY(rects)
+	tree := newCountSegTree(ys)
+	events := buildEvents(rects, ys)
+
+	var perimeter int64
+	prevCovered := 0
+	for i := 0; i < len(events); {
+		x := events[i].x
+		for i < len(events) && events[i].x == x {
+			tree.update(events[i].loIdx, events[i].hiIdx-1, events[i].delta)
+			i++
+		}
+		covered := tree.coveredLength()
+		diff := covered - prevCovered
+		if diff < 0 {
+			diff = -diff
+		}
+		perimeter += int64(diff)
+		prevCovered = covered
+	}
+	return perimeter
+}
+
+// event is a sweep-line event at x: the covered range [loIdx, hiIdx) of
+// compressed y-indices should have delta added to its open-interval
+// count.
+type event struct {
+	x            int
+	loIdx, hiIdx int
+	delta        int
+}
+
+// compressY returns the sorted, de-duplicated set of every rectangle's Y1
+// and Y2, which the segment tree's leaves are built over.
+func compressY(rects []Rectangle) []int {
+	seen := make(map[int]bool, 2*len(rects))
+	ys := make([]int, 0, 2*len(rects))
+	for _, r := range rects {
+		if !seen[r.Y1] {
+			seen[r.Y1] = true
+			ys = append(ys, r.Y1)
+		}
+		if !seen[r.Y2] {
+			seen[r.Y2] = true
+			ys = append(ys, r.Y2)
+		}
+	}
+	sort.Ints(ys)
+	return ys
+}
+
+func buildEvents(rects []Rectangle, ys []int) []event {
+	events := make([]event, 0, 2*len(rects))
+	for _, r := range rects {
+		lo := indexOf(ys, r.Y1)
+		hi := indexOf(ys, r.Y2)
+		events = append(events,
+			event{x: r.X1, loIdx: lo, hiIdx: hi, delta: 1},
+			event{x: r.X2, loIdx: lo, hiIdx: hi, delta: -1},
+		)
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].x < events[j].x })
+	return events
+}
+
+func indexOf(ys []int, y int) int {
+	return sort.SearchInts(ys, y)
+}
@@ -0,0 +1,117 @@
+package rectanglearea
+
+import "testing"
+
+func bruteForceArea(rects []Rectangle) int64 {
+	minX, maxX, minY, maxY := 0, 0, 0, 0
+	first := true
+	for _, r := range rects {
+		if degenerate(r) {
+			continue
+		}
+		if first {
+			minX, maxX, minY, maxY = r.X1, r.X2, r.Y1, r.Y2
+			first = false
+			continue
+		}
+		minX = min(minX, r.X1)
+		maxX = max(maxX, r.X2)
+		minY = min(minY, r.Y1)
+		maxY = max(maxY, r.Y2)
+	}
+	if first {
+		return 0
+	}
+	var area int64
+	for x := minX; x < maxX; x++ {
+		for y := minY; y < maxY; y++ {
+			for _, r := range rects {
+				if x >= r.X1 && x < r.X2 && y >= r.Y1 && y < r.Y2 {
+					area++
+					break
+				}
+			}
+		}
+	}
+	return area
+}
+
+func TestAreaSingleRectangle(t *testing.T) {
+	rects := []Rectangle{{0, 0, 4, 3}}
+	if got, want := Area(rects), int64(12); got != want {
+		t.Errorf("Area(%v) = %d, want %d", rects, got, want)
+	}
+}
+
+func TestAreaNonOverlapping(t *testing.T) {
+	rects := []Rectangle{{0, 0, 2, 2}, {5, 5, 7, 7}}
+	if got, want := Area(rects), int64(8); got != want {
+		t.Errorf("Area(%v) = %d, want %d", rects, got, want)
+	}
+}
+
+func TestAreaOverlapping(t *testing.T) {
+	// Two 4x4 squares overlapping in a 2x2 region.
+	rects := []Rectangle{{0, 0, 4, 4}, {2, 2, 6, 6}}
+	if got, want := Area(rects), int64(28); got != want {
+		t.Errorf("Area(%v) = %d, want %d", rects, got, want)
+	}
+}
+
+func TestAreaAgainstBruteForce(t *testing.T) {
+	cases := [][]Rectangle{
+		{{0, 0, 3, 3}, {1, 1, 4, 4}, {2, 0, 5, 2}},
+		{{0, 0, 5, 5}, {1, 1, 2, 2}, {3, 3, 4, 4}},
+		{{0, 0, 1, 10}, {0, 0, 10, 1}, {5, 5, 6, 6}},
+		{},
+		{{2, 2, 2, 5}}, // degenerate: zero width
+	}
+	for _, rects := range cases {
+		if got, want := Area(rects), bruteForceArea(rects); got != want {
+			t.Errorf("Area(%v) = %d, want %d", rects, got, want)
+		}
+	}
+}
+
+func TestPerimeterSingleRectangle(t *testing.T) {
+	rects := []Rectangle{{0, 0, 4, 3}}
+	if got, want := Perimeter(rects), int64(14); got != want {
+		t.Errorf("Perimeter(%v) = %d, want %d", rects, got, want)
+	}
+}
+
+func TestPerimeterNonOverlapping(t *testing.T) {
+	// Two separate 2x2 squares: perimeters just add.
+	rects := []Rectangle{{0, 0, 2, 2}, {5, 5, 7, 7}}
+	if got, want := Perimeter(rects), int64(16); got != want {
+		t.Errorf("Perimeter(%v) = %d, want %d", rects, got, want)
+	}
+}
+
+func TestPerimeterIdenticalRectanglesMatchesOne(t *testing.T) {
+	// A rectangle union with itself has the same perimeter as the
+	// rectangle alone.
+	rects := []Rectangle{{0, 0, 4, 3}, {0, 0, 4, 3}}
+	if got, want := Perimeter(rects), Perimeter([]Rectangle{{0, 0, 4, 3}}); got != want {
+		t.Errorf("Perimeter of a rectangle unioned with itself = %d, want %d", got, want)
+	}
+}
+
+func TestPerimeterOverlappingLShape(t *testing.T) {
+	// An L-shape formed by two overlapping rectangles, where the
+	// overlap region's internal edges shouldn't be counted.
+	rects := []Rectangle{{0, 0, 4, 2}, {0, 0, 2, 4}}
+	// Outline: (0,0)->(4,0)->(4,2)->(2,2)->(2,4)->(0,4)->(0,0)
+	if got, want := Perimeter(rects), int64(4+2+2+2+2+4); got != want {
+		t.Errorf("Perimeter(%v) = %d, want %d", rects, got, want)
+	}
+}
+
+func TestEmptyInput(t *testing.T) {
+	if got := Area(nil); got != 0 {
+		t.Errorf("Area(nil) = %d, want 0", got)
+	}
+	if got := Perimeter(nil); got != 0 {
+		t.Errorf("Perimeter(nil) = %d, want 0", got)
+	}
+}
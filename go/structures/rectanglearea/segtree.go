@@ -0,0 +1,75 @@
+package rectanglearea
+
+// countSegTree is a segment tree over the m = len(ys)-1 elementary
+// y-intervals [ys[i], ys[i+1]) that the compressed coordinates carve the
+// y-axis into. Each node tracks cnt, the number of open rectangle
+// y-ranges that were added at exactly that node (i.e. fully cover its
+// range without being pushed down to its children), and length, the
+// total covered length within the node's range given every update
+// applied so far.
+//
+// A node with cnt > 0 is fully covered regardless of what its children
+// say, so its length is just its full width. A node with cnt == 0 is
+// covered only where its children say it is, so its length is the sum of
+// its children's lengths. Because updates only ever increment cnt on
+// nodes whose range is fully contained in the update range, no lazy
+// push-down is needed: this invariant is maintained purely bottom-up.
+type countSegTree struct {
+	ys     []int
+	cnt    []int
+	length []int
+	m      int
+}
+
+func newCountSegTree(ys []int) *countSegTree {
+	m := len(ys) - 1
+	if m < 1 {
+		m = 1
+	}
+	return &countSegTree{
+		ys:     ys,
+		cnt:    make([]int, 4*m),
+		length: make([]int, 4*m),
+		m:      m,
+	}
+}
+
+// update adds delta to the open-interval count of leaf range [lo, hi]
+// (inclusive leaf indices into the elementary intervals).
+func (t *countSegTree) update(lo, hi, delta int) {
+	if lo > hi {
+		return
+	}
+	t.update_(1, 0, t.m-1, lo, hi, delta)
+}
+
+func (t *countSegTree) update_(node, nodeLo, nodeHi, lo, hi, delta int) {
+	if hi < nodeLo || nodeHi < lo {
+		return
+	}
+	if lo <= nodeLo && nodeHi <= hi {
+		t.cnt[node] += delta
+	} else {
+		mid := (nodeLo + nodeHi) / 2
+		t.update_(2*node, nodeLo, mid, lo, hi, delta)
+		t.update_(2*node+1, mid+1, nodeHi, lo, hi, delta)
+	}
+	t.pull(node, nodeLo, nodeHi)
+}
+
+func (t *countSegTree) pull(node, nodeLo, nodeHi int) {
+	switch {
+	case t.cnt[node] > 0:
+		t.length[node] = t.ys[nodeHi+1] - t.ys[nodeLo]
+	case nodeLo == nodeHi:
+		t.length[node] = 0
+	default:
+		t.length[node] = t.length[2*node] + t.length[2*node+1]
+	}
+}
+
+// coveredLength returns the total covered length across the whole
+// y-axis, given every update applied so far.
+func (t *countSegTree) coveredLength() int {
+	return t.length[1]
+}
@@ -0,0 +1,135 @@
+// Package rollingstats maintains min, max, mean, and variance over the
+// last k pushed values in O(1) amortized time per push, for streaming
+// analytics where recomputing every statistic from scratch on each new
+// sample would be too slow.
+//
+// Min and max are tracked with monotonic deques, the same technique
+// rangequery.SlidingWindowMin/Max use for a static array, adapted to a
+// live stream. Mean and variance use Welford's online algorithm, plus
+// its (exact) reverse update for the value that falls out of the
+// window, so neither statistic ever needs to rescan the window.
+package rollingstats
+
+// RollingStats tracks statistics over the most recent K pushed values.
+type RollingStats struct {
+	k      int
+	seq    int64
+	window []float64
+	n      int
+
+	mean, m2 float64
+
+	minDeque []point
+	maxDeque []point
+}
+
+type point struct {
+	seq int64
+	val float64
+}
+
+// New creates a RollingStats over a window of the last k values. k must
+// be positive.
+func New(k int) *RollingStats {
+	if k <= 0 {
+		panic("rollingstats: k must be positive")
+	}
+	return &RollingStats{k: k, window: make([]float64, k)}
+}
+
+// Len returns the number of values currently in the window (less than
+// k until the window has filled up for the first time).
+func (r *RollingStats) Len() int {
+	return r.n
+}
+
+// Push adds x to the stream, evicting the oldest value once the window
+// already holds k elements.
+func (r *RollingStats) Push(x float64) {
+	if r.n == r.k {
+		evictSeq := r.seq - int64(r.k)
+		r.evictWelford(r.window[evictSeq%int64(r.k)])
+		if len(r.minDeque) > 0 && r.minDeque[0].seq == evictSeq {
+			r.minDeque = r.minDeque[1:]
+		}
+		if len(r.maxDeque) > 0 && r.maxDeque[0].seq == evictSeq {
+			r.maxDeque = r.maxDeque[1:]
+		}
+		r.n--
+	}
+
+	r.window[r.seq%int64(r.k)] = x
+	r.addWelford(x)
+
+	for len(r.minDeque) > 0 && r.minDeque[len(r.minDeque)-1].val >= x {
+		r.minDeque = r.minDeque[:len(r.minDeque)-1]
+	}
+	r.minDeque = append(r.minDeque, point{seq: r.seq, val: x})
+
+	for len(r.maxDeque) > 0 && r.maxDeque[len(r.maxDeque)-1].val <= x {
+		r.maxDeque = r.maxDeque[:len(r.maxDeque)-1]
+	}
+	r.maxDeque = append(r.maxDeque, point{seq: r.seq, val: x})
+
+	r.n++
+	r.seq++
+}
+
+// Min returns the smallest value in the window. It reports false if the
+// window is empty.
+func (r *RollingStats) Min() (float64, bool) {
+	if r.n == 0 {
+		return 0, false
+	}
+	return r.minDeque[0].val, true
+}
+
+// Max returns the largest value in the window. It reports false if the
+// window is empty.
+func (r *RollingStats) Max() (float64, bool) {
+	if r.n == 0 {
+		return 0, false
+	}
+	return r.maxDeque[0].val, true
+}
+
+// Mean returns the arithmetic mean of the window. It reports false if
+// the window is empty.
+func (r *RollingStats) Mean() (float64, bool) {
+	if r.n == 0 {
+		return 0, false
+	}
+	return r.mean, true
+}
+
+// Variance returns the population variance of the window. It reports
+// false if the window is empty.
+func (r *RollingStats) Variance() (float64, bool) {
+	if r.n == 0 {
+		return 0, false
+	}
+	return r.m2 / float64(r.n), true
+}
+
+// addWelford incorporates a new value into the running mean/variance.
+func (r *RollingStats) addWelford(x float64) {
+	n := r.n + 1
+	delta := x - r.mean
+	r.mean += delta / float64(n)
+	delta2 := x - r.mean
+	r.m2 += delta * delta2
+}
+
+// evictWelford exactly reverses addWelford for a value leaving the
+// window, since Welford's update is invertible given the current n.
+func (r *RollingStats) evictWelford(x float64) {
+	n := r.n
+	delta := x - r.mean
+	if n <= 1 {
+		r.mean, r.m2 = 0, 0
+		return
+	}
+	r.mean = (r.mean*float64(n) - x) / float64(n-1)
+	delta2 := x - r.mean
+	r.m2 -= delta * delta2
+}
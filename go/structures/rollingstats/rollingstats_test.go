@@ -0,0 +1,100 @@
+package rollingstats
+
+import (
+	"math"
+	"testing"
+)
+
+func bruteForceStats(window []float64) (min, max, mean, variance float64) {
+	min, max = window[0], window[0]
+	var sum float64
+	for _, v := range window {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+		sum += v
+	}
+	mean = sum / float64(len(window))
+	var sqDiff float64
+	for _, v := range window {
+		d := v - mean
+		sqDiff += d * d
+	}
+	variance = sqDiff / float64(len(window))
+	return
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestRollingStatsAgainstBruteForce(t *testing.T) {
+	stream := []float64{4, 8, 15, 16, 23, 42, 1, 9, 7, 3}
+	const k = 4
+
+	rs := New(k)
+	for i, x := range stream {
+		rs.Push(x)
+
+		start := 0
+		if i-k+1 > 0 {
+			start = i - k + 1
+		}
+		window := stream[start : i+1]
+
+		wantMin, wantMax, wantMean, wantVar := bruteForceStats(window)
+
+		if got, ok := rs.Min(); !ok || got != wantMin {
+			t.Fatalf("step %d: Min() = (%v, %v), want (%v, true)", i, got, ok, wantMin)
+		}
+		if got, ok := rs.Max(); !ok || got != wantMax {
+			t.Fatalf("step %d: Max() = (%v, %v), want (%v, true)", i, got, ok, wantMax)
+		}
+		if got, ok := rs.Mean(); !ok || !almostEqual(got, wantMean) {
+			t.Fatalf("step %d: Mean() = (%v, %v), want (%v, true)", i, got, ok, wantMean)
+		}
+		if got, ok := rs.Variance(); !ok || !almostEqual(got, wantVar) {
+			t.Fatalf("step %d: Variance() = (%v, %v), want (%v, true)", i, got, ok, wantVar)
+		}
+	}
+}
+
+func TestRollingStatsEmptyWindow(t *testing.T) {
+	rs := New(3)
+	if _, ok := rs.Min(); ok {
+		t.Errorf("Min() on empty window reported a value")
+	}
+	if _, ok := rs.Max(); ok {
+		t.Errorf("Max() on empty window reported a value")
+	}
+	if _, ok := rs.Mean(); ok {
+		t.Errorf("Mean() on empty window reported a value")
+	}
+	if _, ok := rs.Variance(); ok {
+		t.Errorf("Variance() on empty window reported a value")
+	}
+}
+
+func TestRollingStatsSingleElement(t *testing.T) {
+	rs := New(1)
+	rs.Push(5)
+	if got, ok := rs.Variance(); !ok || got != 0 {
+		t.Errorf("Variance() with a single element = (%v, %v), want (0, true)", got, ok)
+	}
+	rs.Push(10)
+	if got, ok := rs.Mean(); !ok || got != 10 {
+		t.Errorf("Mean() after evicting the only element = (%v, %v), want (10, true)", got, ok)
+	}
+}
+
+func TestRollingStatsPanicsOnNonPositiveK(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("New(0) did not panic")
+		}
+	}()
+	New(0)
+}
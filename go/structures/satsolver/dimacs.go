@@ -0,0 +1,58 @@
+package satsolver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseDIMACS parses a CNF formula in DIMACS format: comment lines start
+// with 'c', the problem line is "p cnf <numVars> <numClauses>", and each
+// subsequent clause is a whitespace-separated list of literals
+// terminated by 0.
+func ParseDIMACS(r io.Reader) (*Formula, error) {
+	f := &Formula{}
+	scanner := bufio.NewScanner(r)
+	var current []int
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "c") {
+			continue
+		}
+		if strings.HasPrefix(line, "p") {
+			fields := strings.Fields(line)
+			if len(fields) < 4 || fields[1] != "cnf" {
+				return nil, fmt.Errorf("satsolver: malformed problem line %q", line)
+			}
+			n, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return nil, fmt.Errorf("satsolver: malformed variable count in %q: %w", line, err)
+			}
+			f.NumVars = n
+			continue
+		}
+
+		for _, tok := range strings.Fields(line) {
+			lit, err := strconv.Atoi(tok)
+			if err != nil {
+				return nil, fmt.Errorf("satsolver: malformed literal %q: %w", tok, err)
+			}
+			if lit == 0 {
+				f.Clauses = append(f.Clauses, current)
+				current = nil
+				continue
+			}
+			current = append(current, lit)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(current) > 0 {
+		f.Clauses = append(f.Clauses, current)
+	}
+	return f, nil
+}
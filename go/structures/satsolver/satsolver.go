@@ -0,0 +1,228 @@
+// Package satsolver implements DPLL: a backtracking SAT solver over CNF
+// formulas in DIMACS form, using unit propagation and pure-literal
+// elimination to prune the search before it branches.
+//
+// Full CDCL (conflict-driven clause learning) is out of scope here —
+// it needs a watched-literal clause database and a learned-clause store
+// that would roughly double this package's size for a problem size this
+// repo's other search structures don't otherwise need to solve.
+package satsolver
+
+import "fmt"
+
+// Formula is a CNF formula: a conjunction of Clauses over variables
+// numbered 1..NumVars. Within a Clause, a literal is a variable number
+// (the variable itself) or its negation (-variable); 0 never appears.
+type Formula struct {
+	NumVars int
+	Clauses [][]int
+}
+
+// Model maps a variable number to the truth value DPLL assigned it.
+type Model map[int]bool
+
+// Solve runs DPLL over f and returns a satisfying Model and true, or a
+// nil Model and false if f is unsatisfiable.
+func Solve(f *Formula) (Model, bool) {
+	clauses := cloneClauses(f.Clauses)
+	assignment := Model{}
+	result, ok := dpll(clauses, assignment)
+	if !ok {
+		return nil, false
+	}
+	// Variables that never appeared in any clause are unconstrained;
+	// report them as true so every variable 1..NumVars has a value.
+	for v := 1; v <= f.NumVars; v++ {
+		if _, assigned := result[v]; !assigned {
+			result[v] = true
+		}
+	}
+	return result, true
+}
+
+func dpll(clauses [][]int, assignment Model) (Model, bool) {
+	clauses, assignment, ok := unitPropagate(clauses, assignment)
+	if !ok {
+		return nil, false
+	}
+	clauses, assignment = eliminatePureLiterals(clauses, assignment)
+
+	if len(clauses) == 0 {
+		return assignment, true
+	}
+	for _, c := range clauses {
+		if len(c) == 0 {
+			return nil, false
+		}
+	}
+
+	v := chooseVariable(clauses)
+	for _, val := range [2]bool{true, false} {
+		lit := v
+		if !val {
+			lit = -v
+		}
+		nextClauses, nextAssignment, ok := assign(clauses, cloneAssignment(assignment), lit)
+		if !ok {
+			continue
+		}
+		if result, ok := dpll(nextClauses, nextAssignment); ok {
+			return result, true
+		}
+	}
+	return nil, false
+}
+
+// unitPropagate repeatedly finds a unit clause (exactly one literal) and
+// assigns it, until none remain or a conflict is found.
+func unitPropagate(clauses [][]int, assignment Model) ([][]int, Model, bool) {
+	for {
+		unit := 0
+		for _, c := range clauses {
+			if len(c) == 1 {
+				unit = c[0]
+				break
+			}
+		}
+		if unit == 0 {
+			return clauses, assignment, true
+		}
+		var ok bool
+		clauses, assignment, ok = assign(clauses, assignment, unit)
+		if !ok {
+			return nil, nil, false
+		}
+	}
+}
+
+// eliminatePureLiterals assigns every variable that appears in only one
+// polarity across all remaining clauses, since such a variable can
+// always be set to satisfy every clause it appears in.
+func eliminatePureLiterals(clauses [][]int, assignment Model) ([][]int, Model) {
+	for {
+		polarity := map[int]int{} // var -> +1 (only positive seen), -1 (only negative), 0 (both)
+		for _, c := range clauses {
+			for _, lit := range c {
+				v := lit
+				sign := 1
+				if v < 0 {
+					v, sign = -v, -1
+				}
+				switch polarity[v] {
+				case 0:
+					polarity[v] = sign
+				default:
+					if polarity[v] != sign {
+						polarity[v] = 2 // marker for "both seen"
+					}
+				}
+			}
+		}
+
+		pure := 0
+		for v, p := range polarity {
+			if p == 1 || p == -1 {
+				pure = v * p
+				break
+			}
+		}
+		if pure == 0 {
+			return clauses, assignment
+		}
+		clauses, assignment, _ = assign(clauses, assignment, pure)
+	}
+}
+
+// assign records lit as satisfied: clauses containing lit are dropped
+// (already satisfied), and -lit is removed from the clauses that
+// contain it. It reports false if doing so produces an empty clause.
+func assign(clauses [][]int, assignment Model, lit int) ([][]int, Model, bool) {
+	v, val := lit, true
+	if v < 0 {
+		v, val = -v, false
+	}
+	assignment[v] = val
+
+	var out [][]int
+	for _, c := range clauses {
+		if containsLiteral(c, lit) {
+			continue // clause satisfied
+		}
+		nc := removeLiteral(c, -lit)
+		if len(nc) == 0 {
+			return nil, assignment, false
+		}
+		out = append(out, nc)
+	}
+	return out, assignment, true
+}
+
+func containsLiteral(c []int, lit int) bool {
+	for _, x := range c {
+		if x == lit {
+			return true
+		}
+	}
+	return false
+}
+
+func removeLiteral(c []int, lit int) []int {
+	out := make([]int, 0, len(c))
+	for _, x := range c {
+		if x != lit {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+// chooseVariable picks the variable of the first literal in the first
+// (necessarily non-unit, non-empty) remaining clause.
+func chooseVariable(clauses [][]int) int {
+	lit := clauses[0][0]
+	if lit < 0 {
+		return -lit
+	}
+	return lit
+}
+
+func cloneClauses(clauses [][]int) [][]int {
+	out := make([][]int, len(clauses))
+	for i, c := range clauses {
+		out[i] = append([]int{}, c...)
+	}
+	return out
+}
+
+func cloneAssignment(a Model) Model {
+	out := make(Model, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+	return out
+}
+
+// Verify reports whether model satisfies every clause of f.
+func Verify(f *Formula, model Model) bool {
+	for _, c := range f.Clauses {
+		satisfied := false
+		for _, lit := range c {
+			v, want := lit, true
+			if v < 0 {
+				v, want = -v, false
+			}
+			if model[v] == want {
+				satisfied = true
+				break
+			}
+		}
+		if !satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *Formula) String() string {
+	return fmt.Sprintf("Formula{NumVars: %d, Clauses: %d}", f.NumVars, len(f.Clauses))
+}
@@ -0,0 +1,145 @@
+package satsolver
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSolveSatisfiable(t *testing.T) {
+	// (x1 OR x2) AND (NOT x1 OR x2) AND (NOT x2 OR x3)
+	f := &Formula{
+		NumVars: 3,
+		Clauses: [][]int{
+			{1, 2},
+			{-1, 2},
+			{-2, 3},
+		},
+	}
+	model, ok := Solve(f)
+	if !ok {
+		t.Fatal("Solve() reported UNSAT for a satisfiable formula")
+	}
+	if !Verify(f, model) {
+		t.Errorf("Verify() rejected the model %v returned by Solve()", model)
+	}
+}
+
+func TestSolveUnsatisfiable(t *testing.T) {
+	// x1 AND NOT x1
+	f := &Formula{
+		NumVars: 1,
+		Clauses: [][]int{{1}, {-1}},
+	}
+	if _, ok := Solve(f); ok {
+		t.Errorf("Solve() reported SAT for an unsatisfiable formula")
+	}
+}
+
+func TestSolveUnitPropagationChain(t *testing.T) {
+	// x1, x1->x2, x2->x3 forces x1=x2=x3=true via unit propagation alone.
+	f := &Formula{
+		NumVars: 3,
+		Clauses: [][]int{
+			{1},
+			{-1, 2},
+			{-2, 3},
+		},
+	}
+	model, ok := Solve(f)
+	if !ok {
+		t.Fatal("Solve() reported UNSAT")
+	}
+	if !model[1] || !model[2] || !model[3] {
+		t.Errorf("model = %v, want all of x1, x2, x3 true", model)
+	}
+}
+
+func TestSolvePureLiteral(t *testing.T) {
+	// x2 never appears negated, so pure-literal elimination should set
+	// it true; that alone satisfies both clauses.
+	f := &Formula{
+		NumVars: 2,
+		Clauses: [][]int{
+			{1, 2},
+			{-1, 2},
+		},
+	}
+	model, ok := Solve(f)
+	if !ok {
+		t.Fatal("Solve() reported UNSAT")
+	}
+	if !model[2] {
+		t.Errorf("model = %v, want x2 true", model)
+	}
+	if !Verify(f, model) {
+		t.Errorf("Verify() rejected the model %v", model)
+	}
+}
+
+func TestSolveRequiresBranching(t *testing.T) {
+	// "exactly one of x1, x2, x3": at-least-one plus pairwise
+	// at-most-one. No unit clauses and every variable appears both
+	// positively and negatively, so neither unit propagation nor
+	// pure-literal elimination can resolve it without branching.
+	f := &Formula{
+		NumVars: 3,
+		Clauses: [][]int{
+			{1, 2, 3},
+			{-1, -2},
+			{-2, -3},
+			{-1, -3},
+		},
+	}
+	model, ok := Solve(f)
+	if !ok {
+		t.Fatal("Solve() reported UNSAT for a satisfiable formula")
+	}
+	if !Verify(f, model) {
+		t.Errorf("Verify() rejected the model %v", model)
+	}
+}
+
+func TestParseDIMACS(t *testing.T) {
+	input := `c a comment line
+p cnf 3 2
+1 2 0
+-1 2 -3 0
+`
+	f, err := ParseDIMACS(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDIMACS() error = %v", err)
+	}
+	if f.NumVars != 3 {
+		t.Errorf("NumVars = %d, want 3", f.NumVars)
+	}
+	want := [][]int{{1, 2}, {-1, 2, -3}}
+	if len(f.Clauses) != len(want) {
+		t.Fatalf("Clauses = %v, want %v", f.Clauses, want)
+	}
+	for i := range want {
+		if len(f.Clauses[i]) != len(want[i]) {
+			t.Errorf("Clauses[%d] = %v, want %v", i, f.Clauses[i], want[i])
+			continue
+		}
+		for j := range want[i] {
+			if f.Clauses[i][j] != want[i][j] {
+				t.Errorf("Clauses[%d][%d] = %d, want %d", i, j, f.Clauses[i][j], want[i][j])
+			}
+		}
+	}
+}
+
+func TestParseDIMACSAndSolve(t *testing.T) {
+	input := "p cnf 2 2\n1 2 0\n-1 -2 0\n"
+	f, err := ParseDIMACS(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseDIMACS() error = %v", err)
+	}
+	model, ok := Solve(f)
+	if !ok {
+		t.Fatal("Solve() reported UNSAT for a satisfiable formula")
+	}
+	if !Verify(f, model) {
+		t.Errorf("Verify() rejected the model %v", model)
+	}
+}
@@ -0,0 +1,149 @@
+package scheduling
+
+import "sort"
+
+// Process is a CPU task that arrives at Arrival and needs Burst units
+// of CPU time to finish.
+type Process struct {
+	ID      int
+	Arrival int
+	Burst   int
+}
+
+// Metrics reports, for one process, how long it waited before first
+// running and its total turnaround time (completion minus arrival).
+type Metrics struct {
+	ID         int
+	Completion int
+	Waiting    int
+	Turnaround int
+}
+
+func sortedByArrival(processes []Process) []Process {
+	sorted := append([]Process{}, processes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Arrival < sorted[j].Arrival
+	})
+	return sorted
+}
+
+func finish(p Process, completion int) Metrics {
+	turnaround := completion - p.Arrival
+	return Metrics{
+		ID:         p.ID,
+		Completion: completion,
+		Turnaround: turnaround,
+		Waiting:    turnaround - p.Burst,
+	}
+}
+
+// FCFS simulates first-come-first-served scheduling: processes run in
+// order of arrival, each running to completion before the next starts.
+func FCFS(processes []Process) []Metrics {
+	sorted := sortedByArrival(processes)
+	metrics := make([]Metrics, len(sorted))
+	clock := 0
+	for i, p := range sorted {
+		if clock < p.Arrival {
+			clock = p.Arrival
+		}
+		clock += p.Burst
+		metrics[i] = finish(p, clock)
+	}
+	return metrics
+}
+
+// SJF simulates non-preemptive shortest-job-first scheduling: whenever
+// the CPU is free, it picks the shortest-burst process among those that
+// have already arrived, breaking ties by arrival order.
+func SJF(processes []Process) []Metrics {
+	remaining := sortedByArrival(processes)
+	metrics := make([]Metrics, 0, len(remaining))
+	clock := 0
+
+	for len(remaining) > 0 {
+		best := -1
+		for i, p := range remaining {
+			if p.Arrival > clock {
+				continue
+			}
+			if best == -1 || p.Burst < remaining[best].Burst {
+				best = i
+			}
+		}
+		if best == -1 {
+			// Nothing has arrived yet; jump to the next arrival.
+			clock = remaining[0].Arrival
+			continue
+		}
+		p := remaining[best]
+		clock += p.Burst
+		metrics = append(metrics, finish(p, clock))
+		remaining = append(remaining[:best], remaining[best+1:]...)
+	}
+	return metrics
+}
+
+// RoundRobin simulates round-robin scheduling with the given time
+// quantum: each process runs for at most quantum units before the CPU
+// moves to the next ready process, cycling until every process
+// finishes. quantum must be positive.
+func RoundRobin(processes []Process, quantum int) []Metrics {
+	if quantum <= 0 {
+		panic("scheduling: RoundRobin requires a positive quantum")
+	}
+
+	sorted := sortedByArrival(processes)
+	remainingBurst := make(map[int]int, len(sorted))
+	for _, p := range sorted {
+		remainingBurst[p.ID] = p.Burst
+	}
+
+	metrics := make(map[int]Metrics, len(sorted))
+	var queue []Process
+	clock := 0
+	next := 0 // index into sorted of the next process still to arrive
+
+	enqueueArrivals := func() {
+		for next < len(sorted) && sorted[next].Arrival <= clock {
+			queue = append(queue, sorted[next])
+			next++
+		}
+	}
+
+	if len(sorted) > 0 {
+		clock = sorted[0].Arrival
+	}
+	enqueueArrivals()
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		run := remainingBurst[p.ID]
+		if run > quantum {
+			run = quantum
+		}
+		clock += run
+		remainingBurst[p.ID] -= run
+
+		enqueueArrivals()
+
+		if remainingBurst[p.ID] == 0 {
+			metrics[p.ID] = finish(p, clock)
+		} else {
+			queue = append(queue, p)
+		}
+
+		if len(queue) == 0 && next < len(sorted) {
+			clock = sorted[next].Arrival
+			enqueueArrivals()
+		}
+	}
+
+	ordered := make([]Metrics, len(sorted))
+	for i, p := range sorted {
+		ordered[i] = metrics[p.ID]
+	}
+	return ordered
+}
@@ -0,0 +1,114 @@
+package scheduling
+
+import "testing"
+
+func metricsByID(m []Metrics) map[int]Metrics {
+	out := make(map[int]Metrics, len(m))
+	for _, v := range m {
+		out[v.ID] = v
+	}
+	return out
+}
+
+func TestFCFS(t *testing.T) {
+	processes := []Process{
+		{ID: 1, Arrival: 0, Burst: 5},
+		{ID: 2, Arrival: 1, Burst: 3},
+		{ID: 3, Arrival: 2, Burst: 8},
+	}
+	got := metricsByID(FCFS(processes))
+
+	// P1: 0-5, P2: 5-8, P3: 8-16
+	want := map[int]Metrics{
+		1: {ID: 1, Completion: 5, Turnaround: 5, Waiting: 0},
+		2: {ID: 2, Completion: 8, Turnaround: 7, Waiting: 4},
+		3: {ID: 3, Completion: 16, Turnaround: 14, Waiting: 6},
+	}
+	for id, w := range want {
+		if got[id] != w {
+			t.Errorf("FCFS()[%d] = %+v, want %+v", id, got[id], w)
+		}
+	}
+}
+
+func TestSJF(t *testing.T) {
+	processes := []Process{
+		{ID: 1, Arrival: 0, Burst: 7},
+		{ID: 2, Arrival: 2, Burst: 4},
+		{ID: 3, Arrival: 4, Burst: 1},
+		{ID: 4, Arrival: 5, Burst: 4},
+	}
+	got := metricsByID(SJF(processes))
+
+	// P1 runs 0-7 (only one ready at t=0). At t=7 all of P2,P3,P4 have
+	// arrived; shortest is P3 (1) -> 7-8, then P2 (4) -> 8-12, then P4 (4) -> 12-16.
+	want := map[int]Metrics{
+		1: {ID: 1, Completion: 7, Turnaround: 7, Waiting: 0},
+		3: {ID: 3, Completion: 8, Turnaround: 4, Waiting: 3},
+		2: {ID: 2, Completion: 12, Turnaround: 10, Waiting: 6},
+		4: {ID: 4, Completion: 16, Turnaround: 11, Waiting: 7},
+	}
+	for id, w := range want {
+		if got[id] != w {
+			t.Errorf("SJF()[%d] = %+v, want %+v", id, got[id], w)
+		}
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	processes := []Process{
+		{ID: 1, Arrival: 0, Burst: 5},
+		{ID: 2, Arrival: 1, Burst: 4},
+		{ID: 3, Arrival: 2, Burst: 2},
+	}
+	got := metricsByID(RoundRobin(processes, 2))
+
+	// quantum=2: queue starts [P1]. t=0: run P1 2 -> t=2, remaining P1=3;
+	// arrivals by t=2: P2(1),P3(2) queued before P1 re-enqueued -> queue [P2,P3,P1].
+	// t=2: run P2 2 -> t=4, remaining P2=2; queue [P3,P1,P2].
+	// t=4: run P3 2 -> t=6, P3 done (remaining 0); queue [P1,P2].
+	// t=6: run P1 2 -> t=8, remaining P1=1; queue [P2,P1].
+	// t=8: run P2 2 -> t=10, P2 done; queue [P1].
+	// t=10: run P1 1 -> t=11, P1 done.
+	want := map[int]Metrics{
+		3: {ID: 3, Completion: 6, Turnaround: 4, Waiting: 2},
+		2: {ID: 2, Completion: 10, Turnaround: 9, Waiting: 5},
+		1: {ID: 1, Completion: 11, Turnaround: 11, Waiting: 6},
+	}
+	for id, w := range want {
+		if got[id] != w {
+			t.Errorf("RoundRobin()[%d] = %+v, want %+v", id, got[id], w)
+		}
+	}
+}
+
+func TestRoundRobinPanicsOnNonPositiveQuantum(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RoundRobin with quantum 0 did not panic")
+		}
+	}()
+	RoundRobin([]Process{{ID: 1, Arrival: 0, Burst: 1}}, 0)
+}
+
+func TestSchedulingAllTurnaroundsConsistent(t *testing.T) {
+	processes := []Process{
+		{ID: 1, Arrival: 0, Burst: 3},
+		{ID: 2, Arrival: 1, Burst: 6},
+		{ID: 3, Arrival: 2, Burst: 2},
+	}
+	for _, m := range FCFS(processes) {
+		if m.Turnaround != m.Completion-findArrival(processes, m.ID) {
+			t.Errorf("FCFS metrics inconsistent: %+v", m)
+		}
+	}
+}
+
+func findArrival(processes []Process, id int) int {
+	for _, p := range processes {
+		if p.ID == id {
+			return p.Arrival
+		}
+	}
+	return -1
+}
@@ -0,0 +1,86 @@
+package scheduling
+
+import "sort"
+
+// WeightedInterval is a job spanning [Start, End] worth Weight if
+// scheduled.
+type WeightedInterval struct {
+	Start, End int
+	Weight     int
+}
+
+// WeightedIntervalScheduling selects a subset of non-overlapping
+// intervals that maximizes total weight, via the standard DP: sort by
+// end time, and for each interval i let p(i) be the last interval
+// before i (by end time) that doesn't overlap it; then
+// dp[i] = max(dp[i-1], weight[i] + dp[p(i)]). The selection is
+// reconstructed by walking back through which branch each dp[i] took.
+//
+// Two intervals that merely touch (one's End equals the next's Start)
+// are treated as compatible, matching the classic problem's half-open
+// [Start, End) convention rather than structures/interval's closed
+// semantics.
+func WeightedIntervalScheduling(intervals []WeightedInterval) (selected []int, totalWeight int) {
+	n := len(intervals)
+	if n == 0 {
+		return nil, 0
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return intervals[order[a]].End < intervals[order[b]].End
+	})
+	sorted := make([]WeightedInterval, n)
+	for i, idx := range order {
+		sorted[i] = intervals[idx]
+	}
+
+	// p[i] = index (1-based, 0 meaning none) of the last interval before
+	// i, by end time, that doesn't overlap it. Since sorted is ordered
+	// by End, this is the rightmost index in [0, i) whose End is at most
+	// sorted[i].Start, found by binary search.
+	p := make([]int, n)
+	for i := 0; i < n; i++ {
+		lo, hi := 0, i-1
+		p[i] = 0
+		for lo <= hi {
+			mid := (lo + hi) / 2
+			if sorted[mid].End <= sorted[i].Start {
+				p[i] = mid + 1
+				lo = mid + 1
+			} else {
+				hi = mid - 1
+			}
+		}
+	}
+
+	dp := make([]int, n+1)
+	take := make([]bool, n+1)
+	for i := 1; i <= n; i++ {
+		withCurrent := sorted[i-1].Weight + dp[p[i-1]]
+		if withCurrent > dp[i-1] {
+			dp[i] = withCurrent
+			take[i] = true
+		} else {
+			dp[i] = dp[i-1]
+		}
+	}
+
+	var chosen []int
+	for i := n; i > 0; {
+		if take[i] {
+			chosen = append(chosen, order[i-1])
+			i = p[i-1]
+		} else {
+			i--
+		}
+	}
+	for i, j := 0, len(chosen)-1; i < j; i, j = i+1, j-1 {
+		chosen[i], chosen[j] = chosen[j], chosen[i]
+	}
+
+	return chosen, dp[n]
+}
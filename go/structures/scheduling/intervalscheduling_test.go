@@ -0,0 +1,56 @@
+package scheduling
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestWeightedIntervalScheduling(t *testing.T) {
+	intervals := []WeightedInterval{
+		{Start: 1, End: 3, Weight: 5},
+		{Start: 2, End: 5, Weight: 6},
+		{Start: 4, End: 6, Weight: 5},
+		{Start: 6, End: 7, Weight: 4},
+		{Start: 5, End: 8, Weight: 11},
+		{Start: 7, End: 9, Weight: 2},
+	}
+	selected, weight := WeightedIntervalScheduling(intervals)
+	if weight != 17 {
+		t.Fatalf("WeightedIntervalScheduling() weight = %d, want 17", weight)
+	}
+
+	total := 0
+	for _, idx := range selected {
+		total += intervals[idx].Weight
+	}
+	if total != weight {
+		t.Errorf("sum of selected weights = %d, want %d (selected=%v)", total, weight, selected)
+	}
+
+	sortedSel := append([]int{}, selected...)
+	sort.Ints(sortedSel)
+	for i := 1; i < len(sortedSel); i++ {
+		a, b := intervals[sortedSel[i-1]], intervals[sortedSel[i]]
+		if a.End > b.Start {
+			t.Errorf("selected intervals overlap: %v and %v", a, b)
+		}
+	}
+}
+
+func TestWeightedIntervalSchedulingNoOverlap(t *testing.T) {
+	intervals := []WeightedInterval{
+		{Start: 0, End: 1, Weight: 3},
+		{Start: 2, End: 3, Weight: 4},
+	}
+	selected, weight := WeightedIntervalScheduling(intervals)
+	if weight != 7 || len(selected) != 2 {
+		t.Errorf("WeightedIntervalScheduling() = (%v, %d), want both intervals, weight 7", selected, weight)
+	}
+}
+
+func TestWeightedIntervalSchedulingEmpty(t *testing.T) {
+	selected, weight := WeightedIntervalScheduling(nil)
+	if selected != nil || weight != 0 {
+		t.Errorf("WeightedIntervalScheduling(nil) = (%v, %d), want (nil, 0)", selected, weight)
+	}
+}
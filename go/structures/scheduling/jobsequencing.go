@@ -0,0 +1,101 @@
+// Package scheduling collects classic scheduling algorithms: job
+// sequencing with deadlines, weighted interval scheduling, and
+// simulations of common CPU scheduling disciplines.
+package scheduling
+
+import "sort"
+
+// Job is a unit-time task that must finish by Deadline to earn Profit.
+type Job struct {
+	ID       int
+	Deadline int
+	Profit   int
+}
+
+// disjointSet tracks, for each time slot, the latest still-free slot at
+// or before it, so JobSequencing can find a job's slot and mark it
+// taken in amortized O(log n) instead of scanning backwards from the
+// deadline on every job.
+type disjointSet struct {
+	parent []int
+}
+
+func newDisjointSet(n int) *disjointSet {
+	parent := make([]int, n+1)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &disjointSet{parent: parent}
+}
+
+func (d *disjointSet) find(x int) int {
+	for d.parent[x] != x {
+		d.parent[x] = d.parent[d.parent[x]]
+		x = d.parent[x]
+	}
+	return x
+}
+
+// occupy marks slot as taken by unioning it with the slot before it, so
+// the next find for slot or anything after it skips past it.
+func (d *disjointSet) occupy(slot int) {
+	d.parent[slot] = slot - 1
+}
+
+// JobSequencing selects a subset of jobs, each occupying one of the
+// unit-time slots 1..maxDeadline, that maximizes total profit subject
+// to every selected job finishing by its deadline. It returns the
+// selected job IDs in the order they were scheduled (by slot) and the
+// total profit earned.
+//
+// This is the greedy job-sequencing algorithm: sort jobs by decreasing
+// profit, and for each job greedily claim the latest free slot at or
+// before its deadline (if any) using a disjoint-set over slots to find
+// and claim that slot in amortized O(log n).
+func JobSequencing(jobs []Job) (selected []int, totalProfit int) {
+	if len(jobs) == 0 {
+		return nil, 0
+	}
+
+	sorted := append([]Job{}, jobs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Profit > sorted[j].Profit
+	})
+
+	maxDeadline := 0
+	for _, j := range sorted {
+		if j.Deadline > maxDeadline {
+			maxDeadline = j.Deadline
+		}
+	}
+	if maxDeadline <= 0 {
+		return nil, 0
+	}
+
+	ds := newDisjointSet(maxDeadline)
+	slotJob := make(map[int]int)
+
+	for _, job := range sorted {
+		if job.Deadline <= 0 {
+			continue
+		}
+		deadline := job.Deadline
+		if deadline > maxDeadline {
+			deadline = maxDeadline
+		}
+		slot := ds.find(deadline)
+		if slot == 0 {
+			continue // no free slot at or before the deadline
+		}
+		ds.occupy(slot)
+		slotJob[slot] = job.ID
+		totalProfit += job.Profit
+	}
+
+	for slot := 1; slot <= maxDeadline; slot++ {
+		if id, ok := slotJob[slot]; ok {
+			selected = append(selected, id)
+		}
+	}
+	return selected, totalProfit
+}
@@ -0,0 +1,46 @@
+package scheduling
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestJobSequencingClassicExample(t *testing.T) {
+	jobs := []Job{
+		{ID: 1, Deadline: 4, Profit: 20},
+		{ID: 2, Deadline: 1, Profit: 10},
+		{ID: 3, Deadline: 1, Profit: 40},
+		{ID: 4, Deadline: 1, Profit: 30},
+	}
+	selected, profit := JobSequencing(jobs)
+	if profit != 60 {
+		t.Errorf("JobSequencing() profit = %d, want 60", profit)
+	}
+
+	ids := append([]int{}, selected...)
+	sort.Ints(ids)
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 {
+		t.Errorf("JobSequencing() selected = %v, want jobs {1, 3}", selected)
+	}
+}
+
+func TestJobSequencingNoJobs(t *testing.T) {
+	selected, profit := JobSequencing(nil)
+	if selected != nil || profit != 0 {
+		t.Errorf("JobSequencing(nil) = (%v, %d), want (nil, 0)", selected, profit)
+	}
+}
+
+func TestJobSequencingAllSameDeadline(t *testing.T) {
+	jobs := []Job{
+		{ID: 1, Deadline: 1, Profit: 5},
+		{ID: 2, Deadline: 1, Profit: 10},
+	}
+	selected, profit := JobSequencing(jobs)
+	if profit != 10 {
+		t.Errorf("JobSequencing() profit = %d, want 10", profit)
+	}
+	if len(selected) != 1 || selected[0] != 2 {
+		t.Errorf("JobSequencing() selected = %v, want [2]", selected)
+	}
+}
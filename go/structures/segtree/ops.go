@@ -0,0 +1,136 @@
+package segtree
+
+import "math"
+
+// Assign is a lazy update type for "set every value in range to v"
+// updates. Set distinguishes a real assignment from the zero value, so
+// an Assign{} (Set: false) can serve as the no-op update even when T's
+// zero value is a meaningful thing to assign.
+type Assign[T any] struct {
+	Set   bool
+	Value T
+}
+
+// RangeAddRangeSum returns Ops for a segment tree supporting range-add
+// updates and range-sum queries over int64 values.
+func RangeAddRangeSum() Ops[int64, int64] {
+	return Ops[int64, int64]{
+		Combine:  func(a, b int64) int64 { return a + b },
+		Identity: func() int64 { return 0 },
+		Apply:    func(f, x int64, size int) int64 { return x + f*int64(size) },
+		Compose:  func(f, g int64) int64 { return f + g },
+		NoOp:     func() int64 { return 0 },
+	}
+}
+
+// RangeAddRangeMin returns Ops for a segment tree supporting range-add
+// updates and range-min queries over int64 values: adding delta to
+// every value in a range shifts that range's minimum by delta too, so
+// Apply doesn't need to scale by the node's size the way sum's does.
+func RangeAddRangeMin() Ops[int64, int64] {
+	return Ops[int64, int64]{
+		Combine: func(a, b int64) int64 {
+			if a < b {
+				return a
+			}
+			return b
+		},
+		Identity: func() int64 { return math.MaxInt64 },
+		Apply:    func(f, x int64, size int) int64 { return x + f },
+		Compose:  func(f, g int64) int64 { return f + g },
+		NoOp:     func() int64 { return 0 },
+	}
+}
+
+// RangeAddRangeMax is RangeAddRangeMin's counterpart for range-max
+// queries.
+func RangeAddRangeMax() Ops[int64, int64] {
+	return Ops[int64, int64]{
+		Combine: func(a, b int64) int64 {
+			if a > b {
+				return a
+			}
+			return b
+		},
+		Identity: func() int64 { return math.MinInt64 },
+		Apply:    func(f, x int64, size int) int64 { return x + f },
+		Compose:  func(f, g int64) int64 { return f + g },
+		NoOp:     func() int64 { return 0 },
+	}
+}
+
+// RangeAssignRangeSum returns Ops for a segment tree supporting
+// range-assign updates and range-sum queries over int64 values.
+func RangeAssignRangeSum() Ops[int64, Assign[int64]] {
+	return Ops[int64, Assign[int64]]{
+		Combine:  func(a, b int64) int64 { return a + b },
+		Identity: func() int64 { return 0 },
+		Apply: func(f Assign[int64], x int64, size int) int64 {
+			if !f.Set {
+				return x
+			}
+			return f.Value * int64(size)
+		},
+		Compose: func(f, g Assign[int64]) Assign[int64] {
+			if f.Set {
+				return f
+			}
+			return g
+		},
+		NoOp: func() Assign[int64] { return Assign[int64]{} },
+	}
+}
+
+// RangeAssignRangeMin returns Ops for a segment tree supporting
+// range-assign updates and range-min queries over int64 values.
+func RangeAssignRangeMin() Ops[int64, Assign[int64]] {
+	return Ops[int64, Assign[int64]]{
+		Combine: func(a, b int64) int64 {
+			if a < b {
+				return a
+			}
+			return b
+		},
+		Identity: func() int64 { return math.MaxInt64 },
+		Apply: func(f Assign[int64], x int64, size int) int64 {
+			if !f.Set {
+				return x
+			}
+			return f.Value
+		},
+		Compose: func(f, g Assign[int64]) Assign[int64] {
+			if f.Set {
+				return f
+			}
+			return g
+		},
+		NoOp: func() Assign[int64] { return Assign[int64]{} },
+	}
+}
+
+// RangeAssignRangeMax is RangeAssignRangeMin's counterpart for
+// range-max queries.
+func RangeAssignRangeMax() Ops[int64, Assign[int64]] {
+	return Ops[int64, Assign[int64]]{
+		Combine: func(a, b int64) int64 {
+			if a > b {
+				return a
+			}
+			return b
+		},
+		Identity: func() int64 { return math.MinInt64 },
+		Apply: func(f Assign[int64], x int64, size int) int64 {
+			if !f.Set {
+				return x
+			}
+			return f.Value
+		},
+		Compose: func(f, g Assign[int64]) Assign[int64] {
+			if f.Set {
+				return f
+			}
+			return g
+		},
+		NoOp: func() Assign[int64] { return Assign[int64]{} },
+	}
+}
@@ -0,0 +1,193 @@
+// Package segtree implements a generic segment tree with lazy
+// propagation: the combine operation over values and the composition
+// of pending updates are supplied as functions (via the Ops type), so
+// the same Tree implementation backs range-add-range-sum,
+// range-assign-range-min, and everything in between.
+//
+// Unlike structures/rectanglearea's countSegTree (a one-off,
+// non-generic tree built for a single problem), Tree uses the
+// AtCoder-Library-style iterative layout: values live in a flat array
+// indexed like a binary heap, leaves first, lazy tags only ever live on
+// internal nodes, and both Query and Update walk up/down by shifting an
+// index rather than recursing — the same cache-friendly, allocation-free
+// traversal structures/rangequery's SparseTable uses for its own flat
+// array, just extended to support range updates through lazy tags.
+package segtree
+
+// Ops bundles the operations a Tree needs from its value type S and its
+// lazy-update type F:
+//
+//   - Combine merges two adjacent values (must be associative).
+//   - Identity is Combine's identity element (e.g. 0 for sum, +Inf for
+//     min), used to seed empty partial results.
+//   - Apply maps a pending update f onto a node's value x, given the
+//     number of leaves that node covers (needed for updates like "add v"
+//     under a sum combine, where the effect scales with leaf count, but
+//     not under min/max, where it doesn't).
+//   - Compose merges update g (applied earlier) with a newer update f
+//     (applied later) into the single update equivalent to doing both.
+//   - NoOp is Compose's identity element: an update that changes
+//     nothing.
+type Ops[S, F any] struct {
+	Combine  func(a, b S) S
+	Identity func() S
+	Apply    func(f F, x S, size int) S
+	Compose  func(f, g F) F
+	NoOp     func() F
+}
+
+// Tree is a segment tree over n values, supporting range queries and
+// range updates in O(log n).
+type Tree[S, F any] struct {
+	ops  Ops[S, F]
+	n    int
+	size int
+	log  int
+	d    []S
+	lz   []F
+	sz   []int
+}
+
+// New builds a Tree over values, using ops to combine values and apply
+// lazy updates.
+func New[S, F any](values []S, ops Ops[S, F]) *Tree[S, F] {
+	n := len(values)
+	log := 0
+	for (1 << log) < n {
+		log++
+	}
+	size := 1 << log
+
+	t := &Tree[S, F]{ops: ops, n: n, size: size, log: log}
+	t.d = make([]S, 2*size)
+	t.lz = make([]F, size)
+	t.sz = make([]int, 2*size)
+	for i := range t.d {
+		t.d[i] = ops.Identity()
+	}
+	for i := range t.lz {
+		t.lz[i] = ops.NoOp()
+	}
+	for i, v := range values {
+		t.d[size+i] = v
+		t.sz[size+i] = 1
+	}
+	for k := size - 1; k >= 1; k-- {
+		t.pull(k)
+	}
+	return t
+}
+
+func (t *Tree[S, F]) pull(k int) {
+	t.d[k] = t.ops.Combine(t.d[2*k], t.d[2*k+1])
+	t.sz[k] = t.sz[2*k] + t.sz[2*k+1]
+}
+
+func (t *Tree[S, F]) allApply(k int, f F) {
+	t.d[k] = t.ops.Apply(f, t.d[k], t.sz[k])
+	if k < t.size {
+		t.lz[k] = t.ops.Compose(f, t.lz[k])
+	}
+}
+
+func (t *Tree[S, F]) push(k int) {
+	t.allApply(2*k, t.lz[k])
+	t.allApply(2*k+1, t.lz[k])
+	t.lz[k] = t.ops.NoOp()
+}
+
+// Set replaces the value at position p.
+func (t *Tree[S, F]) Set(p int, x S) {
+	p += t.size
+	for i := t.log; i >= 1; i-- {
+		t.push(p >> i)
+	}
+	t.d[p] = x
+	for i := 1; i <= t.log; i++ {
+		t.pull(p >> i)
+	}
+}
+
+// Get returns the value at position p.
+func (t *Tree[S, F]) Get(p int) S {
+	p += t.size
+	for i := t.log; i >= 1; i-- {
+		t.push(p >> i)
+	}
+	return t.d[p]
+}
+
+// Query combines the values over the half-open range [l, r).
+func (t *Tree[S, F]) Query(l, r int) S {
+	if l == r {
+		return t.ops.Identity()
+	}
+	l += t.size
+	r += t.size
+
+	for i := t.log; i >= 1; i-- {
+		if (l>>i)<<i != l {
+			t.push(l >> i)
+		}
+		if (r>>i)<<i != r {
+			t.push((r - 1) >> i)
+		}
+	}
+
+	sml, smr := t.ops.Identity(), t.ops.Identity()
+	for l < r {
+		if l&1 == 1 {
+			sml = t.ops.Combine(sml, t.d[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			smr = t.ops.Combine(t.d[r], smr)
+		}
+		l >>= 1
+		r >>= 1
+	}
+	return t.ops.Combine(sml, smr)
+}
+
+// Update applies f to every value in the half-open range [l, r).
+func (t *Tree[S, F]) Update(l, r int, f F) {
+	if l == r {
+		return
+	}
+	l += t.size
+	r += t.size
+
+	for i := t.log; i >= 1; i-- {
+		if (l>>i)<<i != l {
+			t.push(l >> i)
+		}
+		if (r>>i)<<i != r {
+			t.push((r - 1) >> i)
+		}
+	}
+
+	l2, r2 := l, r
+	for l < r {
+		if l&1 == 1 {
+			t.allApply(l, f)
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			t.allApply(r, f)
+		}
+		l >>= 1
+		r >>= 1
+	}
+	l, r = l2, r2
+
+	for i := 1; i <= t.log; i++ {
+		if (l>>i)<<i != l {
+			t.pull(l >> i)
+		}
+		if (r>>i)<<i != r {
+			t.pull((r - 1) >> i)
+		}
+	}
+}
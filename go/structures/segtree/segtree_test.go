@@ -0,0 +1,124 @@
+package segtree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func toInt64(vals []int) []int64 {
+	out := make([]int64, len(vals))
+	for i, v := range vals {
+		out[i] = int64(v)
+	}
+	return out
+}
+
+func TestRangeAddRangeSum(t *testing.T) {
+	vals := toInt64([]int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	tr := New(vals, RangeAddRangeSum())
+
+	if got := tr.Query(0, len(vals)); got != 45 {
+		t.Fatalf("Query(0, 9) = %d, want 45", got)
+	}
+	tr.Update(2, 5, 10) // vals[2..5) += 10 -> 3,4,5 become 13,14,15
+	want := int64(13 + 14 + 15)
+	if got := tr.Query(2, 5); got != want {
+		t.Errorf("Query(2, 5) after Update = %d, want %d", got, want)
+	}
+	if got := tr.Query(0, len(vals)); got != 45+30 {
+		t.Errorf("Query(0, 9) after Update = %d, want %d", got, 45+30)
+	}
+}
+
+func TestRangeAssignRangeMin(t *testing.T) {
+	vals := toInt64([]int{5, 3, 8, 1, 9, 2})
+	tr := New(vals, RangeAssignRangeMin())
+
+	if got := tr.Query(0, 6); got != 1 {
+		t.Fatalf("Query(0, 6) = %d, want 1", got)
+	}
+	tr.Update(0, 3, Assign[int64]{Set: true, Value: 100})
+	if got := tr.Query(0, 3); got != 100 {
+		t.Errorf("Query(0, 3) after assign = %d, want 100", got)
+	}
+	if got := tr.Query(0, 6); got != 1 {
+		t.Errorf("Query(0, 6) after partial assign = %d, want 1 (unaffected min still present)", got)
+	}
+}
+
+func TestRangeAssignRangeMax(t *testing.T) {
+	vals := toInt64([]int{5, 3, 8, 1, 9, 2})
+	tr := New(vals, RangeAssignRangeMax())
+
+	tr.Update(3, 6, Assign[int64]{Set: true, Value: -1})
+	if got := tr.Query(3, 6); got != -1 {
+		t.Errorf("Query(3, 6) after assign = %d, want -1", got)
+	}
+	if got := tr.Query(0, 6); got != 8 {
+		t.Errorf("Query(0, 6) = %d, want 8 (max from untouched prefix)", got)
+	}
+}
+
+func TestSetAndGet(t *testing.T) {
+	vals := toInt64([]int{1, 2, 3, 4})
+	tr := New(vals, RangeAddRangeSum())
+	tr.Set(2, 100)
+	if got := tr.Get(2); got != 100 {
+		t.Errorf("Get(2) = %d, want 100", got)
+	}
+	if got := tr.Query(0, 4); got != 1+2+100+4 {
+		t.Errorf("Query(0, 4) = %d, want %d", got, 1+2+100+4)
+	}
+}
+
+func TestEmptyRangeQueryReturnsIdentity(t *testing.T) {
+	vals := toInt64([]int{1, 2, 3})
+	tr := New(vals, RangeAddRangeSum())
+	if got := tr.Query(1, 1); got != 0 {
+		t.Errorf("Query(1, 1) = %d, want 0 (identity)", got)
+	}
+}
+
+func TestSingleElementTree(t *testing.T) {
+	tr := New([]int64{42}, RangeAddRangeSum())
+	if got := tr.Query(0, 1); got != 42 {
+		t.Errorf("Query(0, 1) = %d, want 42", got)
+	}
+}
+
+// bruteForceSum/bruteForceMin mirror what a correct implementation should
+// report, for randomized cross-checking against the real tree.
+func bruteForceSum(a []int64, l, r int) int64 {
+	var s int64
+	for i := l; i < r; i++ {
+		s += a[i]
+	}
+	return s
+}
+
+func TestRangeAddRangeSumAgainstBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	n := 50
+	a := make([]int64, n)
+	for i := range a {
+		a[i] = int64(r.Intn(100) - 50)
+	}
+	tr := New(append([]int64{}, a...), RangeAddRangeSum())
+
+	for op := 0; op < 500; op++ {
+		l := r.Intn(n)
+		hi := l + r.Intn(n-l) + 1
+		if r.Intn(2) == 0 {
+			delta := int64(r.Intn(21) - 10)
+			tr.Update(l, hi, delta)
+			for i := l; i < hi; i++ {
+				a[i] += delta
+			}
+		} else {
+			want := bruteForceSum(a, l, hi)
+			if got := tr.Query(l, hi); got != want {
+				t.Fatalf("Query(%d, %d) = %d, want %d", l, hi, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+)
+
+// MM1Result summarizes an M/M/1 queue simulation: one server, Poisson
+// arrivals, exponential service times.
+type MM1Result struct {
+	CustomersServed   int
+	AverageWaitTime   float64 // time spent queued before service starts
+	AverageSystemTime float64 // wait time plus service time
+	ServerUtilization float64 // fraction of simulated time the server was busy
+}
+
+func exponential(rng *rand.Rand, rate float64) float64 {
+	return -math.Log(1-rng.Float64()) / rate
+}
+
+// SimulateMM1 simulates an M/M/1 queue for numCustomers customers, with
+// Poisson arrivals at arrivalRate and a single server whose service
+// times are exponential at serviceRate, and reports standard queueing
+// metrics. It's a worked example of the Engine: each arrival is a single
+// scheduled event that computes when service starts (the greater of its
+// own arrival time and when the server frees up), so the whole queue's
+// dynamics fall out of events firing in time order.
+func SimulateMM1(arrivalRate, serviceRate float64, numCustomers int, rng *rand.Rand) MM1Result {
+	if numCustomers <= 0 {
+		return MM1Result{}
+	}
+
+	engine := New()
+	var served int
+	var totalWait, totalSystemTime, totalBusyTime float64
+	serverFreeAt := 0.0
+
+	arrivalTime := 0.0
+	for i := 0; i < numCustomers; i++ {
+		arrivalTime += exponential(rng, arrivalRate)
+		at := arrivalTime
+		engine.ScheduleAt(at, func() {
+			start := math.Max(engine.Now(), serverFreeAt)
+			service := exponential(rng, serviceRate)
+			wait := start - at
+
+			totalWait += wait
+			totalSystemTime += wait + service
+			totalBusyTime += service
+			serverFreeAt = start + service
+			served++
+		})
+	}
+	engine.Run()
+
+	return MM1Result{
+		CustomersServed:   served,
+		AverageWaitTime:   totalWait / float64(served),
+		AverageSystemTime: totalSystemTime / float64(served),
+		ServerUtilization: totalBusyTime / serverFreeAt,
+	}
+}
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSimulateMM1StableQueue(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	result := SimulateMM1(1.0, 2.0, 5000, rng) // rho = 0.5, well within stability
+
+	if result.CustomersServed != 5000 {
+		t.Fatalf("CustomersServed = %d, want 5000", result.CustomersServed)
+	}
+	if result.AverageWaitTime < 0 {
+		t.Errorf("AverageWaitTime = %v, want >= 0", result.AverageWaitTime)
+	}
+	if result.ServerUtilization <= 0 || result.ServerUtilization >= 1 {
+		t.Errorf("ServerUtilization = %v, want in (0, 1)", result.ServerUtilization)
+	}
+	// For M/M/1, utilization should track rho = arrivalRate/serviceRate = 0.5.
+	if result.ServerUtilization < 0.4 || result.ServerUtilization > 0.6 {
+		t.Errorf("ServerUtilization = %v, want close to 0.5", result.ServerUtilization)
+	}
+	if result.AverageSystemTime < result.AverageWaitTime {
+		t.Errorf("AverageSystemTime (%v) < AverageWaitTime (%v), want system time to include wait time", result.AverageSystemTime, result.AverageWaitTime)
+	}
+}
+
+func TestSimulateMM1ZeroCustomers(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	result := SimulateMM1(1.0, 2.0, 0, rng)
+	if result.CustomersServed != 0 {
+		t.Errorf("CustomersServed = %d, want 0", result.CustomersServed)
+	}
+}
+
+func TestSimulateMM1HeavierLoadWaitsLonger(t *testing.T) {
+	light := SimulateMM1(1.0, 4.0, 3000, rand.New(rand.NewSource(2)))
+	heavy := SimulateMM1(3.0, 4.0, 3000, rand.New(rand.NewSource(2)))
+
+	if heavy.AverageWaitTime <= light.AverageWaitTime {
+		t.Errorf("heavier load AverageWaitTime = %v, want greater than lighter load's %v", heavy.AverageWaitTime, light.AverageWaitTime)
+	}
+}
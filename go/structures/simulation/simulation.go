@@ -0,0 +1,136 @@
+// Package simulation implements a discrete-event simulation core: an
+// Engine keyed by a virtual clock that runs scheduled actions in
+// non-decreasing time order, breaking ties by scheduling order. Events
+// are held in a binary heap indexed by event ID (the technique
+// container/heap's own documentation recommends: Swap keeps a
+// position map current, so a still-pending event can be found and
+// removed from the middle of the heap in O(log n) instead of only ever
+// being poppable from the front).
+package simulation
+
+import "container/heap"
+
+// EventID identifies a scheduled event, returned by ScheduleAt/
+// ScheduleAfter and accepted by Cancel.
+type EventID int
+
+type event struct {
+	id     EventID
+	time   float64
+	seq    int // breaks time ties in scheduling order
+	action func()
+}
+
+// eventHeap is a container/heap.Interface over pending events that
+// additionally tracks each event's current slice index in pos, so a
+// specific event can be located and removed without scanning.
+type eventHeap struct {
+	events []*event
+	pos    map[EventID]int
+}
+
+func (h *eventHeap) Len() int { return len(h.events) }
+
+func (h *eventHeap) Less(i, j int) bool {
+	a, b := h.events[i], h.events[j]
+	if a.time != b.time {
+		return a.time < b.time
+	}
+	return a.seq < b.seq
+}
+
+func (h *eventHeap) Swap(i, j int) {
+	h.events[i], h.events[j] = h.events[j], h.events[i]
+	h.pos[h.events[i].id] = i
+	h.pos[h.events[j].id] = j
+}
+
+func (h *eventHeap) Push(x any) {
+	e := x.(*event)
+	h.pos[e.id] = len(h.events)
+	h.events = append(h.events, e)
+}
+
+func (h *eventHeap) Pop() any {
+	n := len(h.events)
+	e := h.events[n-1]
+	h.events = h.events[:n-1]
+	delete(h.pos, e.id)
+	return e
+}
+
+// Engine is a discrete-event simulation clock and scheduler.
+type Engine struct {
+	pending eventHeap
+	now     float64
+	nextID  EventID
+	seq     int
+}
+
+// New returns an Engine whose virtual clock starts at 0.
+func New() *Engine {
+	return &Engine{pending: eventHeap{pos: make(map[EventID]int)}}
+}
+
+// Now returns the engine's current virtual time: the time of the event
+// most recently run, or 0 before Run/RunUntil has processed anything.
+func (e *Engine) Now() float64 {
+	return e.now
+}
+
+// ScheduleAt schedules action to run at the given virtual time, which
+// must be >= e.Now(), and returns an EventID that can later be passed to
+// Cancel.
+func (e *Engine) ScheduleAt(t float64, action func()) EventID {
+	e.nextID++
+	e.seq++
+	id := e.nextID
+	heap.Push(&e.pending, &event{id: id, time: t, seq: e.seq, action: action})
+	return id
+}
+
+// ScheduleAfter schedules action to run delay units after e.Now().
+func (e *Engine) ScheduleAfter(delay float64, action func()) EventID {
+	return e.ScheduleAt(e.now+delay, action)
+}
+
+// Cancel removes a pending event before it runs, reporting whether it
+// found one to remove (Cancel on an already-run or already-cancelled id
+// is a no-op that returns false).
+func (e *Engine) Cancel(id EventID) bool {
+	i, ok := e.pending.pos[id]
+	if !ok {
+		return false
+	}
+	heap.Remove(&e.pending, i)
+	return true
+}
+
+// Pending returns the number of events still scheduled.
+func (e *Engine) Pending() int {
+	return e.pending.Len()
+}
+
+// Run processes every pending event in time order, including any new
+// events scheduled by actions as they run, until none remain.
+func (e *Engine) Run() {
+	for e.pending.Len() > 0 {
+		e.step()
+	}
+}
+
+// RunUntil processes pending events in time order up to and including
+// endTime, leaving any later-scheduled events pending. The clock is left
+// at the last event actually run, which may be before endTime if the
+// queue emptied first.
+func (e *Engine) RunUntil(endTime float64) {
+	for e.pending.Len() > 0 && e.pending.events[0].time <= endTime {
+		e.step()
+	}
+}
+
+func (e *Engine) step() {
+	ev := heap.Pop(&e.pending).(*event)
+	e.now = ev.time
+	ev.action()
+}
@@ -0,0 +1,141 @@
+package simulation
+
+import "testing"
+
+func TestRunProcessesEventsInTimeOrder(t *testing.T) {
+	e := New()
+	var order []int
+
+	e.ScheduleAt(3, func() { order = append(order, 3) })
+	e.ScheduleAt(1, func() { order = append(order, 1) })
+	e.ScheduleAt(2, func() { order = append(order, 2) })
+
+	e.Run()
+
+	want := []int{1, 2, 3}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order = %v, want %v", order, want)
+			break
+		}
+	}
+}
+
+func TestTiesBreakByScheduleOrder(t *testing.T) {
+	e := New()
+	var order []string
+
+	e.ScheduleAt(1, func() { order = append(order, "first") })
+	e.ScheduleAt(1, func() { order = append(order, "second") })
+
+	e.Run()
+
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Errorf("order = %v, want [first second]", order)
+	}
+}
+
+func TestScheduleAfterUsesCurrentTime(t *testing.T) {
+	e := New()
+	var fired []float64
+
+	e.ScheduleAt(5, func() {
+		fired = append(fired, e.Now())
+		e.ScheduleAfter(2, func() { fired = append(fired, e.Now()) })
+	})
+	e.Run()
+
+	want := []float64{5, 7}
+	if len(fired) != len(want) {
+		t.Fatalf("fired = %v, want %v", fired, want)
+	}
+	for i := range want {
+		if fired[i] != want[i] {
+			t.Errorf("fired = %v, want %v", fired, want)
+			break
+		}
+	}
+}
+
+func TestCancel(t *testing.T) {
+	e := New()
+	ran := false
+	id := e.ScheduleAt(1, func() { ran = true })
+
+	if !e.Cancel(id) {
+		t.Fatal("Cancel returned false for a pending event")
+	}
+	if e.Cancel(id) {
+		t.Error("Cancel returned true for an already-cancelled event")
+	}
+
+	e.Run()
+	if ran {
+		t.Error("cancelled event's action ran")
+	}
+}
+
+func TestCancelLeavesOtherEventsIntact(t *testing.T) {
+	e := New()
+	var order []int
+
+	id := e.ScheduleAt(1, func() { order = append(order, 1) })
+	e.ScheduleAt(2, func() { order = append(order, 2) })
+	e.ScheduleAt(3, func() { order = append(order, 3) })
+	e.Cancel(id)
+
+	e.Run()
+
+	want := []int{2, 3}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order = %v, want %v", order, want)
+			break
+		}
+	}
+}
+
+func TestRunUntilLeavesLaterEventsPending(t *testing.T) {
+	e := New()
+	var order []int
+	e.ScheduleAt(1, func() { order = append(order, 1) })
+	e.ScheduleAt(10, func() { order = append(order, 10) })
+
+	e.RunUntil(5)
+
+	if len(order) != 1 || order[0] != 1 {
+		t.Errorf("order after RunUntil(5) = %v, want [1]", order)
+	}
+	if e.Pending() != 1 {
+		t.Errorf("Pending() = %d, want 1", e.Pending())
+	}
+
+	e.Run()
+	if len(order) != 2 || order[1] != 10 {
+		t.Errorf("order after Run() = %v, want [1 10]", order)
+	}
+}
+
+func TestEventsCanScheduleMoreEvents(t *testing.T) {
+	e := New()
+	count := 0
+	var schedule func(n int)
+	schedule = func(n int) {
+		count++
+		if n > 0 {
+			e.ScheduleAfter(1, func() { schedule(n - 1) })
+		}
+	}
+	e.ScheduleAt(0, func() { schedule(4) })
+	e.Run()
+
+	if count != 5 {
+		t.Errorf("count = %d, want 5", count)
+	}
+}
@@ -0,0 +1,85 @@
+// Package skyline solves the classic building-skyline problem: given a
+// set of rectangular buildings, find the key points tracing the outline
+// of their union as seen from a distance.
+//
+// It does so with a sweep line over each building's left and right
+// edges, using an ordered multiset (structures/multiset, an AVL tree)
+// to track the heights of every currently-open building — the tallest
+// one is always the skyline's current height, and the multiset answers
+// that in O(log n) as buildings open and close.
+package skyline
+
+import (
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/structures/multiset"
+)
+
+// Building is a rectangle spanning [Left, Right) with the given Height.
+type Building struct {
+	Left, Right, Height int
+}
+
+// Point is a key point on the skyline outline: the height changes to
+// Height at horizontal position X.
+type Point struct {
+	X, Height int
+}
+
+type event struct {
+	x      int
+	height int
+	isEnd  bool
+}
+
+// Skyline returns the key points of the skyline traced by buildings, in
+// increasing order of X. Consecutive points never repeat the same
+// height.
+func Skyline(buildings []Building) []Point {
+	events := make([]event, 0, 2*len(buildings))
+	for _, b := range buildings {
+		events = append(events, event{x: b.Left, height: b.Height, isEnd: false})
+		events = append(events, event{x: b.Right, height: b.Height, isEnd: true})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].x != events[j].x {
+			return events[i].x < events[j].x
+		}
+		// At the same x, process starts before ends so a building
+		// ending exactly where another begins doesn't cause a
+		// spurious dip to the next-tallest height in between.
+		if events[i].isEnd != events[j].isEnd {
+			return !events[i].isEnd
+		}
+		// Among starts, the tallest should be seen first so the
+		// multiset's max is already correct; among ends, order
+		// doesn't affect the final heights.
+		return events[i].height > events[j].height
+	})
+
+	heights := multiset.New[int]()
+	heights.Insert(0) // ground level, so Max() is always defined
+	var points []Point
+	prevHeight := 0
+
+	i := 0
+	for i < len(events) {
+		x := events[i].x
+		for i < len(events) && events[i].x == x {
+			if events[i].isEnd {
+				heights.Remove(events[i].height)
+			} else {
+				heights.Insert(events[i].height)
+			}
+			i++
+		}
+		curHeight, _ := heights.Max()
+		if curHeight != prevHeight {
+			points = append(points, Point{X: x, Height: curHeight})
+			prevHeight = curHeight
+		}
+	}
+
+	return points
+}
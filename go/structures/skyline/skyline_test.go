@@ -0,0 +1,70 @@
+package skyline
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkylineClassicExample(t *testing.T) {
+	buildings := []Building{
+		{Left: 2, Right: 9, Height: 10},
+		{Left: 3, Right: 7, Height: 15},
+		{Left: 5, Right: 12, Height: 12},
+		{Left: 15, Right: 20, Height: 10},
+		{Left: 19, Right: 24, Height: 8},
+	}
+	want := []Point{
+		{X: 2, Height: 10},
+		{X: 3, Height: 15},
+		{X: 7, Height: 12},
+		{X: 12, Height: 0},
+		{X: 15, Height: 10},
+		{X: 20, Height: 8},
+		{X: 24, Height: 0},
+	}
+	got := Skyline(buildings)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Skyline() = %v, want %v", got, want)
+	}
+}
+
+func TestSkylineSingleBuilding(t *testing.T) {
+	got := Skyline([]Building{{Left: 0, Right: 5, Height: 3}})
+	want := []Point{{X: 0, Height: 3}, {X: 5, Height: 0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Skyline() = %v, want %v", got, want)
+	}
+}
+
+func TestSkylineNonOverlappingBuildings(t *testing.T) {
+	got := Skyline([]Building{
+		{Left: 0, Right: 2, Height: 3},
+		{Left: 5, Right: 7, Height: 4},
+	})
+	want := []Point{
+		{X: 0, Height: 3},
+		{X: 2, Height: 0},
+		{X: 5, Height: 4},
+		{X: 7, Height: 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Skyline() = %v, want %v", got, want)
+	}
+}
+
+func TestSkylineNoBuildings(t *testing.T) {
+	if got := Skyline(nil); len(got) != 0 {
+		t.Errorf("Skyline(nil) = %v, want empty", got)
+	}
+}
+
+func TestSkylineIdenticalOverlappingBuildings(t *testing.T) {
+	got := Skyline([]Building{
+		{Left: 0, Right: 5, Height: 3},
+		{Left: 0, Right: 5, Height: 3},
+	})
+	want := []Point{{X: 0, Height: 3}, {X: 5, Height: 0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Skyline() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,85 @@
+// Package spellcheck is a worked example of a Bloom filter fronting an
+// exact dictionary: a Checker rejects most misspellings with a single
+// cheap bloomfilter.Test call, only falling through to the exact word
+// set (and, for corrections, an edit-distance search over it) when the
+// filter says a word is possibly known.
+//
+// This is the classic reason to reach for a Bloom filter at all — not
+// to replace the exact set, but to sit in front of it as a fast
+// negative cache, since real dictionaries are large enough that an
+// exact lookup (or, worse, scanning for correction candidates) is much
+// more expensive than a handful of hash probes into a bit array.
+package spellcheck
+
+import (
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/strings/stringmetrics"
+	"github.com/ghostmkg/dsa-code/go/structures/bloomfilter"
+)
+
+// Checker spell-checks words against a fixed dictionary.
+type Checker struct {
+	filter *bloomfilter.Filter
+	words  map[string]struct{}
+}
+
+// New builds a Checker over dictionary, sizing its Bloom filter for
+// len(dictionary) entries at a 1% false-positive rate.
+func New(dictionary []string) *Checker {
+	c := &Checker{
+		filter: bloomfilter.New(len(dictionary), 0.01),
+		words:  make(map[string]struct{}, len(dictionary)),
+	}
+	for _, w := range dictionary {
+		c.filter.Add(w)
+		c.words[w] = struct{}{}
+	}
+	return c
+}
+
+// IsCorrect reports whether word is in the dictionary. Most misspelled
+// words are rejected by the Bloom filter alone; only words the filter
+// says are possibly present fall through to the exact set, which also
+// absorbs the filter's rare false positives.
+func (c *Checker) IsCorrect(word string) bool {
+	if !c.filter.Test(word) {
+		return false
+	}
+	_, ok := c.words[word]
+	return ok
+}
+
+// Suggestion is a dictionary word offered as a correction, together with
+// its edit distance from the misspelled word (lower is a closer match).
+type Suggestion struct {
+	Word     string
+	Distance int
+}
+
+// Suggest returns every dictionary word within maxDistance Levenshtein
+// edits of word, ordered by increasing distance and then alphabetically.
+// It does not consult the Bloom filter: a correction search needs the
+// exact dictionary regardless, since the filter can only say "maybe" or
+// "definitely not there", never enumerate near matches.
+func (c *Checker) Suggest(word string, maxDistance int) []Suggestion {
+	var out []Suggestion
+	for w := range c.words {
+		d := stringmetrics.Levenshtein(word, w, stringmetrics.DefaultCosts)
+		if d <= maxDistance {
+			out = append(out, Suggestion{Word: w, Distance: d})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Distance != out[j].Distance {
+			return out[i].Distance < out[j].Distance
+		}
+		return out[i].Word < out[j].Word
+	})
+	return out
+}
+
+// Len returns the number of words in the dictionary.
+func (c *Checker) Len() int {
+	return len(c.words)
+}
@@ -0,0 +1,66 @@
+package spellcheck
+
+import (
+	"reflect"
+	"testing"
+)
+
+var dictionary = []string{"apple", "banana", "cherry", "grape", "orange", "peach", "plum"}
+
+func TestIsCorrect(t *testing.T) {
+	c := New(dictionary)
+
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"apple", true},
+		{"banana", true},
+		{"appl", false},
+		{"xyz", false},
+		{"", false},
+	}
+	for _, tc := range tests {
+		if got := c.IsCorrect(tc.word); got != tc.want {
+			t.Errorf("IsCorrect(%q) = %v, want %v", tc.word, got, tc.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	c := New(dictionary)
+
+	got := c.Suggest("aple", 2)
+	if len(got) == 0 || got[0].Word != "apple" {
+		t.Fatalf("Suggest(\"aple\", 2) = %v, want \"apple\" first", got)
+	}
+	if got[0].Distance != 1 {
+		t.Errorf("Suggest(\"aple\", 2)[0].Distance = %d, want 1", got[0].Distance)
+	}
+}
+
+func TestSuggestRespectsMaxDistance(t *testing.T) {
+	c := New(dictionary)
+
+	got := c.Suggest("zzzzzzzzzz", 2)
+	if got != nil {
+		t.Errorf("Suggest with no close dictionary word = %v, want nil", got)
+	}
+}
+
+func TestSuggestOrdering(t *testing.T) {
+	c := New([]string{"cat", "car", "cap", "dog"})
+
+	got := c.Suggest("cas", 1)
+	want := []Suggestion{{Word: "cap", Distance: 1}, {Word: "car", Distance: 1}, {Word: "cat", Distance: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Suggest(\"cas\", 1) = %v, want %v", got, want)
+	}
+}
+
+func TestLen(t *testing.T) {
+	c := New(dictionary)
+	if c.Len() != len(dictionary) {
+		t.Errorf("Len() = %d, want %d", c.Len(), len(dictionary))
+	}
+}
@@ -0,0 +1,11 @@
+package splaysequence
+
+import "fmt"
+
+func indexError(op string, i, length int) error {
+	return fmt.Errorf("splaysequence: %s: index %d out of range [0, %d)", op, i, length)
+}
+
+func rangeError(op string, l, r, length int) error {
+	return fmt.Errorf("splaysequence: %s: range [%d, %d) invalid for length %d", op, l, r, length)
+}
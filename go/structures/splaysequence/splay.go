@@ -0,0 +1,188 @@
+package splaysequence
+
+func sizeOf[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// toggleReversed flips n's reversed flag and, to keep n.sum and n.revSum
+// valid without re-folding n's subtree, swaps them: reversing a subtree
+// turns its right-to-left fold into the new left-to-right one and vice
+// versa. This is what lets RangeReverse and pushDown stay O(1) per node
+// even when combine isn't commutative.
+func (s *Sequence[T]) toggleReversed(n *node[T]) {
+	if n == nil {
+		return
+	}
+	n.reversed = !n.reversed
+	n.sum, n.revSum = n.revSum, n.sum
+}
+
+// pushDown propagates n's reversed flag one level down, if set. Callers
+// must do this before reading or restructuring n's children so that a
+// pending reversal higher up the tree never leaves a stale child pointer
+// exposed.
+func (s *Sequence[T]) pushDown(n *node[T]) {
+	if n == nil || !n.reversed {
+		return
+	}
+	n.reversed = false
+	n.left, n.right = n.right, n.left
+	s.toggleReversed(n.left)
+	s.toggleReversed(n.right)
+}
+
+// update recomputes n's size, sum, and revSum from its (already up to
+// date) children. It assumes n's own reversed flag has already been
+// resolved (false), which every call site maintains by pushing down
+// before restructuring; n.left/n.right's sum and revSum are still valid
+// even while *their* reversed flags are pending, since toggleReversed
+// keeps both in sync with every flip. sum folds left-to-right; revSum
+// folds the same elements right-to-left, which for a non-commutative
+// combine is not the same value.
+func (s *Sequence[T]) update(n *node[T]) {
+	if n == nil {
+		return
+	}
+	n.size = 1 + sizeOf(n.left) + sizeOf(n.right)
+
+	sum := n.value
+	if n.left != nil {
+		sum = s.combine(n.left.sum, sum)
+	}
+	if n.right != nil {
+		sum = s.combine(sum, n.right.sum)
+	}
+	n.sum = sum
+
+	revSum := n.value
+	if n.right != nil {
+		revSum = s.combine(n.right.revSum, revSum)
+	}
+	if n.left != nil {
+		revSum = s.combine(revSum, n.left.revSum)
+	}
+	n.revSum = revSum
+}
+
+// splay brings the node at position rank (0-indexed within root's
+// subtree) to the top of that subtree and returns the new subtree root.
+//
+// It's a top-down splay (Sleator-Tarjan): rather than splaying bottom-up
+// with parent pointers, it walks down from root once, peeling nodes off
+// into two chains (destined to become the result's final left and right
+// subtrees) and folding two same-direction steps into a single rotation
+// (the "zig-zig" case) as it goes. Reaching the target still costs
+// O(depth), but it's one pass instead of a separate find followed by a
+// walk back up.
+func (s *Sequence[T]) splay(root *node[T], rank int) *node[T] {
+	if root == nil {
+		return nil
+	}
+
+	var leftHeader, rightHeader node[T]
+	l, r := &leftHeader, &rightHeader
+	var leftChain, rightChain []*node[T]
+
+	t := root
+	for {
+		s.pushDown(t)
+		leftSize := sizeOf(t.left)
+
+		switch {
+		case rank < leftSize:
+			s.pushDown(t.left)
+			if rank < sizeOf(t.left.left) {
+				y := t.left
+				t.left = y.right
+				y.right = t
+				s.update(t)
+				t = y
+				if t.left == nil {
+					goto assemble
+				}
+			}
+			r.left = t
+			rightChain = append(rightChain, t)
+			r = t
+			t = t.left
+
+		case rank > leftSize:
+			rank -= leftSize + 1
+			s.pushDown(t.right)
+			if t.right != nil && rank > sizeOf(t.right.left) {
+				// The rotation below reattaches t (and whatever used to
+				// be y's left subtree) as y's new left child, changing
+				// what y's leftSize means, so rank must be re-expressed
+				// relative to y's subtree before descending further —
+				// using y's *original* left size, which is what the
+				// comparison above actually tested against.
+				y := t.right
+				innerLeftSize := sizeOf(y.left)
+				t.right = y.left
+				y.left = t
+				s.update(t)
+				t = y
+				rank -= innerLeftSize + 1
+				if t.right == nil {
+					goto assemble
+				}
+			}
+			l.right = t
+			leftChain = append(leftChain, t)
+			l = t
+			t = t.right
+
+		default:
+			goto assemble
+		}
+	}
+
+assemble:
+	l.right = t.left
+	r.left = t.right
+	for i := len(leftChain) - 1; i >= 0; i-- {
+		s.update(leftChain[i])
+	}
+	for i := len(rightChain) - 1; i >= 0; i-- {
+		s.update(rightChain[i])
+	}
+	t.left = leftHeader.right
+	t.right = rightHeader.left
+	s.update(t)
+	return t
+}
+
+// split splits root into two subtrees: the first containing its first k
+// elements (indices [0, k)), the second containing the rest.
+func (s *Sequence[T]) split(root *node[T], k int) (*node[T], *node[T]) {
+	if k <= 0 {
+		return nil, root
+	}
+	if k >= sizeOf(root) {
+		return root, nil
+	}
+	root = s.splay(root, k)
+	left := root.left
+	root.left = nil
+	s.update(root)
+	return left, root
+}
+
+// merge joins a (every element of which precedes every element of b) into
+// a single subtree, by splaying a's last element to its own root and
+// hanging b off its right.
+func (s *Sequence[T]) merge(a, b *node[T]) *node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	a = s.splay(a, sizeOf(a)-1)
+	a.right = b
+	s.update(a)
+	return a
+}
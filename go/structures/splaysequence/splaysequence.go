@@ -0,0 +1,165 @@
+// Package splaysequence implements an implicit splay tree: a sequence of
+// values keyed by position rather than by an explicit comparable key, so
+// that "go left/right" decisions during a splay are driven by subtree
+// size instead of a key comparison. That gets index-based Insert and
+// Delete, range-sum queries, and an O(log n) lazy RangeReverse (the same
+// trick segment trees use for range-update flags) all amortized to
+// O(log n), self-balancing from access pattern alone the way any splay
+// tree does.
+//
+// This repo does not otherwise have a rope or a treap; this package
+// fills the same "editable array with cheap range operations" niche by
+// itself rather than building on either.
+package splaysequence
+
+type node[T any] struct {
+	value T
+	left  *node[T]
+	right *node[T]
+
+	size int
+	// sum is the combine-fold of this subtree's elements in current
+	// left-to-right order; revSum is the fold of the same elements
+	// right-to-left. Keeping both lets a pending reversal update n's
+	// own aggregates in O(1) (swap sum and revSum) instead of requiring
+	// an immediate O(size) re-fold, which matters for combine functions
+	// that aren't commutative (string concatenation, matrix multiply):
+	// see toggleReversed.
+	sum      T
+	revSum   T
+	reversed bool
+}
+
+// Sequence is an editable sequence of T supporting index-based Insert and
+// Delete plus range sum and range reverse, all in amortized O(log n).
+// combine must be associative and identity must be its identity element,
+// the same contract sqrtdecomp.Decomposition uses.
+type Sequence[T any] struct {
+	root     *node[T]
+	combine  func(a, b T) T
+	identity T
+}
+
+// New builds a Sequence over a copy of values.
+func New[T any](values []T, combine func(a, b T) T, identity T) *Sequence[T] {
+	s := &Sequence[T]{combine: combine, identity: identity}
+	s.root = s.build(values)
+	return s
+}
+
+// build constructs a balanced tree from values in one pass. Inserting the
+// values one at a time into an empty tree would instead produce a
+// near-linear chain, since there's no prior splay history to balance
+// against yet.
+func (s *Sequence[T]) build(values []T) *node[T] {
+	if len(values) == 0 {
+		return nil
+	}
+	mid := len(values) / 2
+	n := &node[T]{value: values[mid]}
+	n.left = s.build(values[:mid])
+	n.right = s.build(values[mid+1:])
+	s.update(n)
+	return n
+}
+
+// Len returns the number of elements in s.
+func (s *Sequence[T]) Len() int {
+	return sizeOf(s.root)
+}
+
+// Get returns the element at index.
+func (s *Sequence[T]) Get(index int) (T, error) {
+	var zero T
+	if index < 0 || index >= s.Len() {
+		return zero, indexError("Get", index, s.Len())
+	}
+	s.root = s.splay(s.root, index)
+	return s.root.value, nil
+}
+
+// Insert inserts value so that it becomes the element at index, shifting
+// every element previously at or after index one position later. index
+// may equal s.Len() to append.
+func (s *Sequence[T]) Insert(index int, value T) error {
+	if index < 0 || index > s.Len() {
+		return indexError("Insert", index, s.Len())
+	}
+	left, right := s.split(s.root, index)
+	n := &node[T]{value: value}
+	s.update(n)
+	s.root = s.merge(s.merge(left, n), right)
+	return nil
+}
+
+// Delete removes and returns the element at index.
+func (s *Sequence[T]) Delete(index int) (T, error) {
+	var zero T
+	if index < 0 || index >= s.Len() {
+		return zero, indexError("Delete", index, s.Len())
+	}
+	left, rest := s.split(s.root, index)
+	mid, right := s.split(rest, 1)
+	value := mid.value
+	s.root = s.merge(left, right)
+	return value, nil
+}
+
+// RangeSum returns combine-folded value of elements [l, r) (r exclusive).
+func (s *Sequence[T]) RangeSum(l, r int) (T, error) {
+	if err := s.checkRange("RangeSum", l, r); err != nil {
+		return s.identity, err
+	}
+	if l == r {
+		return s.identity, nil
+	}
+	left, rest := s.split(s.root, l)
+	mid, right := s.split(rest, r-l)
+	sum := mid.sum
+	s.root = s.merge(s.merge(left, mid), right)
+	return sum, nil
+}
+
+// RangeReverse reverses the order of elements [l, r) (r exclusive) in
+// place. The reversal itself is O(log n): it just splits out the [l, r)
+// subtree and flips a lazy flag on its root, which pushDown later
+// propagates to that subtree's children the first time anything descends
+// into it again.
+func (s *Sequence[T]) RangeReverse(l, r int) error {
+	if err := s.checkRange("RangeReverse", l, r); err != nil {
+		return err
+	}
+	if l == r {
+		return nil
+	}
+	left, rest := s.split(s.root, l)
+	mid, right := s.split(rest, r-l)
+	s.toggleReversed(mid)
+	s.root = s.merge(s.merge(left, mid), right)
+	return nil
+}
+
+// ToSlice returns every element of s, in order.
+func (s *Sequence[T]) ToSlice() []T {
+	out := make([]T, 0, s.Len())
+	var walk func(n *node[T])
+	walk = func(n *node[T]) {
+		if n == nil {
+			return
+		}
+		s.pushDown(n)
+		walk(n.left)
+		out = append(out, n.value)
+		walk(n.right)
+	}
+	walk(s.root)
+	return out
+}
+
+func (s *Sequence[T]) checkRange(op string, l, r int) error {
+	n := s.Len()
+	if l < 0 || r > n || l > r {
+		return rangeError(op, l, r, n)
+	}
+	return nil
+}
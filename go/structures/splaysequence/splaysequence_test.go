@@ -0,0 +1,236 @@
+package splaysequence
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func sumCombine(a, b int) int { return a + b }
+
+func TestBasicInsertDeleteGet(t *testing.T) {
+	s := New([]int{10, 20, 30}, sumCombine, 0)
+	if s.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", s.Len())
+	}
+
+	if err := s.Insert(1, 15); err != nil {
+		t.Fatalf("Insert: %v", err)
+	}
+	want := []int{10, 15, 20, 30}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+
+	v, err := s.Delete(0)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if v != 10 {
+		t.Fatalf("Delete(0) = %d, want 10", v)
+	}
+	want = []int{15, 20, 30}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+
+	if got, err := s.Get(1); err != nil || got != 20 {
+		t.Fatalf("Get(1) = (%d, %v), want (20, nil)", got, err)
+	}
+}
+
+func TestRangeSumAndRangeReverse(t *testing.T) {
+	s := New([]int{1, 2, 3, 4, 5}, sumCombine, 0)
+
+	sum, err := s.RangeSum(1, 4)
+	if err != nil {
+		t.Fatalf("RangeSum: %v", err)
+	}
+	if sum != 2+3+4 {
+		t.Fatalf("RangeSum(1,4) = %d, want %d", sum, 2+3+4)
+	}
+
+	if err := s.RangeReverse(1, 4); err != nil {
+		t.Fatalf("RangeReverse: %v", err)
+	}
+	want := []int{1, 4, 3, 2, 5}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() after RangeReverse = %v, want %v", got, want)
+	}
+
+	// Sum over the same range is unaffected by reversal.
+	sum, err = s.RangeSum(1, 4)
+	if err != nil {
+		t.Fatalf("RangeSum: %v", err)
+	}
+	if sum != 2+3+4 {
+		t.Fatalf("RangeSum(1,4) after reverse = %d, want %d", sum, 2+3+4)
+	}
+}
+
+func TestRangeReverseWholeSequence(t *testing.T) {
+	s := New([]int{1, 2, 3, 4}, sumCombine, 0)
+	if err := s.RangeReverse(0, 4); err != nil {
+		t.Fatalf("RangeReverse: %v", err)
+	}
+	want := []int{4, 3, 2, 1}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+}
+
+func concatCombine(a, b string) string { return a + b }
+
+// TestRangeSumAfterReverseWithNonCommutativeCombine exercises a combine
+// that isn't commutative, where a reversed range's sum genuinely differs
+// from its pre-reversal sum (every other test here uses int addition,
+// which can't tell a reversed fold from a stale one).
+func TestRangeSumAfterReverseWithNonCommutativeCombine(t *testing.T) {
+	s := New([]string{"A", "B", "C"}, concatCombine, "")
+
+	if sum, err := s.RangeSum(0, 3); err != nil || sum != "ABC" {
+		t.Fatalf("RangeSum(0,3) = (%q, %v), want (%q, nil)", sum, err, "ABC")
+	}
+
+	if err := s.RangeReverse(0, 3); err != nil {
+		t.Fatalf("RangeReverse: %v", err)
+	}
+	if got, want := s.ToSlice(), []string{"C", "B", "A"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() after RangeReverse = %v, want %v", got, want)
+	}
+	if sum, err := s.RangeSum(0, 3); err != nil || sum != "CBA" {
+		t.Fatalf("RangeSum(0,3) after RangeReverse = (%q, %v), want (%q, nil)", sum, err, "CBA")
+	}
+
+	// A partial-range reverse should only flip the combine order within
+	// that range, leaving elements outside it in their original order.
+	if err := s.RangeReverse(0, 2); err != nil {
+		t.Fatalf("RangeReverse: %v", err)
+	}
+	if got, want := s.ToSlice(), []string{"B", "C", "A"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToSlice() after partial RangeReverse = %v, want %v", got, want)
+	}
+	if sum, err := s.RangeSum(0, 2); err != nil || sum != "BC" {
+		t.Fatalf("RangeSum(0,2) after partial RangeReverse = (%q, %v), want (%q, nil)", sum, err, "BC")
+	}
+}
+
+func TestOutOfRangeErrors(t *testing.T) {
+	s := New([]int{1, 2, 3}, sumCombine, 0)
+
+	if err := s.Insert(4, 9); err == nil {
+		t.Error("Insert(4, ...) on a length-3 sequence returned no error")
+	}
+	if _, err := s.Delete(3); err == nil {
+		t.Error("Delete(3) on a length-3 sequence returned no error")
+	}
+	if _, err := s.Get(-1); err == nil {
+		t.Error("Get(-1) returned no error")
+	}
+	if _, err := s.RangeSum(2, 1); err == nil {
+		t.Error("RangeSum(2, 1) returned no error")
+	}
+	if err := s.RangeReverse(0, 10); err == nil {
+		t.Error("RangeReverse(0, 10) on a length-3 sequence returned no error")
+	}
+}
+
+func TestEmptySequence(t *testing.T) {
+	s := New[int](nil, sumCombine, 0)
+	if s.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", s.Len())
+	}
+	if err := s.Insert(0, 42); err != nil {
+		t.Fatalf("Insert into empty sequence: %v", err)
+	}
+	if got := s.ToSlice(); !reflect.DeepEqual(got, []int{42}) {
+		t.Fatalf("ToSlice() = %v, want [42]", got)
+	}
+}
+
+// bruteForce mirrors Sequence's behavior over a plain slice, so random
+// operation sequences can be cross-checked against it.
+type bruteForce struct {
+	data []int
+}
+
+func (b *bruteForce) insert(index, value int) {
+	b.data = append(b.data, 0)
+	copy(b.data[index+1:], b.data[index:])
+	b.data[index] = value
+}
+
+func (b *bruteForce) delete(index int) int {
+	v := b.data[index]
+	b.data = append(b.data[:index], b.data[index+1:]...)
+	return v
+}
+
+func (b *bruteForce) rangeSum(l, r int) int {
+	sum := 0
+	for _, v := range b.data[l:r] {
+		sum += v
+	}
+	return sum
+}
+
+func (b *bruteForce) rangeReverse(l, r int) {
+	sub := b.data[l:r]
+	for i, j := 0, len(sub)-1; i < j; i, j = i+1, j-1 {
+		sub[i], sub[j] = sub[j], sub[i]
+	}
+}
+
+func TestAgainstBruteForceRandomOps(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	bf := &bruteForce{data: []int{}}
+	s := New[int](nil, sumCombine, 0)
+
+	for op := 0; op < 2000; op++ {
+		n := len(bf.data)
+		switch {
+		case n == 0 || r.Intn(4) == 0:
+			index := r.Intn(n + 1)
+			value := r.Intn(1000)
+			bf.insert(index, value)
+			if err := s.Insert(index, value); err != nil {
+				t.Fatalf("op %d: Insert(%d, %d): %v", op, index, value, err)
+			}
+		case r.Intn(3) == 0:
+			index := r.Intn(n)
+			want := bf.delete(index)
+			got, err := s.Delete(index)
+			if err != nil {
+				t.Fatalf("op %d: Delete(%d): %v", op, index, err)
+			}
+			if got != want {
+				t.Fatalf("op %d: Delete(%d) = %d, want %d", op, index, got, want)
+			}
+		case r.Intn(2) == 0:
+			l := r.Intn(n)
+			rr := l + r.Intn(n-l) + 1
+			want := bf.rangeSum(l, rr)
+			got, err := s.RangeSum(l, rr)
+			if err != nil {
+				t.Fatalf("op %d: RangeSum(%d,%d): %v", op, l, rr, err)
+			}
+			if got != want {
+				t.Fatalf("op %d: RangeSum(%d,%d) = %d, want %d", op, l, rr, got, want)
+			}
+		default:
+			l := r.Intn(n)
+			rr := l + r.Intn(n-l) + 1
+			bf.rangeReverse(l, rr)
+			if err := s.RangeReverse(l, rr); err != nil {
+				t.Fatalf("op %d: RangeReverse(%d,%d): %v", op, l, rr, err)
+			}
+		}
+
+		if got, want := s.Len(), len(bf.data); got != want {
+			t.Fatalf("op %d: Len() = %d, want %d", op, got, want)
+		}
+		if got := s.ToSlice(); !reflect.DeepEqual(got, bf.data) {
+			t.Fatalf("op %d: ToSlice() = %v, want %v", op, got, bf.data)
+		}
+	}
+}
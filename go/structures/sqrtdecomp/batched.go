@@ -0,0 +1,65 @@
+package sqrtdecomp
+
+// BatchedSum is the "sqrt on queries" rebuild pattern applied to a sum
+// aggregate: instead of paying the O(sqrt n) block rebuild on every single
+// Update, it buffers up to sqrt(totalOps) pending deltas and only folds
+// them into the underlying Decomposition (one full O(sqrt n)-per-update
+// pass) once the buffer fills, so the amortized update cost across a known
+// number of operations drops to O(sqrt totalOps) instead of O(sqrt n) on
+// every call. Query answers out of whichever pending deltas fall in range
+// plus the last rebuild's aggregate, which only works because sum is
+// invertible (a delta's contribution to a range doesn't depend on what
+// else is in that range) — this trick does not generalize to non-invertible
+// aggregates like min or max the way Decomposition itself does.
+type BatchedSum struct {
+	base      *Decomposition[int]
+	pending   map[int]int // index -> delta not yet folded into base
+	threshold int
+}
+
+// NewBatchedSum builds a BatchedSum over values, sized for roughly
+// totalOps upcoming Update/Query calls.
+func NewBatchedSum(values []int, totalOps int) *BatchedSum {
+	threshold := isqrtCeil(totalOps)
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &BatchedSum{
+		base:      New(values, func(a, b int) int { return a + b }, 0),
+		pending:   make(map[int]int, threshold),
+		threshold: threshold,
+	}
+}
+
+// Update adds delta to arr[index]. It's buffered rather than applied
+// immediately, and only folded into the underlying Decomposition once
+// enough updates have accumulated.
+func (b *BatchedSum) Update(index, delta int) {
+	b.pending[index] += delta
+	if len(b.pending) >= b.threshold {
+		b.rebuild()
+	}
+}
+
+// rebuild folds every pending delta into base and clears the buffer.
+func (b *BatchedSum) rebuild() {
+	for index, delta := range b.pending {
+		if delta == 0 {
+			continue
+		}
+		b.base.Update(index, b.base.arr[index]+delta)
+	}
+	b.pending = make(map[int]int, b.threshold)
+}
+
+// Query returns the sum of arr[l:r] (r exclusive), combining the last
+// rebuild's aggregate with any pending deltas that fall inside the range.
+func (b *BatchedSum) Query(l, r int) int {
+	sum := b.base.Query(l, r)
+	for index, delta := range b.pending {
+		if index >= l && index < r {
+			sum += delta
+		}
+	}
+	return sum
+}
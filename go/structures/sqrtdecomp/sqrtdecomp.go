@@ -0,0 +1,119 @@
+// Package sqrtdecomp implements sqrt (block) decomposition: an array is
+// split into blocks of roughly sqrt(n) elements, each carrying its own
+// aggregate, so point updates and range-aggregate queries both cost
+// O(sqrt n) — simpler to implement and reason about than a segment tree,
+// at the cost of the better tree's O(log n).
+package sqrtdecomp
+
+// Decomposition supports point updates and range-aggregate queries over a
+// slice of T, combined with an arbitrary associative combine function
+// (sum, min, max, gcd, ...). It is not limited to numeric types the way a
+// Fenwick tree would be.
+type Decomposition[T any] struct {
+	arr       []T
+	combine   func(a, b T) T
+	identity  T
+	blockSize int
+	blockAgg  []T
+}
+
+// New builds a Decomposition over a copy of values. combine must be
+// associative and identity must be its identity element (0 for sum,
+// +Inf-equivalent for min, and so on) so that combining any value with
+// identity returns that value unchanged.
+func New[T any](values []T, combine func(a, b T) T, identity T) *Decomposition[T] {
+	arr := append([]T{}, values...)
+	blockSize := isqrtCeil(len(arr))
+	if blockSize < 1 {
+		blockSize = 1
+	}
+
+	numBlocks := (len(arr) + blockSize - 1) / blockSize
+	d := &Decomposition[T]{
+		arr:       arr,
+		combine:   combine,
+		identity:  identity,
+		blockSize: blockSize,
+		blockAgg:  make([]T, numBlocks),
+	}
+	for b := range d.blockAgg {
+		d.blockAgg[b] = d.rebuildBlock(b)
+	}
+	return d
+}
+
+// rebuildBlock recomputes block b's aggregate from scratch over its
+// current elements in arr.
+func (d *Decomposition[T]) rebuildBlock(b int) T {
+	start, end := d.blockRange(b)
+	agg := d.identity
+	for i := start; i < end; i++ {
+		agg = d.combine(agg, d.arr[i])
+	}
+	return agg
+}
+
+func (d *Decomposition[T]) blockRange(b int) (start, end int) {
+	start = b * d.blockSize
+	end = start + d.blockSize
+	if end > len(d.arr) {
+		end = len(d.arr)
+	}
+	return start, end
+}
+
+// Update sets arr[index] = value and recomputes just that index's block
+// aggregate, O(sqrt n) work.
+func (d *Decomposition[T]) Update(index int, value T) {
+	d.arr[index] = value
+	block := index / d.blockSize
+	d.blockAgg[block] = d.rebuildBlock(block)
+}
+
+// Query combines arr[l:r] (r exclusive), visiting whole blocks via their
+// precomputed aggregate and only walking element-by-element through the
+// two partial blocks at the ends — O(sqrt n) total.
+func (d *Decomposition[T]) Query(l, r int) T {
+	agg := d.identity
+	if l >= r {
+		return agg
+	}
+
+	startBlock := l / d.blockSize
+	endBlock := (r - 1) / d.blockSize
+
+	if startBlock == endBlock {
+		for i := l; i < r; i++ {
+			agg = d.combine(agg, d.arr[i])
+		}
+		return agg
+	}
+
+	_, firstBlockEnd := d.blockRange(startBlock)
+	for i := l; i < firstBlockEnd; i++ {
+		agg = d.combine(agg, d.arr[i])
+	}
+	for b := startBlock + 1; b < endBlock; b++ {
+		agg = d.combine(agg, d.blockAgg[b])
+	}
+	lastBlockStart, _ := d.blockRange(endBlock)
+	for i := lastBlockStart; i < r; i++ {
+		agg = d.combine(agg, d.arr[i])
+	}
+	return agg
+}
+
+// Len returns the number of elements the Decomposition was built over.
+func (d *Decomposition[T]) Len() int { return len(d.arr) }
+
+// isqrtCeil returns ceil(sqrt(n)) for n >= 0 using only integer arithmetic.
+func isqrtCeil(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	r := 0
+	for r*r < n {
+		r++
+	}
+	return r
+}
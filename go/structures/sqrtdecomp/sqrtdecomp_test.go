@@ -0,0 +1,82 @@
+package sqrtdecomp
+
+import "testing"
+
+func bruteSum(arr []int, l, r int) int {
+	sum := 0
+	for i := l; i < r; i++ {
+		sum += arr[i]
+	}
+	return sum
+}
+
+func TestQueryMatchesBruteForce(t *testing.T) {
+	arr := []int{5, 2, 8, 1, 9, 3, 7, 4, 6, 0}
+	d := New(arr, func(a, b int) int { return a + b }, 0)
+
+	ranges := [][2]int{{0, 10}, {0, 1}, {3, 3}, {2, 7}, {9, 10}, {4, 9}}
+	for _, rg := range ranges {
+		got := d.Query(rg[0], rg[1])
+		want := bruteSum(arr, rg[0], rg[1])
+		if got != want {
+			t.Errorf("Query(%d, %d) = %d, want %d", rg[0], rg[1], got, want)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	arr := []int{1, 1, 1, 1, 1, 1, 1}
+	d := New(arr, func(a, b int) int { return a + b }, 0)
+
+	d.Update(3, 100)
+	arr[3] = 100 // mirror for the brute-force comparison below
+
+	for l := 0; l < len(arr); l++ {
+		for r := l; r <= len(arr); r++ {
+			if got, want := d.Query(l, r), bruteSum(arr, l, r); got != want {
+				t.Errorf("Query(%d, %d) after Update = %d, want %d", l, r, got, want)
+			}
+		}
+	}
+}
+
+func TestMinAggregate(t *testing.T) {
+	arr := []int{5, 2, 8, 1, 9, 3}
+	const maxInt = int(^uint(0) >> 1)
+	d := New(arr, func(a, b int) int {
+		if a < b {
+			return a
+		}
+		return b
+	}, maxInt)
+
+	if got, want := d.Query(0, 6), 1; got != want {
+		t.Errorf("min Query(0,6) = %d, want %d", got, want)
+	}
+	if got, want := d.Query(1, 3), 2; got != want {
+		t.Errorf("min Query(1,3) = %d, want %d", got, want)
+	}
+}
+
+func TestBatchedSum(t *testing.T) {
+	arr := make([]int, 20)
+	for i := range arr {
+		arr[i] = i
+	}
+	b := NewBatchedSum(arr, 50)
+
+	mirror := append([]int{}, arr...)
+	updates := [][2]int{{0, 5}, {3, -2}, {10, 7}, {19, 1}, {5, 5}}
+	for _, u := range updates {
+		b.Update(u[0], u[1])
+		mirror[u[0]] += u[1]
+
+		for l := 0; l < len(mirror); l += 3 {
+			for r := l; r <= len(mirror); r += 4 {
+				if got, want := b.Query(l, r), bruteSum(mirror, l, r); got != want {
+					t.Errorf("after Update%v, Query(%d, %d) = %d, want %d", u, l, r, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package stack
+
+// listNode is one node of a ListStack's backing singly linked list.
+type listNode[T any] struct {
+	val  T
+	next *listNode[T]
+}
+
+// ListStack is a Stack backed by a singly linked list, pushing and
+// popping at the head so every operation is O(1) with no reallocation.
+// Its zero value is an empty, ready-to-use stack.
+type ListStack[T any] struct {
+	top *listNode[T]
+	len int
+}
+
+// NewListStack returns an empty ListStack.
+func NewListStack[T any]() *ListStack[T] {
+	return &ListStack[T]{}
+}
+
+// Push implements Stack.
+func (s *ListStack[T]) Push(v T) {
+	s.top = &listNode[T]{val: v, next: s.top}
+	s.len++
+}
+
+// Pop implements Stack.
+func (s *ListStack[T]) Pop() (T, bool) {
+	if s.top == nil {
+		var zero T
+		return zero, false
+	}
+	v := s.top.val
+	s.top = s.top.next
+	s.len--
+	return v, true
+}
+
+// Peek implements Stack.
+func (s *ListStack[T]) Peek() (T, bool) {
+	if s.top == nil {
+		var zero T
+		return zero, false
+	}
+	return s.top.val, true
+}
+
+// Len implements Stack.
+func (s *ListStack[T]) Len() int {
+	return s.len
+}
+
+// ToSlice implements Stack.
+func (s *ListStack[T]) ToSlice() []T {
+	out := make([]T, 0, s.len)
+	for n := s.top; n != nil; n = n.next {
+		out = append(out, n.val)
+	}
+	return out
+}
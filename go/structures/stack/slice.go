@@ -0,0 +1,51 @@
+package stack
+
+// SliceStack is a Stack backed by a growable slice. Its zero value is an
+// empty, ready-to-use stack.
+type SliceStack[T any] struct {
+	items []T
+}
+
+// NewSliceStack returns an empty SliceStack.
+func NewSliceStack[T any]() *SliceStack[T] {
+	return &SliceStack[T]{}
+}
+
+// Push implements Stack.
+func (s *SliceStack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop implements Stack.
+func (s *SliceStack[T]) Pop() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	v := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+// Peek implements Stack.
+func (s *SliceStack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len implements Stack.
+func (s *SliceStack[T]) Len() int {
+	return len(s.items)
+}
+
+// ToSlice implements Stack.
+func (s *SliceStack[T]) ToSlice() []T {
+	out := make([]T, len(s.items))
+	for i := range s.items {
+		out[i] = s.items[len(s.items)-1-i]
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+// Package stack implements a generic LIFO Stack[T] behind one interface,
+// with two interchangeable backings: SliceStack (a growable slice, the
+// usual choice) and ListStack (a singly linked list, useful when pointers
+// into existing nodes must stay valid across pushes that would otherwise
+// reallocate a slice). Both support iterating every element from top to
+// bottom without popping any of them.
+package stack
+
+// Stack is the common operations every backing implements.
+type Stack[T any] interface {
+	// Push adds v to the top of the stack.
+	Push(v T)
+	// Pop removes and returns the top of the stack. ok is false if the
+	// stack was empty, in which case the returned value is the zero
+	// value of T.
+	Pop() (v T, ok bool)
+	// Peek returns the top of the stack without removing it. ok is
+	// false if the stack is empty.
+	Peek() (v T, ok bool)
+	// Len returns the number of elements currently on the stack.
+	Len() int
+	// ToSlice returns every element from top to bottom, without
+	// popping any of them.
+	ToSlice() []T
+}
@@ -0,0 +1,97 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func backings() map[string]func() Stack[int] {
+	return map[string]func() Stack[int]{
+		"SliceStack": func() Stack[int] { return NewSliceStack[int]() },
+		"ListStack":  func() Stack[int] { return NewListStack[int]() },
+	}
+}
+
+func TestStackPushPopOrder(t *testing.T) {
+	for name, newStack := range backings() {
+		t.Run(name, func(t *testing.T) {
+			s := newStack()
+			s.Push(1)
+			s.Push(2)
+			s.Push(3)
+
+			for _, want := range []int{3, 2, 1} {
+				got, ok := s.Pop()
+				if !ok || got != want {
+					t.Errorf("Pop() = (%d, %v), want (%d, true)", got, ok, want)
+				}
+			}
+			if s.Len() != 0 {
+				t.Errorf("Len() = %d after draining, want 0", s.Len())
+			}
+		})
+	}
+}
+
+func TestStackPopEmpty(t *testing.T) {
+	for name, newStack := range backings() {
+		t.Run(name, func(t *testing.T) {
+			s := newStack()
+			if _, ok := s.Pop(); ok {
+				t.Errorf("Pop() on empty stack: ok = true, want false")
+			}
+			if _, ok := s.Peek(); ok {
+				t.Errorf("Peek() on empty stack: ok = true, want false")
+			}
+		})
+	}
+}
+
+func TestStackPeekDoesNotRemove(t *testing.T) {
+	for name, newStack := range backings() {
+		t.Run(name, func(t *testing.T) {
+			s := newStack()
+			s.Push(42)
+
+			got, ok := s.Peek()
+			if !ok || got != 42 {
+				t.Errorf("Peek() = (%d, %v), want (42, true)", got, ok)
+			}
+			if s.Len() != 1 {
+				t.Errorf("Len() = %d after Peek, want 1", s.Len())
+			}
+		})
+	}
+}
+
+func TestStackToSliceDoesNotPop(t *testing.T) {
+	for name, newStack := range backings() {
+		t.Run(name, func(t *testing.T) {
+			s := newStack()
+			s.Push(1)
+			s.Push(2)
+			s.Push(3)
+
+			if got, want := s.ToSlice(), []int{3, 2, 1}; !slices.Equal(got, want) {
+				t.Errorf("ToSlice() = %v, want %v", got, want)
+			}
+			if s.Len() != 3 {
+				t.Errorf("Len() = %d after ToSlice, want 3 (ToSlice must not pop)", s.Len())
+			}
+		})
+	}
+}
+
+func TestStackZeroValueIsUsable(t *testing.T) {
+	var sliceStack SliceStack[int]
+	sliceStack.Push(1)
+	if got, ok := sliceStack.Pop(); !ok || got != 1 {
+		t.Errorf("zero-value SliceStack: Pop() = (%d, %v), want (1, true)", got, ok)
+	}
+
+	var listStack ListStack[int]
+	listStack.Push(1)
+	if got, ok := listStack.Pop(); !ok || got != 1 {
+		t.Errorf("zero-value ListStack: Pop() = (%d, %v), want (1, true)", got, ok)
+	}
+}
@@ -0,0 +1,48 @@
+// Package timemap implements the classic time-based key-value store
+// design-structure problem: Set(key, value, timestamp) records a new
+// version of a key, and Get(key, timestamp) returns the latest value
+// recorded at or before that timestamp, found via binary search over
+// each key's append-only log.
+package timemap
+
+import "sort"
+
+type entry[V any] struct {
+	timestamp int
+	value     V
+}
+
+// TimeMap stores, per key, a log of (timestamp, value) pairs. Set calls
+// for a given key must use non-decreasing timestamps, matching the
+// classic problem's constraint and letting Get binary search the log
+// directly instead of sorting it first.
+type TimeMap[V any] struct {
+	logs map[string][]entry[V]
+}
+
+// New creates an empty TimeMap.
+func New[V any]() *TimeMap[V] {
+	return &TimeMap[V]{logs: make(map[string][]entry[V])}
+}
+
+// Set records value for key at timestamp. timestamp must be greater
+// than or equal to the timestamp of key's previous Set call, if any.
+func (m *TimeMap[V]) Set(key string, value V, timestamp int) {
+	m.logs[key] = append(m.logs[key], entry[V]{timestamp: timestamp, value: value})
+}
+
+// Get returns the value set for key with the largest timestamp that is
+// at most timestamp, found by binary search over key's log. It reports
+// false if key has no such value, either because key was never set or
+// every recorded timestamp is after the given one.
+func (m *TimeMap[V]) Get(key string, timestamp int) (V, bool) {
+	log := m.logs[key]
+	i := sort.Search(len(log), func(i int) bool {
+		return log[i].timestamp > timestamp
+	})
+	if i == 0 {
+		var zero V
+		return zero, false
+	}
+	return log[i-1].value, true
+}
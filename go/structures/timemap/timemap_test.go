@@ -0,0 +1,57 @@
+package timemap
+
+import "testing"
+
+func TestTimeMapBasic(t *testing.T) {
+	m := New[string]()
+	m.Set("foo", "bar", 1)
+
+	if v, ok := m.Get("foo", 1); !ok || v != "bar" {
+		t.Errorf("Get(foo, 1) = (%q, %v), want (bar, true)", v, ok)
+	}
+	if v, ok := m.Get("foo", 3); !ok || v != "bar" {
+		t.Errorf("Get(foo, 3) = (%q, %v), want (bar, true)", v, ok)
+	}
+}
+
+func TestTimeMapMultipleVersions(t *testing.T) {
+	m := New[string]()
+	m.Set("foo", "bar", 1)
+	m.Set("foo", "bar2", 4)
+
+	cases := []struct {
+		timestamp int
+		want      string
+		wantOK    bool
+	}{
+		{0, "", false},
+		{1, "bar", true},
+		{2, "bar", true},
+		{3, "bar", true},
+		{4, "bar2", true},
+		{100, "bar2", true},
+	}
+	for _, c := range cases {
+		got, ok := m.Get("foo", c.timestamp)
+		if ok != c.wantOK || got != c.want {
+			t.Errorf("Get(foo, %d) = (%q, %v), want (%q, %v)", c.timestamp, got, ok, c.want, c.wantOK)
+		}
+	}
+}
+
+func TestTimeMapUnknownKey(t *testing.T) {
+	m := New[int]()
+	if _, ok := m.Get("missing", 5); ok {
+		t.Errorf("Get(missing, 5) reported a value for an unset key")
+	}
+}
+
+func TestTimeMapGeneric(t *testing.T) {
+	m := New[int]()
+	m.Set("count", 10, 1)
+	m.Set("count", 20, 2)
+
+	if v, ok := m.Get("count", 2); !ok || v != 20 {
+		t.Errorf("Get(count, 2) = (%d, %v), want (20, true)", v, ok)
+	}
+}
@@ -0,0 +1,272 @@
+package trie
+
+import "sort"
+
+// radixNode is one edge-compressed node: label holds the run of runes
+// consumed between this node and its parent (the root's label is always
+// empty), so a chain of single-child trieNodes collapses into one
+// radixNode with a multi-rune label.
+type radixNode[V any] struct {
+	label    []rune
+	children []*radixNode[V]
+	value    V
+	hasValue bool
+}
+
+// RadixTree is a compressed (PATRICIA-style) trie mapping string keys to
+// values of type V, offering the same Insert/Delete/Get/PrefixSearch/
+// AutocompleteTopK surface as Trie.
+type RadixTree[V any] struct {
+	root *radixNode[V]
+	size int
+}
+
+// NewRadixTree returns an empty RadixTree.
+func NewRadixTree[V any]() *RadixTree[V] {
+	return &RadixTree[V]{root: &radixNode[V]{}}
+}
+
+// Len returns the number of keys currently stored.
+func (t *RadixTree[V]) Len() int {
+	return t.size
+}
+
+func commonPrefixLen(a, b []rune) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	i := 0
+	for i < n && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// Insert stores value under key, overwriting any existing value for that
+// key.
+func (t *RadixTree[V]) Insert(key string, value V) {
+	_, existed := t.Get(key)
+	insertRadix(t.root, []rune(key), value)
+	if !existed {
+		t.size++
+	}
+}
+
+func insertRadix[V any](n *radixNode[V], key []rune, value V) {
+	if len(key) == 0 {
+		n.value = value
+		n.hasValue = true
+		return
+	}
+	for i, child := range n.children {
+		common := commonPrefixLen(child.label, key)
+		if common == 0 {
+			continue
+		}
+		if common == len(child.label) {
+			insertRadix(child, key[common:], value)
+			return
+		}
+
+		// The new key diverges partway through child's label: split
+		// child into an intermediate node holding the shared prefix,
+		// with the shortened original child and (if any key remains) a
+		// new leaf for the divergent suffix as its children.
+		mid := &radixNode[V]{label: cloneRunes(child.label[:common])}
+		child.label = cloneRunes(child.label[common:])
+		mid.children = []*radixNode[V]{child}
+		if common < len(key) {
+			mid.children = append(mid.children, &radixNode[V]{
+				label:    cloneRunes(key[common:]),
+				value:    value,
+				hasValue: true,
+			})
+		} else {
+			mid.value = value
+			mid.hasValue = true
+		}
+		sortRadixChildren(mid.children)
+		n.children[i] = mid
+		return
+	}
+	n.children = append(n.children, &radixNode[V]{label: cloneRunes(key), value: value, hasValue: true})
+	sortRadixChildren(n.children)
+}
+
+func cloneRunes(r []rune) []rune {
+	return append([]rune{}, r...)
+}
+
+func sortRadixChildren[V any](children []*radixNode[V]) {
+	sort.Slice(children, func(i, j int) bool { return children[i].label[0] < children[j].label[0] })
+}
+
+// Get returns the value stored under key, if any.
+func (t *RadixTree[V]) Get(key string) (V, bool) {
+	n := findRadix(t.root, []rune(key))
+	if n == nil || !n.hasValue {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+func findRadix[V any](n *radixNode[V], key []rune) *radixNode[V] {
+	if len(key) == 0 {
+		return n
+	}
+	for _, child := range n.children {
+		common := commonPrefixLen(child.label, key)
+		if common == 0 {
+			continue
+		}
+		if common == len(child.label) {
+			return findRadix(child, key[common:])
+		}
+		return nil // key mismatches, or is exhausted partway through the edge
+	}
+	return nil
+}
+
+// Delete removes key, reporting whether it was present. A node left with
+// no value and a single remaining child is merged with that child, and a
+// node left with no value and no children is pruned, so the tree stays
+// maximally compressed after deletions.
+func (t *RadixTree[V]) Delete(key string) bool {
+	if key == "" {
+		if !t.root.hasValue {
+			return false
+		}
+		var zero V
+		t.root.value = zero
+		t.root.hasValue = false
+		t.size--
+		return true
+	}
+	if !deleteRadix(t.root, []rune(key)) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+func deleteRadix[V any](n *radixNode[V], key []rune) bool {
+	for i, child := range n.children {
+		common := commonPrefixLen(child.label, key)
+		if common == 0 {
+			continue
+		}
+		if common < len(child.label) {
+			return false
+		}
+		rest := key[common:]
+		var found bool
+		if len(rest) == 0 {
+			if !child.hasValue {
+				return false
+			}
+			var zero V
+			child.value = zero
+			child.hasValue = false
+			found = true
+		} else {
+			found = deleteRadix(child, rest)
+		}
+		if found {
+			compact(n, i)
+		}
+		return found
+	}
+	return false
+}
+
+// compact collapses n.children[i] if deleting from under it left it
+// without a value: drop it entirely if it also has no children, or merge
+// it with its one remaining child if it has exactly one.
+func compact[V any](n *radixNode[V], i int) {
+	child := n.children[i]
+	if child.hasValue {
+		return
+	}
+	switch len(child.children) {
+	case 0:
+		n.children = append(n.children[:i], n.children[i+1:]...)
+	case 1:
+		only := child.children[0]
+		n.children[i] = &radixNode[V]{
+			label:    append(child.label, only.label...),
+			value:    only.value,
+			hasValue: only.hasValue,
+			children: only.children,
+		}
+	}
+}
+
+// PrefixSearch returns every key with the given prefix (prefix itself
+// included, if it's a stored key), in lexicographic order.
+func (t *RadixTree[V]) PrefixSearch(prefix string) []Entry[V] {
+	node, matched, ok := findRadixPrefix(t.root, []rune(prefix), nil)
+	if !ok {
+		return nil
+	}
+	var results []Entry[V]
+	collectRadix(node, string(matched), -1, &results)
+	return results
+}
+
+// AutocompleteTopK returns up to k keys with the given prefix, in
+// lexicographic order (see Trie.AutocompleteTopK for why "top" means
+// lexicographically first here).
+func (t *RadixTree[V]) AutocompleteTopK(prefix string, k int) []Entry[V] {
+	if k <= 0 {
+		return nil
+	}
+	node, matched, ok := findRadixPrefix(t.root, []rune(prefix), nil)
+	if !ok {
+		return nil
+	}
+	var results []Entry[V]
+	collectRadix(node, string(matched), k, &results)
+	return results
+}
+
+// findRadixPrefix descends from n looking for the node whose subtree is
+// exactly "every key starting with key". path accumulates the runes
+// consumed so far. If key runs out in the middle of an edge, the whole
+// child at the far end of that edge is still the right subtree: every
+// key under it shares the queried prefix.
+func findRadixPrefix[V any](n *radixNode[V], key []rune, path []rune) (*radixNode[V], []rune, bool) {
+	if len(key) == 0 {
+		return n, path, true
+	}
+	for _, child := range n.children {
+		common := commonPrefixLen(child.label, key)
+		if common == 0 {
+			continue
+		}
+		if common == len(key) {
+			return child, append(path, child.label...), true
+		}
+		if common == len(child.label) {
+			return findRadixPrefix(child, key[common:], append(path, child.label...))
+		}
+		return nil, nil, false
+	}
+	return nil, nil, false
+}
+
+func collectRadix[V any](n *radixNode[V], path string, limit int, results *[]Entry[V]) {
+	if limit >= 0 && len(*results) >= limit {
+		return
+	}
+	if n.hasValue {
+		*results = append(*results, Entry[V]{Key: path, Value: n.value})
+	}
+	for _, child := range n.children {
+		if limit >= 0 && len(*results) >= limit {
+			return
+		}
+		collectRadix(child, path+string(child.label), limit, results)
+	}
+}
@@ -0,0 +1,84 @@
+package trie
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRadixTreeEdgeSplitting(t *testing.T) {
+	rt := NewRadixTree[int]()
+	rt.Insert("romane", 1)
+	rt.Insert("romanus", 2)
+	rt.Insert("romulus", 3)
+	rt.Insert("rom", 4)
+
+	if v, ok := rt.Get("romane"); !ok || v != 1 {
+		t.Errorf("Get(romane) = %d, %v, want 1, true", v, ok)
+	}
+	if v, ok := rt.Get("rom"); !ok || v != 4 {
+		t.Errorf("Get(rom) = %d, %v, want 4, true", v, ok)
+	}
+	if _, ok := rt.Get("roma"); ok {
+		t.Error("Get(roma) found a value, want false")
+	}
+}
+
+func TestRadixTreePrefixSearchMidEdge(t *testing.T) {
+	rt := NewRadixTree[int]()
+	for i, w := range []string{"test", "testing", "tester", "team"} {
+		rt.Insert(w, i)
+	}
+	got := keysOf(rt.PrefixSearch("tes"))
+	want := []string{"test", "tester", "testing"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PrefixSearch(tes) = %v, want %v", got, want)
+	}
+}
+
+func TestRadixTreeDeleteMergesNodes(t *testing.T) {
+	rt := NewRadixTree[int]()
+	rt.Insert("test", 1)
+	rt.Insert("testing", 2)
+
+	if !rt.Delete("test") {
+		t.Fatal("Delete(test) = false, want true")
+	}
+	if v, ok := rt.Get("testing"); !ok || v != 2 {
+		t.Errorf("Get(testing) after deleting test = %d, %v, want 2, true", v, ok)
+	}
+	if _, ok := rt.Get("test"); ok {
+		t.Error("Get(test) found a value after Delete")
+	}
+
+	// The tree should now consist of a single node for "testing" (the
+	// intermediate "test" node should have merged with its only child).
+	if got := keysOf(rt.PrefixSearch("")); !reflect.DeepEqual(got, []string{"testing"}) {
+		t.Errorf("PrefixSearch(\"\") after merge = %v, want [testing]", got)
+	}
+}
+
+func TestRadixTreeAutocompleteTopK(t *testing.T) {
+	rt := NewRadixTree[int]()
+	for i, w := range []string{"apple", "app", "application", "apply", "banana"} {
+		rt.Insert(w, i)
+	}
+	got := keysOf(rt.AutocompleteTopK("app", 2))
+	want := []string{"app", "apple"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AutocompleteTopK(app, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestRadixTreeEmptyKey(t *testing.T) {
+	rt := NewRadixTree[string]()
+	rt.Insert("", "root value")
+	if v, ok := rt.Get(""); !ok || v != "root value" {
+		t.Errorf("Get(\"\") = %q, %v, want \"root value\", true", v, ok)
+	}
+	if !rt.Delete("") {
+		t.Error("Delete(\"\") = false, want true")
+	}
+	if _, ok := rt.Get(""); ok {
+		t.Error("Get(\"\") found a value after Delete")
+	}
+}
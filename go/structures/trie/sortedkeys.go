@@ -0,0 +1,14 @@
+package trie
+
+import "sort"
+
+// sortedRuneKeys returns m's keys in ascending order, so tries iterate
+// their children in lexicographic order.
+func sortedRuneKeys[V any](m map[rune]*trieNode[V]) []rune {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
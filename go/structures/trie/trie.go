@@ -0,0 +1,165 @@
+// Package trie provides two generic, rune-aware string-keyed maps: Trie,
+// a classic one-child-per-character trie, and RadixTree, a compressed
+// (PATRICIA-style) variant that merges chains of single-child nodes into
+// one edge. Both support Insert/Delete/PrefixSearch/AutocompleteTopK
+// with the same signatures, and both iterate in lexicographic order of
+// the key's runes, so callers can swap one for the other — RadixTree
+// trades construction-time edge-splitting work for a much smaller node
+// count on keys that share long runs of common characters.
+package trie
+
+// Entry is one key/value pair returned by a prefix query.
+type Entry[V any] struct {
+	Key   string
+	Value V
+}
+
+type trieNode[V any] struct {
+	children map[rune]*trieNode[V]
+	value    V
+	hasValue bool
+}
+
+// Trie is a generic trie mapping string keys (matched rune by rune, so
+// multi-byte UTF-8 characters count as one step) to values of type V.
+type Trie[V any] struct {
+	root *trieNode[V]
+	size int
+}
+
+// NewTrie returns an empty Trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: newTrieNode[V]()}
+}
+
+func newTrieNode[V any]() *trieNode[V] {
+	return &trieNode[V]{children: make(map[rune]*trieNode[V])}
+}
+
+// Len returns the number of keys currently stored.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// Insert stores value under key, overwriting any existing value for that
+// key.
+func (t *Trie[V]) Insert(key string, value V) {
+	n := t.root
+	for _, r := range key {
+		child := n.children[r]
+		if child == nil {
+			child = newTrieNode[V]()
+			n.children[r] = child
+		}
+		n = child
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value = value
+	n.hasValue = true
+}
+
+// Get returns the value stored under key, if any.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.find(key)
+	if n == nil || !n.hasValue {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := t.root
+	for _, r := range key {
+		n = n.children[r]
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// Delete removes key, reporting whether it was present. Nodes left with
+// no value and no children are pruned back up the path, so deleting
+// every key under a prefix returns the trie to its empty-trie size.
+func (t *Trie[V]) Delete(key string) bool {
+	runes := []rune(key)
+	path := make([]*trieNode[V], 1, len(runes)+1)
+	path[0] = t.root
+
+	n := t.root
+	for _, r := range runes {
+		n = n.children[r]
+		if n == nil {
+			return false
+		}
+		path = append(path, n)
+	}
+	if !n.hasValue {
+		return false
+	}
+
+	var zero V
+	n.value = zero
+	n.hasValue = false
+	t.size--
+
+	for i := len(path) - 1; i > 0; i-- {
+		node := path[i]
+		if node.hasValue || len(node.children) > 0 {
+			break
+		}
+		delete(path[i-1].children, runes[i-1])
+	}
+	return true
+}
+
+// PrefixSearch returns every key with the given prefix (prefix itself
+// included, if it's a stored key), in lexicographic order.
+func (t *Trie[V]) PrefixSearch(prefix string) []Entry[V] {
+	n := t.find(prefix)
+	if n == nil {
+		return nil
+	}
+	var results []Entry[V]
+	collectTrie(n, prefix, -1, &results)
+	return results
+}
+
+// AutocompleteTopK returns up to k keys with the given prefix, in
+// lexicographic order. "Top" here means the first k completions in
+// lexicographic order, since Trie has no independent ranking signal for
+// its entries beyond the keys themselves.
+func (t *Trie[V]) AutocompleteTopK(prefix string, k int) []Entry[V] {
+	if k <= 0 {
+		return nil
+	}
+	n := t.find(prefix)
+	if n == nil {
+		return nil
+	}
+	var results []Entry[V]
+	collectTrie(n, prefix, k, &results)
+	return results
+}
+
+// collectTrie does a lexicographic-order DFS from n, appending every
+// value-holding node found (keyed by prefix plus the runes traversed so
+// far) to results, stopping early once results reaches limit entries
+// (a negative limit means unbounded).
+func collectTrie[V any](n *trieNode[V], prefix string, limit int, results *[]Entry[V]) {
+	if limit >= 0 && len(*results) >= limit {
+		return
+	}
+	if n.hasValue {
+		*results = append(*results, Entry[V]{Key: prefix, Value: n.value})
+	}
+	for _, r := range sortedRuneKeys(n.children) {
+		if limit >= 0 && len(*results) >= limit {
+			return
+		}
+		collectTrie(n.children[r], prefix+string(r), limit, results)
+	}
+}
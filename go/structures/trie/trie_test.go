@@ -0,0 +1,140 @@
+package trie
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func keysOf[V any](entries []Entry[V]) []string {
+	keys := make([]string, len(entries))
+	for i, e := range entries {
+		keys[i] = e.Key
+	}
+	return keys
+}
+
+func TestTrieInsertGetDelete(t *testing.T) {
+	tr := NewTrie[int]()
+	tr.Insert("cat", 1)
+	tr.Insert("car", 2)
+	tr.Insert("card", 3)
+	tr.Insert("care", 4)
+	tr.Insert("dog", 5)
+
+	if tr.Len() != 5 {
+		t.Fatalf("Len() = %d, want 5", tr.Len())
+	}
+	if v, ok := tr.Get("car"); !ok || v != 2 {
+		t.Errorf("Get(car) = %d, %v, want 2, true", v, ok)
+	}
+	if _, ok := tr.Get("ca"); ok {
+		t.Error("Get(ca) found a value, want false (ca was never inserted)")
+	}
+
+	if !tr.Delete("car") {
+		t.Error("Delete(car) = false, want true")
+	}
+	if tr.Delete("car") {
+		t.Error("second Delete(car) = true, want false")
+	}
+	if _, ok := tr.Get("car"); ok {
+		t.Error("Get(car) found a value after Delete")
+	}
+	if _, ok := tr.Get("card"); !ok {
+		t.Error("Get(card) should still be present after deleting car")
+	}
+	if tr.Len() != 4 {
+		t.Errorf("Len() after delete = %d, want 4", tr.Len())
+	}
+}
+
+func TestTriePrefixSearchLexicographic(t *testing.T) {
+	tr := NewTrie[int]()
+	for i, w := range []string{"cat", "car", "card", "care", "dog", "careful"} {
+		tr.Insert(w, i)
+	}
+	got := keysOf(tr.PrefixSearch("car"))
+	want := []string{"car", "card", "care", "careful"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PrefixSearch(car) = %v, want %v", got, want)
+	}
+	if got := tr.PrefixSearch("zzz"); got != nil {
+		t.Errorf("PrefixSearch(zzz) = %v, want nil", got)
+	}
+}
+
+func TestTrieAutocompleteTopK(t *testing.T) {
+	tr := NewTrie[int]()
+	for i, w := range []string{"apple", "app", "application", "apply", "banana"} {
+		tr.Insert(w, i)
+	}
+	got := keysOf(tr.AutocompleteTopK("app", 2))
+	want := []string{"app", "apple"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AutocompleteTopK(app, 2) = %v, want %v", got, want)
+	}
+	if got := tr.AutocompleteTopK("app", 0); got != nil {
+		t.Errorf("AutocompleteTopK(app, 0) = %v, want nil", got)
+	}
+}
+
+func TestTrieRuneAwareKeys(t *testing.T) {
+	tr := NewTrie[string]()
+	tr.Insert("héllo", "greeting")
+	tr.Insert("héllo world", "greeting2")
+	if v, ok := tr.Get("héllo"); !ok || v != "greeting" {
+		t.Errorf("Get(héllo) = %q, %v, want \"greeting\", true", v, ok)
+	}
+	got := keysOf(tr.PrefixSearch("héllo"))
+	want := []string{"héllo", "héllo world"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PrefixSearch(héllo) = %v, want %v", got, want)
+	}
+}
+
+// radixTreeStore and trieStore wrap each implementation's Insert/Delete/
+// Get/PrefixSearch/AutocompleteTopK behind a common func set so the same
+// test bodies can exercise both.
+type store[V any] interface {
+	Insert(key string, value V)
+	Get(key string) (V, bool)
+	Delete(key string) bool
+	PrefixSearch(prefix string) []Entry[V]
+	AutocompleteTopK(prefix string, k int) []Entry[V]
+}
+
+func testAgainstWords(t *testing.T, s store[int], words []string) {
+	for i, w := range words {
+		s.Insert(w, i)
+	}
+	for i, w := range words {
+		if v, ok := s.Get(w); !ok || v != i {
+			t.Errorf("Get(%q) = %d, %v, want %d, true", w, v, ok, i)
+		}
+	}
+
+	sortedWords := append([]string{}, words...)
+	sort.Strings(sortedWords)
+	got := keysOf(s.PrefixSearch(""))
+	sortedGot := append([]string{}, got...)
+	sort.Strings(sortedGot)
+	if !reflect.DeepEqual(sortedGot, sortedWords) {
+		t.Errorf("PrefixSearch(\"\") keys = %v, want %v", sortedGot, sortedWords)
+	}
+
+	for _, w := range words {
+		if !s.Delete(w) {
+			t.Errorf("Delete(%q) = false, want true", w)
+		}
+		if _, ok := s.Get(w); ok {
+			t.Errorf("Get(%q) found a value after Delete", w)
+		}
+	}
+}
+
+func TestTrieAndRadixTreeAgreeOnWordSet(t *testing.T) {
+	words := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rome"}
+	testAgainstWords(t, NewTrie[int](), words)
+	testAgainstWords(t, NewRadixTree[int](), words)
+}
@@ -0,0 +1,110 @@
+// Package virtualtree builds the compressed auxiliary ("virtual") tree
+// over a subset of a larger tree's vertices: the smallest tree that
+// contains those vertices, their pairwise LCAs, and the same
+// ancestor/descendant relationships as the full tree. Once built it's
+// small enough — O(len(nodes)) vertices — to run tree DP over
+// per-query, even when the underlying tree has millions of nodes and
+// any one query only cares about a handful of them.
+//
+// Construction sorts the requested nodes by Euler-tour entry time, uses
+// structures/lca's offline batch algorithm to find the LCA of every
+// consecutive pair in that order (the only LCAs a virtual tree ever
+// needs), and then assembles parent/child edges with the classic
+// sort-and-stack method: ancestor checks along the way are answered
+// directly from each node's entry/exit time interval rather than with
+// further LCA queries.
+package virtualtree
+
+import (
+	"sort"
+
+	"github.com/ghostmkg/dsa-code/go/structures/lca"
+)
+
+// Tree is a compressed auxiliary tree. Parent and Children are keyed by
+// the original tree's vertex IDs; Root has no entry in Parent.
+type Tree struct {
+	Root     int
+	Parent   map[int]int
+	Children map[int][]int
+}
+
+// eulerTimes runs one DFS over adj rooted at root, returning each node's
+// entry (tin) and exit (tout) times; a is an ancestor of b (inclusive)
+// exactly when tin[a] <= tin[b] and tout[b] <= tout[a].
+func eulerTimes(n, root int, adj [][]int) (tin, tout []int) {
+	tin = make([]int, n)
+	tout = make([]int, n)
+	timer := 0
+
+	var dfs func(u, parent int)
+	dfs = func(u, parent int) {
+		tin[u] = timer
+		timer++
+		for _, v := range adj[u] {
+			if v != parent {
+				dfs(v, u)
+			}
+		}
+		tout[u] = timer
+		timer++
+	}
+	dfs(root, -1)
+	return tin, tout
+}
+
+// BuildVirtualTree constructs the virtual tree over nodes (a subset of
+// the full tree's vertices 0..n-1, described by the undirected adjacency
+// list adj and rooted at root). BuildVirtualTree panics if nodes is
+// empty.
+func BuildVirtualTree(n, root int, adj [][]int, nodes []int) *Tree {
+	if len(nodes) == 0 {
+		panic("virtualtree: nodes must be non-empty")
+	}
+
+	tin, tout := eulerTimes(n, root, adj)
+
+	sorted := append([]int{}, nodes...)
+	sort.Slice(sorted, func(i, j int) bool { return tin[sorted[i]] < tin[sorted[j]] })
+
+	queries := make([]lca.Query, 0, len(sorted)-1)
+	for i := 0; i+1 < len(sorted); i++ {
+		queries = append(queries, lca.Query{U: sorted[i], V: sorted[i+1]})
+	}
+	lcas := lca.OfflineLCA(n, root, adj, queries)
+
+	seen := make(map[int]bool, len(sorted)+len(lcas))
+	var merged []int
+	for _, v := range sorted {
+		if !seen[v] {
+			seen[v] = true
+			merged = append(merged, v)
+		}
+	}
+	for _, v := range lcas {
+		if !seen[v] {
+			seen[v] = true
+			merged = append(merged, v)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool { return tin[merged[i]] < tin[merged[j]] })
+
+	isAncestor := func(a, b int) bool {
+		return tin[a] <= tin[b] && tout[b] <= tout[a]
+	}
+
+	t := &Tree{Root: merged[0], Parent: map[int]int{}, Children: map[int][]int{}}
+	stack := []int{merged[0]}
+	for _, v := range merged[1:] {
+		for len(stack) > 0 && !isAncestor(stack[len(stack)-1], v) {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			parent := stack[len(stack)-1]
+			t.Parent[v] = parent
+			t.Children[parent] = append(t.Children[parent], v)
+		}
+		stack = append(stack, v)
+	}
+	return t
+}
@@ -0,0 +1,108 @@
+package virtualtree
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+// tree:
+//
+//	       0
+//	     / | \
+//	    1  2  3
+//	   / \    |
+//	  4   5   6
+//	 /
+//	7
+func testTree() [][]int {
+	adj := make([][]int, 8)
+	edges := [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {3, 6}, {4, 7}}
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	return adj
+}
+
+func allVertices(t *Tree) []int {
+	set := map[int]bool{t.Root: true}
+	for v := range t.Parent {
+		set[v] = true
+	}
+	var out []int
+	for v := range set {
+		out = append(out, v)
+	}
+	sort.Ints(out)
+	return out
+}
+
+func TestBuildVirtualTreeIncludesRequestedNodesAndTheirLCAs(t *testing.T) {
+	adj := testTree()
+	vt := BuildVirtualTree(8, 0, adj, []int{7, 5, 6})
+
+	// LCA(7,5) = 1, LCA(1,6) = 0, so the virtual tree must contain
+	// {0, 1, 5, 6, 7} even though only {7, 5, 6} were requested.
+	want := []int{0, 1, 5, 6, 7}
+	got := allVertices(vt)
+	if !slices.Equal(got, want) {
+		t.Errorf("vertices = %v, want %v", got, want)
+	}
+	if vt.Root != 0 {
+		t.Errorf("Root = %d, want 0", vt.Root)
+	}
+}
+
+func TestBuildVirtualTreePreservesAncestry(t *testing.T) {
+	adj := testTree()
+	vt := BuildVirtualTree(8, 0, adj, []int{7, 5, 6})
+
+	cases := []struct {
+		child, parent int
+	}{
+		{1, 0}, // 1 is a direct child of the virtual root
+		{6, 0},
+		{5, 1},
+		{7, 1},
+	}
+	for _, c := range cases {
+		if got := vt.Parent[c.child]; got != c.parent {
+			t.Errorf("Parent[%d] = %d, want %d", c.child, got, c.parent)
+		}
+	}
+}
+
+func TestBuildVirtualTreeSingleNode(t *testing.T) {
+	adj := testTree()
+	vt := BuildVirtualTree(8, 0, adj, []int{4})
+	if vt.Root != 4 {
+		t.Errorf("Root = %d, want 4", vt.Root)
+	}
+	if len(vt.Parent) != 0 {
+		t.Errorf("Parent = %v, want empty (single-node virtual tree has no edges)", vt.Parent)
+	}
+}
+
+func TestBuildVirtualTreeAllLeaves(t *testing.T) {
+	adj := testTree()
+	// every leaf of the full tree
+	vt := BuildVirtualTree(8, 0, adj, []int{7, 5, 2, 6})
+
+	// LCA(5,2) = 0 and LCA(2,6) = 0, so node 3 (the parent of leaf 6)
+	// never needs to appear — 0 already connects everything directly.
+	want := []int{0, 1, 2, 5, 6, 7}
+	got := allVertices(vt)
+	if !slices.Equal(got, want) {
+		t.Errorf("vertices = %v, want %v", got, want)
+	}
+}
+
+func TestBuildVirtualTreePanicsOnEmptyNodes(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("BuildVirtualTree() did not panic for empty nodes")
+		}
+	}()
+	BuildVirtualTree(8, 0, testTree(), nil)
+}
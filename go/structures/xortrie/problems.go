@@ -0,0 +1,51 @@
+package xortrie
+
+// MaxXorPair returns the maximum value of nums[i]^nums[j] over all pairs
+// i != j, built by inserting each number into a Trie and, before
+// inserting it, asking the trie for the best XOR against every number
+// already inserted — O(n * bitWidth) instead of the O(n^2) brute force.
+// It reports false if nums has fewer than two elements.
+func MaxXorPair(nums []int) (int, bool) {
+	if len(nums) < 2 {
+		return 0, false
+	}
+
+	t := New()
+	t.Insert(nums[0])
+	best := 0
+	for _, x := range nums[1:] {
+		if candidate, ok := t.MaxXorWith(x); ok && candidate > best {
+			best = candidate
+		}
+		t.Insert(x)
+	}
+	return best, true
+}
+
+// MaxXorSubarray returns the maximum XOR of any non-empty contiguous
+// subarray of nums. It relies on the fact that the XOR of nums[i:j] is
+// prefix[j]^prefix[i], where prefix[k] is the XOR of nums[:k] (and
+// prefix[0] = 0), so the problem reduces to MaxXorPair over the prefix
+// XOR array — including prefix[0], so a subarray starting at index 0
+// is considered too.
+func MaxXorSubarray(nums []int) int {
+	if len(nums) == 0 {
+		return 0
+	}
+
+	prefix := make([]int, len(nums)+1)
+	for i, x := range nums {
+		prefix[i+1] = prefix[i] ^ x
+	}
+
+	t := New()
+	t.Insert(prefix[0])
+	best := 0
+	for _, p := range prefix[1:] {
+		if candidate, ok := t.MaxXorWith(p); ok && candidate > best {
+			best = candidate
+		}
+		t.Insert(p)
+	}
+	return best
+}
@@ -0,0 +1,104 @@
+// Package xortrie implements a binary trie over non-negative integers,
+// keyed bit by bit from the most significant bit down, so that the
+// value already in the trie whose XOR with a query is largest can be
+// found in O(bitWidth) by always preferring the child that disagrees
+// with the query's current bit.
+//
+// MaxXorPair and MaxXorSubarray are built directly on top of it, since
+// both classic problems reduce to "insert values one at a time, and
+// before inserting each one, ask the trie for the best XOR against
+// everything inserted so far."
+package xortrie
+
+// bitWidth bounds every value handled by Trie to 32 bits, which covers
+// the usual range for the maximum-XOR-pair/subarray problems this
+// package targets without paying for 64 levels on every query.
+const bitWidth = 32
+
+type trieNode struct {
+	children [2]*trieNode
+	count    int
+}
+
+// Trie is a binary trie over non-negative integers supporting Insert,
+// Remove, and MaxXorWith.
+type Trie struct {
+	root *trieNode
+	size int
+}
+
+// New creates an empty Trie.
+func New() *Trie {
+	return &Trie{root: &trieNode{}}
+}
+
+// Len returns the number of values currently in the trie, counting
+// duplicates.
+func (t *Trie) Len() int {
+	return t.size
+}
+
+func bit(x int, i int) int {
+	return (x >> i) & 1
+}
+
+// Insert adds x to the trie.
+func (t *Trie) Insert(x int) {
+	n := t.root
+	for i := bitWidth - 1; i >= 0; i-- {
+		b := bit(x, i)
+		if n.children[b] == nil {
+			n.children[b] = &trieNode{}
+		}
+		n = n.children[b]
+		n.count++
+	}
+	t.size++
+}
+
+// Remove removes one occurrence of x from the trie, if present,
+// reporting whether it found one to remove.
+func (t *Trie) Remove(x int) bool {
+	if !t.contains(x) {
+		return false
+	}
+	n := t.root
+	for i := bitWidth - 1; i >= 0; i-- {
+		n = n.children[bit(x, i)]
+		n.count--
+	}
+	t.size--
+	return true
+}
+
+func (t *Trie) contains(x int) bool {
+	n := t.root
+	for i := bitWidth - 1; i >= 0; i-- {
+		n = n.children[bit(x, i)]
+		if n == nil || n.count == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MaxXorWith returns the maximum value of x^v over every v currently in
+// the trie. It reports false if the trie is empty.
+func (t *Trie) MaxXorWith(x int) (int, bool) {
+	if t.size == 0 {
+		return 0, false
+	}
+	n := t.root
+	result := 0
+	for i := bitWidth - 1; i >= 0; i-- {
+		b := bit(x, i)
+		want := 1 - b
+		if n.children[want] != nil && n.children[want].count > 0 {
+			result |= 1 << i
+			n = n.children[want]
+		} else {
+			n = n.children[b]
+		}
+	}
+	return result, true
+}
@@ -0,0 +1,93 @@
+package xortrie
+
+import "testing"
+
+func TestTrieMaxXorWith(t *testing.T) {
+	trie := New()
+	for _, v := range []int{3, 10, 5, 25, 2, 8} {
+		trie.Insert(v)
+	}
+
+	if got, ok := trie.MaxXorWith(5); !ok || got != 28 {
+		t.Errorf("MaxXorWith(5) = (%d, %v), want (28, true)", got, ok)
+	}
+}
+
+func TestTrieMaxXorWithEmpty(t *testing.T) {
+	trie := New()
+	if _, ok := trie.MaxXorWith(5); ok {
+		t.Errorf("MaxXorWith() on empty trie reported a value")
+	}
+}
+
+func TestTrieInsertAndRemove(t *testing.T) {
+	trie := New()
+	trie.Insert(5)
+	trie.Insert(5)
+	if trie.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", trie.Len())
+	}
+
+	if !trie.Remove(5) {
+		t.Fatalf("Remove(5) = false, want true")
+	}
+	if trie.Len() != 1 {
+		t.Errorf("Len() after one Remove = %d, want 1", trie.Len())
+	}
+
+	if trie.Remove(42) {
+		t.Errorf("Remove(42) = true, want false for a value never inserted")
+	}
+
+	if !trie.Remove(5) {
+		t.Fatalf("Remove(5) (second) = false, want true")
+	}
+	if _, ok := trie.MaxXorWith(0); ok {
+		t.Errorf("MaxXorWith() after removing the only value reported a value")
+	}
+}
+
+func TestMaxXorPair(t *testing.T) {
+	got, ok := MaxXorPair([]int{3, 10, 5, 25, 2, 8})
+	if !ok || got != 28 {
+		t.Errorf("MaxXorPair() = (%d, %v), want (28, true)", got, ok)
+	}
+}
+
+func TestMaxXorPairTooFewElements(t *testing.T) {
+	if _, ok := MaxXorPair([]int{5}); ok {
+		t.Errorf("MaxXorPair([5]) reported a value, want false")
+	}
+	if _, ok := MaxXorPair(nil); ok {
+		t.Errorf("MaxXorPair(nil) reported a value, want false")
+	}
+}
+
+func TestMaxXorSubarray(t *testing.T) {
+	// Brute force: every subarray's XOR, cross-checked against the trie version.
+	nums := []int{8, 1, 2, 12, 7, 10, 13}
+	want := bruteForceMaxXorSubarray(nums)
+	if got := MaxXorSubarray(nums); got != want {
+		t.Errorf("MaxXorSubarray() = %d, want %d", got, want)
+	}
+}
+
+func TestMaxXorSubarrayEmpty(t *testing.T) {
+	if got := MaxXorSubarray(nil); got != 0 {
+		t.Errorf("MaxXorSubarray(nil) = %d, want 0", got)
+	}
+}
+
+func bruteForceMaxXorSubarray(nums []int) int {
+	best := 0
+	for i := range nums {
+		x := 0
+		for j := i; j < len(nums); j++ {
+			x ^= nums[j]
+			if x > best {
+				best = x
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,60 @@
+package bintreeheight
+
+import (
+	"cmp"
+
+	"github.com/ghostmkg/dsa-code/go/iterutil"
+)
+
+type Node[T cmp.Ordered] struct {
+	data  T
+	left  *Node[T]
+	right *Node[T]
+}
+
+// Insert node (BST insert)
+func Insert[T cmp.Ordered](root *Node[T], val T) *Node[T] {
+	if root == nil {
+		return &Node[T]{data: val}
+	}
+	if val < root.data {
+		root.left = Insert(root.left, val)
+	} else {
+		root.right = Insert(root.right, val)
+	}
+	return root
+}
+
+// Find height of binary tree
+func Height[T cmp.Ordered](root *Node[T]) int {
+	if root == nil {
+		return 0
+	}
+	leftHeight := Height(root.left)
+	rightHeight := Height(root.right)
+
+	if leftHeight > rightHeight {
+		return leftHeight + 1
+	}
+	return rightHeight + 1
+}
+
+// All returns an in-order iterator over the tree rooted at root.
+func All[T cmp.Ordered](root *Node[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		inorder(root, yield)
+	}
+}
+
+func inorder[T cmp.Ordered](n *Node[T], yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	if !inorder(n.left, yield) {
+		return false
+	}
+	if !yield(n.data) {
+		return false
+	}
+	return inorder(n.right, yield)
+}
@@ -0,0 +1,13 @@
+package bintreeheight
+
+import "fmt"
+
+func ExampleHeight() {
+	var root *Node[int]
+	for _, v := range []int{10, 5, 20, 3, 7, 15} {
+		root = Insert(root, v)
+	}
+
+	fmt.Println(Height(root))
+	// Output: 3
+}
@@ -0,0 +1,60 @@
+package bintreeheight
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// nodeJSON mirrors Node's private fields with exported ones so
+// encoding/json and encoding/gob, which can't see unexported fields, have
+// something to (de)serialize.
+type nodeJSON[T cmp.Ordered] struct {
+	Data  T        `json:"data"`
+	Left  *Node[T] `json:"left,omitempty"`
+	Right *Node[T] `json:"right,omitempty"`
+}
+
+// MarshalJSON encodes the subtree rooted at n, preserving its shape.
+func (n *Node[T]) MarshalJSON() ([]byte, error) {
+	if n == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(nodeJSON[T]{Data: n.data, Left: n.left, Right: n.right})
+}
+
+// UnmarshalJSON decodes a subtree previously written by MarshalJSON.
+func (n *Node[T]) UnmarshalJSON(b []byte) error {
+	var aux nodeJSON[T]
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+	n.data, n.left, n.right = aux.Data, aux.Left, aux.Right
+	return nil
+}
+
+// GobEncode encodes the subtree rooted at n for encoding/gob.
+func (n *Node[T]) GobEncode() ([]byte, error) {
+	if n == nil {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(nodeJSON[T]{Data: n.data, Left: n.left, Right: n.right}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes a subtree previously written by GobEncode.
+func (n *Node[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var aux nodeJSON[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&aux); err != nil {
+		return err
+	}
+	n.data, n.left, n.right = aux.Data, aux.Left, aux.Right
+	return nil
+}
@@ -0,0 +1,72 @@
+package bintreeheight
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func collectAll[T cmp.Ordered](root *Node[T]) []T {
+	var out []T
+	All(root)(func(v T) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
+
+func TestHeight(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   int
+	}{
+		{"empty tree", nil, 0},
+		{"single node", []int{5}, 1},
+		{"balanced", []int{5, 3, 8, 1, 4, 7, 9}, 3},
+		{"left skewed", []int{5, 4, 3, 2, 1}, 5},
+		{"right skewed", []int{1, 2, 3, 4, 5}, 5},
+		{"duplicates", []int{5, 5, 5}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var root *Node[int]
+			for _, v := range tt.values {
+				root = Insert(root, v)
+			}
+			if got := Height(root); got != tt.want {
+				t.Errorf("Height(%v) = %d, want %d", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAll(t *testing.T) {
+	var root *Node[int]
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		root = Insert(root, v)
+	}
+
+	want := []int{1, 3, 4, 5, 8}
+	if got := collectAll(root); !slices.Equal(got, want) {
+		t.Errorf("collectAll(root) = %v, want %v", got, want)
+	}
+
+	t.Run("empty tree yields nothing", func(t *testing.T) {
+		if got := collectAll[int](nil); len(got) != 0 {
+			t.Errorf("collectAll(nil) = %v, want empty", got)
+		}
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		var sroot *Node[string]
+		for _, v := range []string{"banana", "apple", "cherry"} {
+			sroot = Insert(sroot, v)
+		}
+		want := []string{"apple", "banana", "cherry"}
+		if got := collectAll(sroot); !slices.Equal(got, want) {
+			t.Errorf("collectAll(sroot) = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package bintreelevelorder
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/iterutil"
+)
+
+type Node[T cmp.Ordered] struct {
+	data  T
+	left  *Node[T]
+	right *Node[T]
+}
+
+// Insert node (BST insert)
+func Insert[T cmp.Ordered](root *Node[T], val T) *Node[T] {
+	if root == nil {
+		return &Node[T]{data: val}
+	}
+	if val < root.data {
+		root.left = Insert(root.left, val)
+	} else {
+		root.right = Insert(root.right, val)
+	}
+	return root
+}
+
+// Level Order Traversal (BFS)
+func LevelOrder[T cmp.Ordered](root *Node[T]) {
+	if root == nil {
+		return
+	}
+	queue := []*Node[T]{root}
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:] // dequeue
+		fmt.Print(curr.data, " ")
+
+		if curr.left != nil {
+			queue = append(queue, curr.left)
+		}
+		if curr.right != nil {
+			queue = append(queue, curr.right)
+		}
+	}
+}
+
+// All returns a level-order (BFS) iterator over the tree rooted at root.
+func All[T cmp.Ordered](root *Node[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		if root == nil {
+			return
+		}
+		queue := []*Node[T]{root}
+
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			if !yield(curr.data) {
+				return
+			}
+
+			if curr.left != nil {
+				queue = append(queue, curr.left)
+			}
+			if curr.right != nil {
+				queue = append(queue, curr.right)
+			}
+		}
+	}
+}
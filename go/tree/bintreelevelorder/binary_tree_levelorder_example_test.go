@@ -0,0 +1,11 @@
+package bintreelevelorder
+
+func ExampleLevelOrder() {
+	var root *Node[int]
+	for _, v := range []int{10, 5, 20, 3, 7, 15, 25} {
+		root = Insert(root, v)
+	}
+
+	LevelOrder(root)
+	// Output: 10 5 20 3 7 15 25
+}
@@ -0,0 +1,70 @@
+package bintreelevelorder
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func buildTestTree() *Node[int] {
+	var root *Node[int]
+	for _, v := range []int{5, 3, 8, 1} {
+		root = Insert(root, v)
+	}
+	return root
+}
+
+func TestNodeJSONRoundTrip(t *testing.T) {
+	root := buildTestTree()
+
+	b, err := json.Marshal(root)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got Node[int]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if !sameShape(root, &got) {
+		t.Errorf("round-tripped tree differs from original\nwant: %v\ngot:  %v", collectAll(root), collectAll(&got))
+	}
+}
+
+func TestNodeJSONNil(t *testing.T) {
+	var n *Node[int]
+	b, err := json.Marshal(n)
+	if err != nil {
+		t.Fatalf("json.Marshal(nil) error = %v", err)
+	}
+	if string(b) != "null" {
+		t.Errorf("json.Marshal(nil) = %q, want %q", b, "null")
+	}
+}
+
+func TestNodeGobRoundTrip(t *testing.T) {
+	root := buildTestTree()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(root); err != nil {
+		t.Fatalf("gob encode error = %v", err)
+	}
+
+	var got Node[int]
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decode error = %v", err)
+	}
+
+	if !sameShape(root, &got) {
+		t.Errorf("round-tripped tree differs from original\nwant: %v\ngot:  %v", collectAll(root), collectAll(&got))
+	}
+}
+
+func sameShape(a, b *Node[int]) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.data == b.data && sameShape(a.left, b.left) && sameShape(a.right, b.right)
+}
@@ -0,0 +1,73 @@
+package bintreelevelorder
+
+import (
+	"bytes"
+	"cmp"
+	"io"
+	"os"
+	"slices"
+	"testing"
+)
+
+func collectAll[T cmp.Ordered](root *Node[T]) []T {
+	var out []T
+	All(root)(func(v T) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
+
+func TestAll(t *testing.T) {
+	var root *Node[int]
+	for _, v := range []int{5, 3, 8, 1, 4, 7, 9} {
+		root = Insert(root, v)
+	}
+
+	want := []int{5, 3, 8, 1, 4, 7, 9}
+	if got := collectAll(root); !slices.Equal(got, want) {
+		t.Errorf("collectAll(root) = %v, want %v", got, want)
+	}
+
+	t.Run("empty tree yields nothing", func(t *testing.T) {
+		if got := collectAll[int](nil); len(got) != 0 {
+			t.Errorf("collectAll(nil) = %v, want empty", got)
+		}
+	})
+
+	t.Run("single node", func(t *testing.T) {
+		single := Insert[int](nil, 42)
+		if got := collectAll(single); !slices.Equal(got, []int{42}) {
+			t.Errorf("collectAll(single) = %v, want [42]", got)
+		}
+	})
+}
+
+func TestLevelOrder(t *testing.T) {
+	var root *Node[int]
+	for _, v := range []int{5, 3, 8} {
+		root = Insert(root, v)
+	}
+
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+
+	LevelOrder(root)
+
+	w.Close()
+	os.Stdout = orig
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("io.Copy: %v", err)
+	}
+
+	want := "5 3 8 "
+	if got := buf.String(); got != want {
+		t.Errorf("LevelOrder output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,126 @@
+package bintreetraversal
+
+import (
+	"fmt"
+
+	"github.com/ghostmkg/dsa-code/go/iterutil"
+)
+
+// Node structure
+type Node struct {
+	Value int
+	Data  int
+
+	Left  *Node
+	Right *Node
+}
+
+// Pre-order traversal: root -> left -> right
+func PreOrder(node *Node) {
+	if node != nil {
+		fmt.Print(node.Value, " ")
+		PreOrder(node.Left)
+		PreOrder(node.Right)
+	}
+}
+
+// In-order traversal: left -> root -> right
+func InOrder(node *Node) {
+	if node != nil {
+		InOrder(node.Left)
+		fmt.Print(node.Value, " ")
+		InOrder(node.Right)
+	}
+}
+
+// Post-order traversal: left -> right -> root
+func PostOrder(node *Node) {
+	if node != nil {
+		PostOrder(node.Left)
+		PostOrder(node.Right)
+		fmt.Print(node.Value, " ")
+	}
+}
+
+// Level-order traversal (BFS)
+func LevelOrder(root *Node) {
+	if root == nil {
+		return
+	}
+
+	queue := []*Node{root}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		fmt.Print(current.Value, " ")
+
+		if current.Left != nil {
+			queue = append(queue, current.Left)
+		}
+		if current.Right != nil {
+			queue = append(queue, current.Right)
+		}
+	}
+}
+
+// Insert into binary tree (simple BST insert)
+func Insert(root *Node, val int) *Node {
+	if root == nil {
+		return &Node{Data: val}
+	}
+	if val < root.Data {
+		root.Left = Insert(root.Left, val)
+	} else {
+		root.Right = Insert(root.Right, val)
+	}
+	return root
+}
+
+// Inorder Traversal
+func Inorder(root *Node) {
+	if root != nil {
+		Inorder(root.Left)
+		fmt.Printf("%d ", root.Data)
+		Inorder(root.Right)
+	}
+}
+
+// Preorder Traversal
+func Preorder(root *Node) {
+	if root != nil {
+		fmt.Printf("%d ", root.Data)
+		Preorder(root.Left)
+		Preorder(root.Right)
+	}
+}
+
+// Postorder Traversal
+func Postorder(root *Node) {
+	if root != nil {
+		Postorder(root.Left)
+		Postorder(root.Right)
+		fmt.Printf("%d ", root.Data)
+
+	}
+}
+
+// InorderSeq returns an in-order iterator over the BST rooted at root.
+func InorderSeq(root *Node) iterutil.Seq[int] {
+	return func(yield func(int) bool) {
+		inorderSeq(root, yield)
+	}
+}
+
+func inorderSeq(n *Node, yield func(int) bool) bool {
+	if n == nil {
+		return true
+	}
+	if !inorderSeq(n.Left, yield) {
+		return false
+	}
+	if !yield(n.Data) {
+		return false
+	}
+	return inorderSeq(n.Right, yield)
+}
@@ -0,0 +1,60 @@
+package bintreetraversal
+
+func exampleTree() *Node {
+	root := &Node{Value: 1}
+	root.Left = &Node{Value: 2}
+	root.Right = &Node{Value: 3}
+	root.Left.Left = &Node{Value: 4}
+	root.Left.Right = &Node{Value: 5}
+	return root
+}
+
+func ExamplePreOrder() {
+	PreOrder(exampleTree())
+	// Output: 1 2 4 5 3
+}
+
+func ExampleInOrder() {
+	InOrder(exampleTree())
+	// Output: 4 2 5 1 3
+}
+
+func ExamplePostOrder() {
+	PostOrder(exampleTree())
+	// Output: 4 5 2 3 1
+}
+
+func ExampleLevelOrder() {
+	LevelOrder(exampleTree())
+	// Output: 1 2 3 4 5
+}
+
+func ExampleInorder() {
+	var bst *Node
+	for _, v := range []int{10, 5, 20, 3, 7, 15, 25} {
+		bst = Insert(bst, v)
+	}
+
+	Inorder(bst)
+	// Output: 3 5 7 10 15 20 25
+}
+
+func ExamplePreorder() {
+	var bst *Node
+	for _, v := range []int{10, 5, 20, 3, 7, 15, 25} {
+		bst = Insert(bst, v)
+	}
+
+	Preorder(bst)
+	// Output: 10 5 3 7 20 15 25
+}
+
+func ExamplePostorder() {
+	var bst *Node
+	for _, v := range []int{10, 5, 20, 3, 7, 15, 25} {
+		bst = Insert(bst, v)
+	}
+
+	Postorder(bst)
+	// Output: 3 7 5 15 25 20 10
+}
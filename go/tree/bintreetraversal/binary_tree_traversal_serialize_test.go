@@ -0,0 +1,63 @@
+package bintreetraversal
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+// Node's fields are already exported, so encoding/json and encoding/gob
+// can (de)serialize it without any custom Marshal/Unmarshal methods; these
+// tests just pin down that the default round-trip preserves shape.
+
+func buildTestNode() *Node {
+	var root *Node
+	for _, v := range []int{5, 3, 8, 1} {
+		root = Insert(root, v)
+	}
+	return root
+}
+
+func TestNodeJSONRoundTrip(t *testing.T) {
+	root := buildTestNode()
+
+	b, err := json.Marshal(root)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var got Node
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if !sameDataShape(root, &got) {
+		t.Errorf("round-tripped tree differs from original")
+	}
+}
+
+func TestNodeGobRoundTrip(t *testing.T) {
+	root := buildTestNode()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(root); err != nil {
+		t.Fatalf("gob encode error = %v", err)
+	}
+
+	var got Node
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob decode error = %v", err)
+	}
+
+	if !sameDataShape(root, &got) {
+		t.Errorf("round-tripped tree differs from original")
+	}
+}
+
+func sameDataShape(a, b *Node) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Data == b.Data && sameDataShape(a.Left, b.Left) && sameDataShape(a.Right, b.Right)
+}
@@ -0,0 +1,119 @@
+package bintreetraversal
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"slices"
+	"testing"
+)
+
+func captureOutput(t *testing.T, fn func()) string {
+	t.Helper()
+
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+
+	fn()
+
+	w.Close()
+	os.Stdout = orig
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		t.Fatalf("io.Copy: %v", err)
+	}
+	return buf.String()
+}
+
+// build returns: root -> Value=1, left -> Value=2, right -> Value=3
+func buildValueTree() *Node {
+	return &Node{Value: 1, Left: &Node{Value: 2}, Right: &Node{Value: 3}}
+}
+
+func TestValueTraversals(t *testing.T) {
+	root := buildValueTree()
+
+	tests := []struct {
+		name string
+		fn   func(*Node)
+		want string
+	}{
+		{"PreOrder", PreOrder, "1 2 3 "},
+		{"InOrder", InOrder, "2 1 3 "},
+		{"PostOrder", PostOrder, "2 3 1 "},
+		{"LevelOrder", LevelOrder, "1 2 3 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := captureOutput(t, func() { tt.fn(root) }); got != tt.want {
+				t.Errorf("%s output = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("nil tree", func(t *testing.T) {
+		if got := captureOutput(t, func() { PreOrder(nil) }); got != "" {
+			t.Errorf("PreOrder(nil) output = %q, want empty", got)
+		}
+	})
+}
+
+func TestInsertAndDataTraversals(t *testing.T) {
+	var root *Node
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		root = Insert(root, v)
+	}
+
+	tests := []struct {
+		name string
+		fn   func(*Node)
+		want string
+	}{
+		{"Inorder", Inorder, "1 3 4 5 8 "},
+		{"Preorder", Preorder, "5 3 1 4 8 "},
+		{"Postorder", Postorder, "1 4 3 8 5 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := captureOutput(t, func() { tt.fn(root) }); got != tt.want {
+				t.Errorf("%s output = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInorderSeq(t *testing.T) {
+	var root *Node
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		root = Insert(root, v)
+	}
+
+	var got []int
+	InorderSeq(root)(func(v int) bool {
+		got = append(got, v)
+		return true
+	})
+
+	want := []int{1, 3, 4, 5, 8}
+	if !slices.Equal(got, want) {
+		t.Errorf("InorderSeq(root) = %v, want %v", got, want)
+	}
+
+	t.Run("early stop", func(t *testing.T) {
+		var got []int
+		InorderSeq(root)(func(v int) bool {
+			got = append(got, v)
+			return len(got) < 2
+		})
+		if !slices.Equal(got, []int{1, 3}) {
+			t.Errorf("InorderSeq(root) with early stop = %v, want [1 3]", got)
+		}
+	})
+}
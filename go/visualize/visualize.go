@@ -0,0 +1,203 @@
+// Package visualize renders the repository's trees, graphs, and array/DP
+// tables as Graphviz DOT (for rendering to SVG with `dot -Tsvg`) or as plain
+// ASCII for a terminal, and offers a small Tracer for capturing a sequence
+// of such renders so an algorithm's progress can be replayed frame by
+// frame (e.g. the KMP LPS table filling in, or a BFS frontier expanding).
+package visualize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TreeNode is the minimal shape a tree needs to expose to be rendered.
+// Existing tree packages keep their fields unexported, so callers adapt
+// their node type to this interface rather than visualize reaching into
+// package-private structs.
+type TreeNode interface {
+	Label() string
+	Children() []TreeNode
+}
+
+// TreeDOT renders a tree as a Graphviz DOT digraph. A nil root renders as
+// an empty graph.
+func TreeDOT(root TreeNode) string {
+	var b strings.Builder
+	b.WriteString("digraph Tree {\n")
+	if root != nil {
+		id := 0
+		var walk func(n TreeNode) int
+		walk = func(n TreeNode) int {
+			myID := id
+			id++
+			fmt.Fprintf(&b, "  n%d [label=%q];\n", myID, n.Label())
+			for _, c := range n.Children() {
+				childID := walk(c)
+				fmt.Fprintf(&b, "  n%d -> n%d;\n", myID, childID)
+			}
+			return myID
+		}
+		walk(root)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// TreeASCII renders a tree as an indented ASCII outline, e.g.:
+//
+//	5
+//	  3
+//	  8
+func TreeASCII(root TreeNode) string {
+	var b strings.Builder
+	var walk func(n TreeNode, depth int)
+	walk = func(n TreeNode, depth int) {
+		if n == nil {
+			return
+		}
+		fmt.Fprintf(&b, "%s%s\n", strings.Repeat("  ", depth), n.Label())
+		for _, c := range n.Children() {
+			walk(c, depth+1)
+		}
+	}
+	walk(root, 0)
+	return b.String()
+}
+
+// WeightedEdge is a single directed, weighted edge for GraphDOT, matching
+// the Edge shape already used by graph/dijkstra and friends.
+type WeightedEdge struct {
+	From, To, Weight int
+}
+
+// GraphDOT renders a directed graph as a Graphviz DOT digraph, labelling
+// each edge with its weight.
+func GraphDOT(edges []WeightedEdge) string {
+	var b strings.Builder
+	b.WriteString("digraph Graph {\n")
+	for _, e := range edges {
+		fmt.Fprintf(&b, "  n%d -> n%d [label=%q];\n", e.From, e.To, strconv.Itoa(e.Weight))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// AdjacencyMatrixToEdges converts a 0/1 (or weighted) adjacency matrix, as
+// used by graph/bfs and graph/dfs, into the edge list GraphDOT expects.
+// A zero entry means "no edge".
+func AdjacencyMatrixToEdges(adj [][]int) []WeightedEdge {
+	var edges []WeightedEdge
+	for from, row := range adj {
+		for to, weight := range row {
+			if weight != 0 {
+				edges = append(edges, WeightedEdge{From: from, To: to, Weight: weight})
+			}
+		}
+	}
+	return edges
+}
+
+// ArrayASCII renders a 1D array as a single row of boxed values, with the
+// value(s) at the given indices marked with a caret underneath, e.g.:
+//
+//	[ 1  2  3  4 ]
+//	      ^
+func ArrayASCII(values []int, highlight ...int) string {
+	marked := make(map[int]bool, len(highlight))
+	for _, i := range highlight {
+		marked[i] = true
+	}
+
+	var row, marks strings.Builder
+	row.WriteString("[")
+	for i, v := range values {
+		if i > 0 {
+			row.WriteString(" ")
+		}
+		cell := fmt.Sprintf("%d", v)
+		row.WriteString(cell)
+		pad := strings.Repeat(" ", len(cell))
+		if marked[i] {
+			marks.WriteString(strings.Repeat("^", len(cell)))
+		} else {
+			marks.WriteString(pad)
+		}
+		if i > 0 {
+			marks.WriteString(" ")
+		}
+	}
+	row.WriteString(" ]")
+
+	if len(marked) == 0 {
+		return row.String() + "\n"
+	}
+	return row.String() + "\n " + marks.String() + "\n"
+}
+
+// TableASCII renders a 2D DP table as a grid of right-aligned columns,
+// suitable for dumping e.g. an edit-distance or LCS table to a terminal.
+func TableASCII(rows [][]int) string {
+	if len(rows) == 0 {
+		return ""
+	}
+
+	width := 0
+	for _, row := range rows {
+		for _, v := range row {
+			if n := len(fmt.Sprintf("%d", v)); n > width {
+				width = n
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, row := range rows {
+		for i, v := range row {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+			fmt.Fprintf(&b, "%*d", width, v)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Frame is one labelled snapshot captured by a Tracer.
+type Frame struct {
+	Label string
+	Body  string
+}
+
+// Tracer accumulates a sequence of rendered frames so a multi-step
+// algorithm (LPS construction, a BFS frontier, a DP table filling in) can
+// be replayed step by step instead of only showing its final result.
+type Tracer struct {
+	frames []Frame
+}
+
+// NewTracer returns an empty Tracer.
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// Capture appends a labelled frame, typically the output of one of the
+// render functions above (TreeASCII, ArrayASCII, GraphDOT, ...).
+func (t *Tracer) Capture(label, body string) {
+	t.frames = append(t.frames, Frame{Label: label, Body: body})
+}
+
+// Frames returns the captured frames in the order they were recorded.
+func (t *Tracer) Frames() []Frame {
+	return t.frames
+}
+
+// Replay writes every captured frame to w, in order, preceded by its label.
+func (t *Tracer) Replay() string {
+	var b strings.Builder
+	for i, f := range t.frames {
+		fmt.Fprintf(&b, "--- step %d: %s ---\n%s", i, f.Label, f.Body)
+	}
+	return b.String()
+}
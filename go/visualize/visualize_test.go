@@ -0,0 +1,97 @@
+package visualize
+
+import (
+	"strings"
+	"testing"
+)
+
+type fakeNode struct {
+	label    string
+	children []TreeNode
+}
+
+func (n fakeNode) Label() string        { return n.label }
+func (n fakeNode) Children() []TreeNode { return n.children }
+
+func TestTreeDOT(t *testing.T) {
+	root := fakeNode{label: "5", children: []TreeNode{
+		fakeNode{label: "3"},
+		fakeNode{label: "8"},
+	}}
+
+	got := TreeDOT(root)
+	for _, want := range []string{`n0 [label="5"]`, `n1 [label="3"]`, `n2 [label="8"]`, "n0 -> n1", "n0 -> n2"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("TreeDOT() missing %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestTreeDOTNilRoot(t *testing.T) {
+	if got, want := TreeDOT(nil), "digraph Tree {\n}\n"; got != want {
+		t.Errorf("TreeDOT(nil) = %q, want %q", got, want)
+	}
+}
+
+func TestTreeASCII(t *testing.T) {
+	root := fakeNode{label: "5", children: []TreeNode{fakeNode{label: "3"}}}
+	want := "5\n  3\n"
+	if got := TreeASCII(root); got != want {
+		t.Errorf("TreeASCII() = %q, want %q", got, want)
+	}
+}
+
+func TestGraphDOT(t *testing.T) {
+	edges := []WeightedEdge{{From: 0, To: 1, Weight: 4}}
+	got := GraphDOT(edges)
+	if want := `n0 -> n1 [label="4"]`; !strings.Contains(got, want) {
+		t.Errorf("GraphDOT() missing %q, got:\n%s", want, got)
+	}
+}
+
+func TestAdjacencyMatrixToEdges(t *testing.T) {
+	adj := [][]int{{0, 1}, {1, 0}}
+	edges := AdjacencyMatrixToEdges(adj)
+	if len(edges) != 2 {
+		t.Fatalf("AdjacencyMatrixToEdges() = %v, want 2 edges", edges)
+	}
+}
+
+func TestArrayASCII(t *testing.T) {
+	got := ArrayASCII([]int{1, 2, 3}, 1)
+	want := "[1 2 3 ]\n  ^   \n"
+	if got != want {
+		t.Errorf("ArrayASCII() = %q, want %q", got, want)
+	}
+}
+
+func TestArrayASCIINoHighlight(t *testing.T) {
+	if got, want := ArrayASCII([]int{1, 2}), "[1 2 ]\n"; got != want {
+		t.Errorf("ArrayASCII() = %q, want %q", got, want)
+	}
+}
+
+func TestTableASCII(t *testing.T) {
+	got := TableASCII([][]int{{1, 2}, {10, 3}})
+	want := " 1  2\n10  3\n"
+	if got != want {
+		t.Errorf("TableASCII() = %q, want %q", got, want)
+	}
+}
+
+func TestTracerReplay(t *testing.T) {
+	tr := NewTracer()
+	tr.Capture("step one", "a\n")
+	tr.Capture("step two", "b\n")
+
+	if got := len(tr.Frames()); got != 2 {
+		t.Fatalf("len(Frames()) = %d, want 2", got)
+	}
+
+	got := tr.Replay()
+	for _, want := range []string{"step one", "step two", "a\n", "b\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Replay() missing %q, got:\n%s", want, got)
+		}
+	}
+}